@@ -0,0 +1,115 @@
+package chrome
+
+import (
+	"context"
+	"strings"
+)
+
+// maxAXNodes is the most accessibility tree nodes kept for a page, so
+// that huge pages stay bounded. The summary counts every node.
+const maxAXNodes = 2000
+
+// AXNode is a node of the accessibility tree, at a depth below the
+// root counting only the nodes exposed to assistive technology
+type AXNode struct {
+	Role  string `json:"role"`
+	Name  string `json:"name,omitempty"`
+	Depth int    `json:"depth"`
+}
+
+// AXTree is the accessibility tree of a page, along with the counts
+// of controls and images assistive technology can not name
+type AXTree struct {
+	Nodes            []AXNode `json:"nodes"`
+	Total            int      `json:"total"`
+	UnlabeledButtons int      `json:"unlabeled_buttons"`
+	UnlabeledImages  int      `json:"unlabeled_images"`
+	UnlabeledLinks   int      `json:"unlabeled_links"`
+	UnlabeledFields  int      `json:"unlabeled_fields"`
+}
+
+// axFieldRoles are the roles of form fields, which need labels
+var axFieldRoles = map[string]bool{
+	"textbox": true, "searchbox": true, "combobox": true, "listbox": true, "checkbox": true,
+	"radio": true, "switch": true, "slider": true, "spinbutton": true,
+}
+
+// accessibilityTree returns the accessibility tree of the page in tab
+func accessibilityTree(ctx context.Context, tab *devtools) (*AXTree, error) {
+
+	if err := tab.call(ctx, "Accessibility.enable", nil, nil); err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Nodes []struct {
+			NodeID   string   `json:"nodeId"`
+			ParentID string   `json:"parentId"`
+			Ignored  bool     `json:"ignored"`
+			Role     *axValue `json:"role"`
+			Name     *axValue `json:"name"`
+		} `json:"nodes"`
+	}
+	if err := tab.call(ctx, "Accessibility.getFullAXTree", nil, &response); err != nil {
+		return nil, err
+	}
+
+	// nodes are listed parents first, so depths are known in order
+	depths := make(map[string]int)
+	tree := &AXTree{}
+	for _, node := range response.Nodes {
+
+		depth := 0
+		if parent, ok := depths[node.ParentID]; ok {
+			depth = parent
+		}
+		if node.Ignored {
+			depths[node.NodeID] = depth
+			continue
+		}
+		depths[node.NodeID] = depth + 1
+
+		role, name := axString(node.Role), strings.TrimSpace(axString(node.Name))
+		if role == "" || role == "none" || role == "generic" || role == "StaticText" || role == "InlineTextBox" {
+			depths[node.NodeID] = depth
+			continue
+		}
+
+		tree.Total++
+		if name == "" {
+			switch {
+			case role == "button":
+				tree.UnlabeledButtons++
+			case role == "image" || role == "img":
+				tree.UnlabeledImages++
+			case role == "link":
+				tree.UnlabeledLinks++
+			case axFieldRoles[role]:
+				tree.UnlabeledFields++
+			}
+		}
+
+		if len(tree.Nodes) < maxAXNodes {
+			tree.Nodes = append(tree.Nodes, AXNode{Role: role, Name: name, Depth: depth})
+		}
+	}
+
+	return tree, nil
+}
+
+// axValue is a property of an accessibility tree node
+type axValue struct {
+	Value interface{} `json:"value"`
+}
+
+// axString returns the value of a property of an accessibility node
+// as a string, or an empty string when it is not one
+func axString(value *axValue) string {
+
+	if value == nil {
+		return ""
+	}
+
+	s, _ := value.Value.(string)
+	return s
+}
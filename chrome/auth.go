@@ -0,0 +1,77 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// maxAuthAttempts is how many times the credentials are offered for
+// a request before giving up on them
+const maxAuthAttempts = 2
+
+// handleAuth answers the authentication challenges of the page in tab
+// with AuthUsername and AuthPassword. Chrome runs the NTLM and
+// Negotiate handshakes itself once it has credentials.
+func (chrome *Chrome) handleAuth(ctx context.Context, tab *devtools) error {
+
+	var mu sync.Mutex
+	attempts := make(map[string]int)
+
+	// Fetch pauses every request once enabled. Handlers can't issue
+	// commands on the read goroutine, so they answer from their own.
+	tab.on("Fetch.requestPaused", func(params json.RawMessage) {
+
+		var event struct {
+			RequestID string `json:"requestId"`
+		}
+		if err := json.Unmarshal(params, &event); err != nil {
+			return
+		}
+
+		go tab.call(ctx, "Fetch.continueRequest", map[string]interface{}{"requestId": event.RequestID}, nil)
+	})
+
+	tab.on("Fetch.authRequired", func(params json.RawMessage) {
+
+		var event struct {
+			RequestID string `json:"requestId"`
+			Request   struct {
+				URL string `json:"url"`
+			} `json:"request"`
+			AuthChallenge struct {
+				Scheme string `json:"scheme"`
+			} `json:"authChallenge"`
+		}
+		if err := json.Unmarshal(params, &event); err != nil {
+			return
+		}
+
+		mu.Lock()
+		attempts[event.RequestID]++
+		attempt := attempts[event.RequestID]
+		mu.Unlock()
+
+		response := map[string]interface{}{
+			"response": "ProvideCredentials", "username": chrome.AuthUsername, "password": chrome.AuthPassword,
+		}
+		if attempt > maxAuthAttempts {
+			log.WithField("url", event.Request.URL).Warn("Credentials were rejected")
+			response = map[string]interface{}{"response": "CancelAuth"}
+		} else {
+			log.WithFields(log.Fields{"url": event.Request.URL, "scheme": event.AuthChallenge.Scheme}).
+				Debug("Answering authentication challenge")
+		}
+
+		go tab.call(ctx, "Fetch.continueWithAuth", map[string]interface{}{
+			"requestId": event.RequestID, "authChallengeResponse": response,
+		}, nil)
+	})
+
+	return tab.call(ctx, "Fetch.enable", map[string]interface{}{
+		"handleAuthRequests": true,
+		"patterns":           []map[string]string{{"urlPattern": "*"}},
+	}, nil)
+}
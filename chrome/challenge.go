@@ -0,0 +1,128 @@
+package chrome
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// challengePoll is how often to check if a challenge has been solved
+const challengePoll = 500 * time.Millisecond
+
+// Challenge is a JavaScript challenge interstitial, such as the one
+// Cloudflare shows while checking the browser, met by a page
+type Challenge struct {
+	Provider string        `json:"provider"`
+	Solved   bool          `json:"solved"`
+	Waited   time.Duration `json:"waited"`
+	Reloads  int           `json:"reloads"`
+}
+
+// ChallengeScript evaluates to the provider of the challenge page that
+// is shown, or an empty string once the real page has loaded
+const ChallengeScript = `(function() {
+	if (document.readyState !== "complete") {
+		return "loading";
+	}
+	var title = document.title || "";
+	var text = document.body ? document.body.innerText.slice(0, 2000) : "";
+	var has = function(selector) {
+		try { return document.querySelector(selector) !== null; } catch (e) { return false; }
+	};
+	if (/^just a moment\.\.\.$|^attention required! \| cloudflare$/i.test(title.trim()) ||
+		has("#challenge-form, #challenge-running, #cf-challenge-running, #cf-please-wait, .cf-browser-verification")) {
+		return "cloudflare";
+	}
+	if (/ddos-guard/i.test(title) || has("#ddg-l10n-title, script[src*='ddos-guard.net/']")) {
+		return "ddos-guard";
+	}
+	if (/sucuri website firewall/i.test(title) || has("#sucuri-challenge")) {
+		return "sucuri";
+	}
+	if (has("iframe[src*='_Incapsula_Resource']")) {
+		return "imperva";
+	}
+	if (/checking your browser before accessing|verifying you are human|please wait while we verify your browser/i.test(text)) {
+		return "unknown";
+	}
+	return "";
+})()`
+
+// waitForChallenge detects a challenge page in tab, waiting up to wait
+// for it to resolve and reloading the page up to reloads times when it
+// does not. Nil is returned when there was no challenge.
+func waitForChallenge(ctx context.Context, tab *devtools, navigateURL string, wait time.Duration, reloads int) (*Challenge, error) {
+
+	var provider string
+	if err := tab.evaluate(ctx, ChallengeScript, &provider); err != nil {
+		return nil, err
+	}
+	if provider == "" || provider == "loading" {
+		return nil, nil
+	}
+
+	challenge := &Challenge{Provider: provider}
+	log.WithFields(log.Fields{"url": navigateURL, "provider": provider}).Info("Page shows a challenge")
+	if wait <= 0 {
+		return challenge, nil
+	}
+
+	started := time.Now()
+	defer func() { challenge.Waited = time.Since(started) }()
+
+	for {
+
+		solved, err := challengeSolved(ctx, tab, navigateURL, wait)
+		if err != nil {
+			return challenge, err
+		}
+
+		if solved {
+			challenge.Solved = true
+			log.WithFields(log.Fields{"url": navigateURL, "provider": challenge.Provider, "waited": time.Since(started)}).
+				Info("Challenge solved")
+			return challenge, nil
+		}
+
+		if challenge.Reloads >= reloads {
+			log.WithFields(log.Fields{"url": navigateURL, "provider": challenge.Provider}).Warn("Challenge was not solved, capturing it")
+			return challenge, nil
+		}
+
+		challenge.Reloads++
+		log.WithFields(log.Fields{"url": navigateURL, "reload": challenge.Reloads}).Debug("Challenge not solved yet, reloading")
+		if err := tab.call(ctx, "Page.reload", nil, nil); err != nil {
+			return challenge, err
+		}
+	}
+}
+
+// challengeSolved polls the page in tab until the challenge it shows
+// is gone, giving up after wait. It returns false when it gave up.
+func challengeSolved(ctx context.Context, tab *devtools, navigateURL string, wait time.Duration) (bool, error) {
+
+	deadline := time.After(wait)
+	for {
+
+		select {
+		case <-time.After(challengePoll):
+		case <-deadline:
+			return false, nil
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+
+		// the page navigates once the challenge is solved, which
+		// fails the scripts evaluated meanwhile
+		var provider string
+		if err := tab.evaluate(ctx, ChallengeScript, &provider); err != nil {
+			log.WithFields(log.Fields{"url": navigateURL, "err": err}).Debug("Challenge check failed, the page may be navigating")
+			continue
+		}
+
+		if provider == "" {
+			return true, nil
+		}
+	}
+}
@@ -2,12 +2,18 @@ package chrome
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -24,6 +30,110 @@ type Chrome struct {
 	Path          string
 	UserAgent     string
 
+	// DismissDialogs attempts to click the accept button of
+	// common consent dialogs before taking a screenshot
+	DismissDialogs   bool
+	DismissSelectors []string
+
+	// CaptureBeforeDismiss also keeps a screenshot of the page
+	// as it was before a dialog was dismissed
+	CaptureBeforeDismiss bool
+
+	// ClickSelectors are clicked in turn once the page has loaded, to
+	// pass splash and interstitial pages. WaitSelector then waits up
+	// to WaitTimeout seconds for an element to appear.
+	ClickSelectors []string
+	WaitSelector   string
+	WaitTimeout    int
+
+	// ChallengeWait waits up to this many seconds for a JavaScript
+	// challenge page to resolve into the real page, reloading it up
+	// to ChallengeReloads times when it does not. Challenges are
+	// only recorded when 0.
+	ChallengeWait    int
+	ChallengeReloads int
+
+	// CrashRetries is how many times a capture is retried in a new
+	// Chrome process when the one running it crashes
+	CrashRetries int
+
+	// NoSandbox runs Chrome without its sandbox, which it needs to
+	// start as root and in most containers
+	NoSandbox bool
+
+	// AuthUsername and AuthPassword answer the authentication
+	// challenges of pages, including NTLM and Negotiate
+	AuthUsername string
+	AuthPassword string
+
+	// Headers and Cookies are sent with every request made
+	// while taking a screenshot
+	Headers map[string]string
+	Cookies map[string]string
+
+	// Accept is sent as the Accept header, to choose the
+	// representation of content negotiating pages. A header
+	// in Headers takes precedence.
+	Accept string
+
+	// LocalStorage and SessionStorage are key/value pairs set
+	// in the page's storage before its scripts run
+	LocalStorage   map[string]string
+	SessionStorage map[string]string
+
+	// ViewportOnly clips every screenshot to exactly
+	// CaptureHeight pixels, regardless of the page length
+	ViewportOnly  bool
+	CaptureHeight int
+
+	// Tiles captures the page as up to this many viewport height
+	// tiles instead of a single full page screenshot, the first
+	// of which is the screenshot itself
+	Tiles int
+
+	// ScrollRequests scrolls the page until this many additional
+	// network requests have been made, up to MaxScrolls times
+	ScrollRequests int
+	MaxScrolls     int
+
+	// SaveDOMText keeps the visible text of the page
+	// once it has loaded
+	SaveDOMText bool
+
+	// SaveAXTree keeps the accessibility tree of the page
+	// once it has loaded
+	SaveAXTree bool
+
+	// ReducedMotion disables animations and transitions, and
+	// emulates prefers-reduced-motion for stable screenshots
+	ReducedMotion bool
+
+	// Touch emulates a touch screen and Mobile a mobile device, while
+	// ScaleFactor sets the device pixel ratio, keeping the size of
+	// Resolution. Chrome's defaults are used when unset.
+	Touch       bool
+	Mobile      bool
+	ScaleFactor float64
+
+	// GPU is the rendering mode of GPUArguments. Canvas and WebGL
+	// pages may come out blank with the default of GPUOff.
+	GPU string
+
+	// Background is the #rrggbb or #rrggbbaa color pages are
+	// rendered against. Chrome's default white is used when empty.
+	Background string
+
+	// Throttle is the name of the ThrottlePresets network
+	// conditions to capture under, if any
+	Throttle string
+
+	// Resolve pins hostnames to addresses, bypassing DNS
+	Resolve []ResolvePin
+
+	// MaxMemory holds captures back while the running browsers
+	// use this many bytes of memory. No limit applies when 0.
+	MaxMemory int64
+
 	ScreenshotPath string
 }
 
@@ -122,6 +232,20 @@ func (chrome *Chrome) checkVersion(lowestVersion string) bool {
 	return true
 }
 
+// viewport returns the width and height from the resolution
+func (chrome *Chrome) viewport() (int, int) {
+
+	parsed := strings.Split(chrome.Resolution, ",")
+	if len(parsed) != 2 {
+		return 0, 0
+	}
+
+	width, _ := strconv.Atoi(parsed[0])
+	height, _ := strconv.Atoi(parsed[1])
+
+	return width, height
+}
+
 // SetScreenshotPath sets the path for screenshots
 func (chrome *Chrome) SetScreenshotPath(p string) error {
 
@@ -140,28 +264,147 @@ func (chrome *Chrome) SetScreenshotPath(p string) error {
 	return nil
 }
 
-// ScreenshotURL takes a screenshot of a URL
-func (chrome *Chrome) ScreenshotURL(targetURL *url.URL, destination string) {
+// ScreenshotResult contains information gathered while
+// taking a screenshot
+type ScreenshotResult struct {
+	// DialogDismissed is set when a consent dialog was
+	// dismissed before the screenshot was taken
+	DialogDismissed bool
+
+	// BeforeDismissFile is the screenshot taken before the dialog
+	// was dismissed, when CaptureBeforeDismiss is set
+	BeforeDismissFile string
+
+	// Clicked are the ClickSelectors that matched an element, and
+	// WaitTimedOut is set when the WaitSelector never appeared
+	Clicked      []string
+	WaitTimedOut bool
+
+	// ClippedHeight is the height the screenshot was clipped
+	// to, or 0 if it was not clipped
+	ClippedHeight int
+
+	// ScrollIterations is the number of times the page was
+	// scrolled to load more content
+	ScrollIterations int
+
+	// DOMText is the visible text of the page when
+	// SaveDOMText is set
+	DOMText string
+
+	// Challenge is the JavaScript challenge page the page
+	// showed first, if any
+	Challenge *Challenge
+
+	// AXTree is the accessibility tree of the page when
+	// SaveAXTree is set
+	AXTree *AXTree
+
+	// WebSockets are the URLs of the WebSockets the page
+	// opened while it was captured
+	WebSockets []string
+
+	// MixedContent are the URLs of the subresources an https
+	// page loaded over http
+	MixedContent []string
+
+	// Exceptions are the JavaScript exceptions the page
+	// did not catch while it was captured
+	Exceptions []PageException
+
+	// Links are the canonical and hreflang alternate links
+	// of the page, and its hyperlinks
+	Links PageLinks
+
+	// DOMNodes is the number of elements in the page, and
+	// TransferredBytes the bytes it took to load it
+	DOMNodes         int
+	TransferredBytes int64
+
+	// TileFiles are the viewport tiles below the first one
+	// when Tiles is set
+	TileFiles []string
+
+	// Crashes is the number of times Chrome crashed while
+	// capturing the page, including retries
+	Crashes int
+
+	// TimedOut is set when the capture did not finish within
+	// the timeout
+	TimedOut bool
+}
+
+// DOMTextScript evaluates to the visible text content of a page
+const DOMTextScript = `(document.body ? document.body.innerText : "")`
+
+// ScreenshotURL takes a screenshot of a URL. Chrome is started for
+// every capture, so a crash only fails the capture it was running,
+// which is retried in a new process up to CrashRetries times.
+func (chrome *Chrome) ScreenshotURL(targetURL *url.URL, destination string) (*ScreenshotResult, error) {
+
+	for attempt := 0; ; attempt++ {
+
+		result, crashed, err := chrome.screenshotAttempt(targetURL, destination)
+		result.Crashes = attempt
+		if crashed {
+			result.Crashes++
+		}
+
+		if err == nil || !crashed || attempt >= chrome.CrashRetries {
+			return result, err
+		}
+
+		log.WithFields(log.Fields{"url": targetURL, "attempt": attempt + 1}).Warn("Chrome crashed, retrying the capture in a new process")
+	}
+}
+
+// screenshotAttempt takes a screenshot of a URL with a new Chrome
+// process, reporting if the process died before it was done
+func (chrome *Chrome) screenshotAttempt(targetURL *url.URL, destination string) (*ScreenshotResult, bool, error) {
 
 	log.WithFields(log.Fields{"url": targetURL, "full-destination": destination}).
 		Debug("Full path to screenshot save using Chrome")
 
-	// Start with the basic headless arguments
+	result := &ScreenshotResult{}
+
+	// Start with the basic headless arguments. The page is driven using
+	// the DevTools protocol so that we can interact with it before the
+	// screenshot is taken.
 	var chromeArguments = []string{
-		"--headless", "--disable-gpu", "--hide-scrollbars",
+		"--headless", "--hide-scrollbars",
 		"--disable-crash-reporter",
 		"--user-agent=" + chrome.UserAgent,
-		"--window-size=" + chrome.Resolution, "--screenshot=" + destination,
+		"--window-size=" + chrome.Resolution,
+		"--remote-debugging-port=0", "--remote-allow-origins=" + devtoolsOrigin,
+	}
+
+	gpuArguments, err := GPUArguments(chrome.GPU)
+	if err != nil {
+		return result, false, err
 	}
+	chromeArguments = append(chromeArguments, gpuArguments...)
 
-	// When we are running as root, chromiun will flag the 'cant
-	// run as root' thing. Handle that case.
-	if os.Geteuid() == 0 {
+	// Each Chrome instance gets its own profile so that concurrent
+	// screenshots do not fight over the same user data directory.
+	profile, err := ioutil.TempDir("", "gowitness-chrome-")
+	if err != nil {
+		log.WithField("error", err).Error("Failed to create a temporary Chrome profile")
+		return result, false, err
+	}
+	defer os.RemoveAll(profile)
+	chromeArguments = append(chromeArguments, "--user-data-dir="+profile)
 
-		log.WithField("euid", os.Geteuid()).Debug("Running as root, adding --no-sandbox")
+	if chrome.NoSandbox {
 		chromeArguments = append(chromeArguments, "--no-sandbox")
 	}
 
+	if len(chrome.Resolve) > 0 {
+		chromeArguments = append(chromeArguments, "--host-resolver-rules="+hostResolverRules(chrome.Resolve))
+	}
+
+	// The URL Chrome will be navigated to
+	navigateURL := targetURL.String()
+
 	// Check if we need to add a proxy hack for Chrome headless to
 	// stfu about certificates :>
 	if targetURL.Scheme == "https" {
@@ -169,7 +412,7 @@ func (chrome *Chrome) ScreenshotURL(targetURL *url.URL, destination string) {
 		// Chrome headless... you suck. Proxy to the target
 		// so that we can ignore SSL certificate issues.
 		// proxy := shittyProxy{targetURL: targetURL}
-		proxy := forwardingProxy{targetURL: targetURL}
+		proxy := forwardingProxy{targetURL: targetURL, resolve: chrome.Resolve}
 
 		// Give the shitty proxy a few moments to start up.
 		time.Sleep(500 * time.Millisecond)
@@ -179,37 +422,46 @@ func (chrome *Chrome) ScreenshotURL(targetURL *url.URL, destination string) {
 		if err := proxy.start(); err != nil {
 
 			log.WithField("error", err).Warning("Failed to start proxy for HTTPS request")
-			return
+			return result, false, err
 		}
 
 		// Update the URL scheme back to http, the proxy will handle the SSL
 		proxyURL, _ := url.Parse("http://localhost:" + strconv.Itoa(proxy.port) + "/")
-		proxyURL.Path = targetURL.Path
+		proxyURL.Path, proxyURL.RawPath = targetURL.Path, targetURL.RawPath
+		proxyURL.RawQuery, proxyURL.Fragment = targetURL.RawQuery, targetURL.Fragment
 
 		// I am not 100% sure if this does anything, but lets add --allow-insecure-localhost
 		// anyways.
 		chromeArguments = append(chromeArguments, "--allow-insecure-localhost")
 
-		// set the URL to call to the proxy we are starting up
-		chromeArguments = append(chromeArguments, proxyURL.String())
+		// set the URL to navigate to the proxy we are starting up
+		navigateURL = proxyURL.String()
 
 		// when we are done, stop the hack :|
 		defer proxy.stop()
-
-	} else {
-
-		// Finally add the url to screenshot
-		chromeArguments = append(chromeArguments, targetURL.String())
 	}
 
+	// Start on a blank page, we will navigate once DevTools is connected
+	chromeArguments = append(chromeArguments, "about:blank")
+
 	log.WithFields(log.Fields{"arguments": chromeArguments}).Debug("Google Chrome arguments")
 
+	// wait for memory before the timeout starts counting
+	admitted := WaitForMemory(chrome.MaxMemory, targetURL.String())
+	defer admitted()
+
 	// get a context to run the command in
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(chrome.ChromeTimeout)*time.Second)
+	timeout := time.Duration(chrome.ChromeTimeout+chrome.ChallengeWait*(chrome.ChallengeReloads+1)) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	// Prepare the command to run...
 	cmd := exec.CommandContext(ctx, chrome.Path, chromeArguments...)
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		log.WithField("error", err).Error("Failed to read Chrome stderr")
+		return result, false, err
+	}
 
 	log.WithFields(log.Fields{"url": targetURL, "destination": destination}).Info("Taking screenshot")
 
@@ -219,23 +471,371 @@ func (chrome *Chrome) ScreenshotURL(targetURL *url.URL, destination string) {
 		log.Fatal(err)
 	}
 
-	// Wait for the screenshot to finish and handle the error that may occur.
-	if err := cmd.Wait(); err != nil {
+	untrack := TrackProcess(cmd.Process.Pid)
+	admitted()
+
+	// Chrome only exits once we are done with it, unless it crashed
+	exited := make(chan struct{})
+	go func() {
+		cmd.Wait()
+		close(exited)
+	}()
+	defer func() {
+		untrack()
+		cmd.Process.Kill()
+		<-exited
+	}()
+
+	if err := chrome.capture(ctx, stderr, navigateURL, targetURL.Scheme == "https", destination, result); err != nil {
 
 		// If if this error was as a result of a timeout
 		if ctx.Err() == context.DeadlineExceeded {
 			log.WithFields(log.Fields{"url": targetURL, "destination": destination, "err": err}).
 				Error("Timeout reached while waiting for screenshot to finish")
-			return
+			result.TimedOut = true
+			return result, false, err
+		}
+
+		if processExited(exited) {
+			log.WithFields(log.Fields{"url": targetURL, "destination": destination, "err": err}).
+				Error("Chrome exited before the screenshot was taken")
+			return result, true, err
 		}
 
 		log.WithFields(log.Fields{"url": targetURL, "destination": destination, "err": err}).
 			Error("Screenshot failed")
 
-		return
+		return result, false, err
 	}
 
 	log.WithFields(log.Fields{
 		"url": targetURL, "destination": destination, "duration": time.Since(startTime),
 	}).Info("Screenshot taken")
+
+	return result, false, nil
+}
+
+// crashGrace is how long a failed capture waits for Chrome to exit
+// before deciding that it did not crash
+const crashGrace = time.Second
+
+// processExited checks if the process that closes exited is gone,
+// giving it a moment, as the DevTools connection of a crashed Chrome
+// usually fails before the process is reaped
+func processExited(exited <-chan struct{}) bool {
+
+	select {
+	case <-exited:
+		return true
+	case <-time.After(crashGrace):
+		return false
+	}
+}
+
+// AcceptHeader returns the Accept header sent while capturing,
+// or an empty string when the defaults are sent
+func (chrome *Chrome) AcceptHeader() string {
+
+	for name, value := range chrome.Headers {
+		if strings.EqualFold(name, "Accept") {
+			return value
+		}
+	}
+
+	return chrome.Accept
+}
+
+// RequestHeaders returns the extra headers sent with every request,
+// including the Accept header
+func (chrome *Chrome) RequestHeaders() map[string]string {
+
+	if chrome.Accept == "" || chrome.AcceptHeader() != chrome.Accept {
+		return chrome.Headers
+	}
+
+	headers := map[string]string{"Accept": chrome.Accept}
+	for name, value := range chrome.Headers {
+		headers[name] = value
+	}
+
+	return headers
+}
+
+// setRequestOptions configures the extra headers and cookies to send
+// before the page is navigated to
+func (chrome *Chrome) setRequestOptions(ctx context.Context, tab *devtools, navigateURL string) error {
+
+	headers := chrome.RequestHeaders()
+	if len(headers) == 0 && len(chrome.Cookies) == 0 {
+		return nil
+	}
+
+	if err := tab.call(ctx, "Network.enable", nil, nil); err != nil {
+		return err
+	}
+
+	if len(headers) > 0 {
+
+		params := map[string]interface{}{"headers": headers}
+		if err := tab.call(ctx, "Network.setExtraHTTPHeaders", params, nil); err != nil {
+			return err
+		}
+	}
+
+	for name, value := range chrome.Cookies {
+
+		params := map[string]interface{}{"name": name, "value": value, "url": navigateURL}
+		if err := tab.call(ctx, "Network.setCookie", params, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// capture connects to a running Chrome instance, navigates to the
+// URL and saves a screenshot to destination. secure is set when the
+// target is an https URL, served through the forwarding proxy.
+func (chrome *Chrome) capture(ctx context.Context, stderr io.Reader, navigateURL string, secure bool,
+	destination string, result *ScreenshotResult) error {
+
+	address, err := waitForDevTools(ctx, stderr)
+	if err != nil {
+		return err
+	}
+
+	tab, err := dialDevTools(ctx, address)
+	if err != nil {
+		return err
+	}
+	defer tab.close()
+
+	loaded := make(chan struct{}, 1)
+	tab.on("Page.loadEventFired", func(params json.RawMessage) {
+		select {
+		case loaded <- struct{}{}:
+		default:
+		}
+	})
+
+	if err := tab.call(ctx, "Page.enable", nil, nil); err != nil {
+		return err
+	}
+
+	transferred := watchTransferred(tab)
+	mixed := watchMixedContent(tab, secure)
+	sockets, err := watchWebSockets(ctx, tab)
+	if err != nil {
+		return err
+	}
+	exceptions, err := watchExceptions(ctx, tab)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		result.WebSockets = sockets.list()
+		result.Exceptions = exceptions.list()
+		result.MixedContent = mixed.list()
+		result.TransferredBytes = atomic.LoadInt64(transferred)
+	}()
+
+	if err := chrome.setRequestOptions(ctx, tab, navigateURL); err != nil {
+		return err
+	}
+
+	if chrome.AuthUsername != "" {
+		if err := chrome.handleAuth(ctx, tab); err != nil {
+			return err
+		}
+	}
+
+	if chrome.Background != "" {
+
+		color, err := ParseColor(chrome.Background)
+		if err != nil {
+			return err
+		}
+
+		if err := tab.call(ctx, "Emulation.setDefaultBackgroundColorOverride", map[string]interface{}{"color": color}, nil); err != nil {
+			return err
+		}
+	}
+
+	if chrome.Throttle != "" {
+
+		conditions, err := ParseThrottle(chrome.Throttle)
+		if err != nil {
+			return err
+		}
+
+		if err := tab.call(ctx, "Network.enable", nil, nil); err != nil {
+			return err
+		}
+		if err := tab.call(ctx, "Network.emulateNetworkConditions", conditions, nil); err != nil {
+			return err
+		}
+	}
+
+	if chrome.emulatesDevice() {
+		if err := chrome.emulateDevice(ctx, tab); err != nil {
+			return err
+		}
+	}
+
+	if chrome.ReducedMotion {
+
+		params := map[string]interface{}{
+			"features": []map[string]string{{"name": "prefers-reduced-motion", "value": "reduce"}},
+		}
+		if err := tab.call(ctx, "Emulation.setEmulatedMedia", params, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, script := range chrome.initScripts() {
+
+		params := map[string]interface{}{"source": script}
+		if err := tab.call(ctx, "Page.addScriptToEvaluateOnNewDocument", params, nil); err != nil {
+			return err
+		}
+	}
+
+	var navigation struct {
+		ErrorText string `json:"errorText"`
+	}
+	if err := tab.call(ctx, "Page.navigate", map[string]interface{}{"url": navigateURL}, &navigation); err != nil {
+		return err
+	}
+
+	if navigation.ErrorText != "" {
+		return errors.New(navigation.ErrorText)
+	}
+
+	select {
+	case <-loaded:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	challenge, err := waitForChallenge(ctx, tab, navigateURL, time.Duration(chrome.ChallengeWait)*time.Second, chrome.ChallengeReloads)
+	if err != nil {
+		log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to check for a challenge page")
+	}
+	result.Challenge = challenge
+
+	if chrome.DismissDialogs {
+
+		// what the page looks like before the dialog is dismissed is
+		// only kept if there was a dialog
+		before := BeforeDismissFile(destination)
+		if chrome.CaptureBeforeDismiss {
+			if err := chrome.writeScreenshot(ctx, tab, before, result); err != nil {
+				log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to capture the page before dismissing dialogs")
+			}
+		}
+
+		selectors := append(append([]string{}, DialogSelectors...), chrome.DismissSelectors...)
+		if err := tab.evaluate(ctx, DismissDialogsScript(selectors), &result.DialogDismissed); err != nil {
+			log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to dismiss dialogs")
+		}
+
+		// give the dialog a moment to animate away
+		if result.DialogDismissed {
+			log.WithField("url", navigateURL).Debug("Dismissed a dialog before the screenshot")
+			time.Sleep(500 * time.Millisecond)
+		}
+
+		if chrome.CaptureBeforeDismiss {
+			result.BeforeDismissFile = KeepBeforeDismiss(before, result.DialogDismissed)
+		}
+	}
+
+	evaluate := func(script string, out interface{}) error { return tab.evaluate(ctx, script, out) }
+	if err := chrome.ClickAndWait(ctx, navigateURL, evaluate, result); err != nil {
+		return err
+	}
+
+	if chrome.ScrollRequests > 0 {
+
+		if err := chrome.scrollForRequests(ctx, tab, result); err != nil {
+			log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to scroll for requests")
+		}
+	}
+
+	if err := tab.evaluate(ctx, DOMNodesScript, &result.DOMNodes); err != nil {
+		log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to count the DOM nodes")
+	}
+
+	if err := tab.evaluate(ctx, PageLinksScript, &result.Links); err != nil {
+		log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to read the page links")
+	}
+
+	if chrome.SaveDOMText {
+
+		if err := tab.evaluate(ctx, DOMTextScript, &result.DOMText); err != nil {
+			log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to read the page text")
+		}
+	}
+
+	if chrome.SaveAXTree {
+
+		tree, err := accessibilityTree(ctx, tab)
+		if err != nil {
+			log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to read the accessibility tree")
+		}
+		result.AXTree = tree
+	}
+
+	if err := chrome.writeScreenshot(ctx, tab, destination, result); err != nil {
+		return err
+	}
+
+	if chrome.Tiles > 1 {
+		if err := chrome.writeTiles(ctx, tab, destination, result); err != nil {
+			log.WithFields(log.Fields{"url": navigateURL, "err": err}).Warn("Failed to capture the viewport tiles")
+		}
+	}
+
+	return nil
+}
+
+// writeScreenshot captures the page in tab as a PNG, written
+// to destination
+func (chrome *Chrome) writeScreenshot(ctx context.Context, tab *devtools, destination string, result *ScreenshotResult) error {
+
+	screenshotParams := map[string]interface{}{"format": "png"}
+	if chrome.ViewportOnly || chrome.Tiles > 0 {
+
+		width, height := chrome.viewport()
+		if chrome.ViewportOnly && chrome.CaptureHeight > 0 {
+			height = chrome.CaptureHeight
+		}
+
+		screenshotParams["clip"] = map[string]interface{}{
+			"x": 0, "y": 0, "width": width, "height": height, "scale": 1,
+		}
+		screenshotParams["captureBeyondViewport"] = true
+		result.ClippedHeight = height
+	}
+
+	return saveScreenshot(ctx, tab, screenshotParams, destination)
+}
+
+// saveScreenshot captures the page in tab with params as a PNG,
+// written to destination
+func saveScreenshot(ctx context.Context, tab *devtools, params map[string]interface{}, destination string) error {
+
+	var screenshot struct {
+		Data string `json:"data"`
+	}
+	if err := tab.call(ctx, "Page.captureScreenshot", params, &screenshot); err != nil {
+		return err
+	}
+
+	image, err := base64.StdEncoding.DecodeString(screenshot.Data)
+	if err != nil {
+		return errors.Wrap(err, "decoding screenshot")
+	}
+
+	return ioutil.WriteFile(destination, image, 0644)
 }
@@ -0,0 +1,136 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// clickSettle is how long to wait after a click for the page to react
+const clickSettle = 500 * time.Millisecond
+
+// waitPoll is how often to check for the wait selector
+const waitPoll = 250 * time.Millisecond
+
+// ClickScript returns the JavaScript used to click the first element
+// matching selector. The script evaluates to true when it was clicked.
+func ClickScript(selector string) string {
+
+	encoded, _ := json.Marshal(selector)
+
+	return fmt.Sprintf(`(function(selector) {
+	var element;
+	try { element = document.querySelector(selector); } catch (e) { return false; }
+	if (!element) {
+		return false;
+	}
+	element.click();
+	return true;
+})(%s)`, encoded)
+}
+
+// SelectorPresentScript returns the JavaScript evaluating to true
+// once an element matches selector
+func SelectorPresentScript(selector string) string {
+
+	encoded, _ := json.Marshal(selector)
+
+	return fmt.Sprintf(`(function(selector) {
+	try { return document.querySelector(selector) !== null; } catch (e) { return false; }
+})(%s)`, encoded)
+}
+
+// ClickThrough clicks each selector in turn, giving the page a moment
+// to react to every click. evaluate runs a script in the page. The
+// selectors that were clicked are returned.
+func ClickThrough(ctx context.Context, selectors []string, evaluate func(script string, out interface{}) error) ([]string, error) {
+
+	var clicked []string
+	for _, selector := range selectors {
+
+		var ok bool
+		if err := evaluate(ClickScript(selector), &ok); err != nil {
+			return clicked, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		clicked = append(clicked, selector)
+
+		select {
+		case <-time.After(clickSettle):
+		case <-ctx.Done():
+			return clicked, ctx.Err()
+		}
+	}
+
+	return clicked, nil
+}
+
+// WaitForSelector polls the page until an element matches selector,
+// giving up after timeout. It returns false when it gave up.
+func WaitForSelector(ctx context.Context, selector string, timeout time.Duration, evaluate func(script string, out interface{}) error) (bool, error) {
+
+	deadline := time.After(timeout)
+	for {
+
+		var present bool
+		if err := evaluate(SelectorPresentScript(selector), &present); err != nil {
+			return false, err
+		}
+
+		if present {
+			return true, nil
+		}
+
+		select {
+		case <-time.After(waitPoll):
+		case <-deadline:
+			return false, nil
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+}
+
+// ClickAndWait clicks the ClickSelectors and waits for the WaitSelector,
+// recording the outcome in result. Failing to click or wait is only
+// logged, so that whatever the page shows is still captured.
+func (chrome *Chrome) ClickAndWait(ctx context.Context, pageURL string, evaluate func(script string, out interface{}) error, result *ScreenshotResult) error {
+
+	if len(chrome.ClickSelectors) > 0 {
+
+		clicked, err := ClickThrough(ctx, chrome.ClickSelectors, evaluate)
+		result.Clicked = clicked
+		if err == context.DeadlineExceeded || err == context.Canceled {
+			return err
+		}
+		if err != nil {
+			log.WithFields(log.Fields{"url": pageURL, "err": err}).Warn("Failed to click the click selectors")
+		}
+
+		log.WithFields(log.Fields{"url": pageURL, "clicked": len(clicked)}).Debug("Clicked through the page")
+	}
+
+	if chrome.WaitSelector != "" {
+
+		found, err := WaitForSelector(ctx, chrome.WaitSelector, time.Duration(chrome.WaitTimeout)*time.Second, evaluate)
+		if err == context.DeadlineExceeded || err == context.Canceled {
+			return err
+		}
+		if err != nil {
+			log.WithFields(log.Fields{"url": pageURL, "err": err}).Warn("Failed to wait for the wait selector")
+		} else if !found {
+			log.WithFields(log.Fields{"url": pageURL, "selector": chrome.WaitSelector}).
+				Warn("Gave up waiting for the wait selector, capturing anyway")
+		}
+		result.WaitTimedOut = err == nil && !found
+	}
+
+	return nil
+}
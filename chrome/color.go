@@ -0,0 +1,41 @@
+package chrome
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Color is an RGBA color, with alpha between 0 and 1
+type Color struct {
+	R int     `json:"r"`
+	G int     `json:"g"`
+	B int     `json:"b"`
+	A float64 `json:"a"`
+}
+
+// ParseColor parses a #rrggbb or #rrggbbaa color
+func ParseColor(value string) (*Color, error) {
+
+	hex := strings.TrimPrefix(value, "#")
+	if hex == value || (len(hex) != 6 && len(hex) != 8) {
+		return nil, errors.Errorf("invalid color %q, use #rrggbb or #rrggbbaa", value)
+	}
+
+	parsed, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return nil, errors.Errorf("invalid color %q, use #rrggbb or #rrggbbaa", value)
+	}
+
+	if len(hex) == 6 {
+		parsed = parsed<<8 | 0xff
+	}
+
+	return &Color{
+		R: int(parsed >> 24 & 0xff),
+		G: int(parsed >> 16 & 0xff),
+		B: int(parsed >> 8 & 0xff),
+		A: float64(parsed&0xff) / 255,
+	}, nil
+}
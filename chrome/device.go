@@ -0,0 +1,34 @@
+package chrome
+
+import "context"
+
+// maxTouchPoints is the number of touch points emulated with Touch
+const maxTouchPoints = 5
+
+// emulatesDevice checks if any of the device settings are set, which
+// are applied when the page is opened rather than on the command line
+func (chrome *Chrome) emulatesDevice() bool {
+
+	return chrome.Touch || chrome.Mobile || chrome.ScaleFactor > 0
+}
+
+// emulateDevice applies Touch, Mobile and ScaleFactor to tab, keeping
+// the viewport of Resolution. Each is independent of the others, so
+// that a desktop viewport can have touch events for example.
+func (chrome *Chrome) emulateDevice(ctx context.Context, tab *devtools) error {
+
+	width, height := chrome.viewport()
+	metrics := map[string]interface{}{
+		"width": width, "height": height, "deviceScaleFactor": chrome.ScaleFactor, "mobile": chrome.Mobile,
+	}
+	if err := tab.call(ctx, "Emulation.setDeviceMetricsOverride", metrics, nil); err != nil {
+		return err
+	}
+
+	if !chrome.Touch {
+		return nil
+	}
+
+	touch := map[string]interface{}{"enabled": true, "maxTouchPoints": maxTouchPoints}
+	return tab.call(ctx, "Emulation.setTouchEmulationEnabled", touch, nil)
+}
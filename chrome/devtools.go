@@ -0,0 +1,274 @@
+package chrome
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// devtoolsOrigin is the origin we connect to the DevTools websocket
+// with. Chrome is told to only accept this origin.
+const devtoolsOrigin string = "http://127.0.0.1"
+
+// devtools is a minimal Chrome DevTools protocol client
+// connected to a single page target.
+type devtools struct {
+	conn *websocket.Conn
+
+	mu       sync.Mutex
+	id       int64
+	pending  map[int64]chan devtoolsMessage
+	handlers map[string][]func(json.RawMessage)
+	closed   chan struct{}
+}
+
+// devtoolsMessage is a message sent or received over the
+// DevTools websocket.
+type devtoolsMessage struct {
+	ID     int64           `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// devtoolsListening matches the line Chrome writes to stderr
+// once the remote debugging server is up.
+var devtoolsListening = regexp.MustCompile(`DevTools listening on ws://([^/]+)/`)
+
+// waitForDevTools reads Chrome's stderr until the remote debugging
+// address is announced, returning host:port.
+func waitForDevTools(ctx context.Context, stderr io.Reader) (string, error) {
+
+	found := make(chan string, 1)
+	sandboxed := make(chan string, 1)
+
+	go func() {
+
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+
+			if match := devtoolsListening.FindStringSubmatch(scanner.Text()); len(match) > 1 {
+				found <- match[1]
+				break
+			}
+
+			if sandboxFailure.MatchString(scanner.Text()) {
+				sandboxed <- scanner.Text()
+				break
+			}
+		}
+
+		// keep draining stderr so that Chrome never blocks on a write
+		io.Copy(ioutil.Discard, stderr)
+	}()
+
+	select {
+	case address := <-found:
+		return address, nil
+	case line := <-sandboxed:
+		return "", errors.Errorf("chrome could not start its sandbox, run gowitness with --no-sandbox (chrome said: %s)", strings.TrimSpace(line))
+	case <-ctx.Done():
+		return "", errors.Wrap(ctx.Err(), "waiting for the DevTools server")
+	}
+}
+
+// dialDevTools connects to the first page target on the DevTools
+// server at address.
+func dialDevTools(ctx context.Context, address string) (*devtools, error) {
+
+	var targets []struct {
+		Type                 string `json:"type"`
+		WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+	}
+
+	// the page target may take a few moments to appear
+	for len(targets) == 0 {
+
+		resp, err := http.Get("http://" + address + "/json/list")
+		if err != nil {
+			return nil, errors.Wrap(err, "listing DevTools targets")
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&targets)
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding DevTools targets")
+		}
+
+		for i := len(targets) - 1; i >= 0; i-- {
+			if targets[i].Type != "page" {
+				targets = append(targets[:i], targets[i+1:]...)
+			}
+		}
+
+		if len(targets) == 0 {
+
+			select {
+			case <-time.After(100 * time.Millisecond):
+			case <-ctx.Done():
+				return nil, errors.Wrap(ctx.Err(), "waiting for a page target")
+			}
+		}
+	}
+
+	conn, err := websocket.Dial(targets[0].WebSocketDebuggerURL, "", devtoolsOrigin)
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to the DevTools websocket")
+	}
+
+	// screenshots are returned as base64 in a single message
+	conn.MaxPayloadBytes = 256 << 20
+
+	d := &devtools{
+		conn:     conn,
+		pending:  make(map[int64]chan devtoolsMessage),
+		handlers: make(map[string][]func(json.RawMessage)),
+		closed:   make(chan struct{}),
+	}
+
+	go d.read()
+
+	return d, nil
+}
+
+// read dispatches messages received on the websocket to either
+// the caller waiting for a command result, or event handlers.
+func (d *devtools) read() {
+
+	defer close(d.closed)
+
+	for {
+
+		var msg devtoolsMessage
+		if err := websocket.JSON.Receive(d.conn, &msg); err != nil {
+			log.WithField("err", err).Debug("DevTools websocket closed")
+			return
+		}
+
+		d.mu.Lock()
+		if msg.ID != 0 {
+
+			if ch, ok := d.pending[msg.ID]; ok {
+				ch <- msg
+				delete(d.pending, msg.ID)
+			}
+
+			d.mu.Unlock()
+			continue
+		}
+
+		handlers := d.handlers[msg.Method]
+		d.mu.Unlock()
+
+		for _, handler := range handlers {
+			handler(msg.Params)
+		}
+	}
+}
+
+// on registers an event handler for a DevTools event. Handlers run
+// on the read goroutine and must not block or issue commands.
+func (d *devtools) on(method string, handler func(params json.RawMessage)) {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.handlers[method] = append(d.handlers[method], handler)
+}
+
+// call runs a DevTools command, unmarshalling the result into
+// result if it is not nil.
+func (d *devtools) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+
+	d.mu.Lock()
+	d.id++
+	id := d.id
+	ch := make(chan devtoolsMessage, 1)
+	d.pending[id] = ch
+	d.mu.Unlock()
+
+	request := map[string]interface{}{"id": id, "method": method}
+	if params != nil {
+		request["params"] = params
+	}
+
+	if err := websocket.JSON.Send(d.conn, request); err != nil {
+		return errors.Wrap(err, method)
+	}
+
+	select {
+
+	case msg := <-ch:
+		if msg.Error != nil {
+			return errors.Errorf("%s: %s", method, msg.Error.Message)
+		}
+
+		if result != nil && msg.Result != nil {
+			return json.Unmarshal(msg.Result, result)
+		}
+
+		return nil
+
+	case <-d.closed:
+		return errors.Errorf("%s: DevTools connection closed", method)
+
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), method)
+	}
+}
+
+// evaluate runs a JavaScript expression in the page, unmarshalling
+// the returned value into result if it is not nil.
+func (d *devtools) evaluate(ctx context.Context, expression string, result interface{}) error {
+
+	var response struct {
+		Result struct {
+			Value json.RawMessage `json:"value"`
+		} `json:"result"`
+		ExceptionDetails *struct {
+			Text string `json:"text"`
+		} `json:"exceptionDetails"`
+	}
+
+	params := map[string]interface{}{
+		"expression":    expression,
+		"returnByValue": true,
+		"awaitPromise":  true,
+	}
+
+	if err := d.call(ctx, "Runtime.evaluate", params, &response); err != nil {
+		return err
+	}
+
+	if response.ExceptionDetails != nil {
+		return errors.Errorf("Runtime.evaluate: %s", response.ExceptionDetails.Text)
+	}
+
+	if result != nil && response.Result.Value != nil {
+		return json.Unmarshal(response.Result.Value, result)
+	}
+
+	return nil
+}
+
+// close closes the DevTools websocket
+func (d *devtools) close() {
+
+	d.conn.Close()
+}
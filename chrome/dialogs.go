@@ -0,0 +1,76 @@
+package chrome
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DialogSelectors are the built-in CSS selectors for the accept buttons
+// of common cookie-consent dialogs and interstitials. Extra selectors
+// may be added using Chrome.DismissSelectors.
+var DialogSelectors = []string{
+	"#onetrust-accept-btn-handler",
+	"#accept-recommended-btn-handler",
+	"#CybotCookiebotDialogBodyLevelButtonLevelOptinAllowAll",
+	"#CybotCookiebotDialogBodyButtonAccept",
+	"#didomi-notice-agree-button",
+	"#truste-consent-button",
+	"#gdpr-cookie-accept",
+	"#cookie_action_close_header",
+	".cc-allow",
+	".cc-dismiss",
+	".fc-cta-consent",
+	".cookie-notice-accept",
+	".qc-cmp2-summary-buttons button[mode='primary']",
+	"button[data-cookiefirst-action='accept']",
+	"[aria-label='Accept cookies']",
+	"[aria-label='Accept all']",
+}
+
+// DismissDialogsScript returns the JavaScript used to click the first
+// visible element matching one of the selectors. The script evaluates
+// to true when something was clicked.
+func DismissDialogsScript(selectors []string) string {
+
+	encoded, _ := json.Marshal(selectors)
+
+	return fmt.Sprintf(`(function(selectors) {
+	for (var i = 0; i < selectors.length; i++) {
+		var element;
+		try { element = document.querySelector(selectors[i]); } catch (e) { continue; }
+		if (element && element.offsetParent !== null) {
+			element.click();
+			return true;
+		}
+	}
+	return false;
+})(%s)`, encoded)
+}
+
+// BeforeDismissFile returns the path of the screenshot taken before
+// dismissing a dialog, next to the screenshot itself
+func BeforeDismissFile(screenshot string) string {
+
+	extension := filepath.Ext(screenshot)
+	return strings.TrimSuffix(screenshot, extension) + "-before" + extension
+}
+
+// KeepBeforeDismiss returns the screenshot taken before dismissing a
+// dialog, removing it when no dialog was dismissed as it would be the
+// same as the screenshot itself
+func KeepBeforeDismiss(before string, dismissed bool) string {
+
+	if _, err := os.Stat(before); err != nil {
+		return ""
+	}
+
+	if !dismissed {
+		os.Remove(before)
+		return ""
+	}
+
+	return before
+}
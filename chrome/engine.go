@@ -0,0 +1,19 @@
+package chrome
+
+import "net/url"
+
+// Engine is a browser capable of taking screenshots. Chrome is the
+// default engine.
+type Engine interface {
+	// Name returns the name recorded on entries captured by the engine
+	Name() string
+
+	// ScreenshotURL takes a screenshot of a URL
+	ScreenshotURL(targetURL *url.URL, destination string) (*ScreenshotResult, error)
+}
+
+// Name returns the name of the engine
+func (chrome *Chrome) Name() string {
+
+	return "chrome"
+}
@@ -0,0 +1,99 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// maxExceptions is the most uncaught exceptions kept for a page, so
+// that pages throwing in a loop stay bounded
+const maxExceptions = 20
+
+// PageException is an uncaught JavaScript exception thrown by a page
+type PageException struct {
+	Message string `json:"message"`
+	URL     string `json:"url"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+}
+
+// exceptionRecorder keeps the unique uncaught exceptions of a page
+type exceptionRecorder struct {
+	mu         sync.Mutex
+	exceptions []PageException
+	seen       map[PageException]bool
+}
+
+// watchExceptions records the exceptions the page in tab does not
+// catch from now on. Console messages are not recorded, as pages log
+// plenty of harmless noise there.
+func watchExceptions(ctx context.Context, tab *devtools) (*exceptionRecorder, error) {
+
+	recorder := &exceptionRecorder{seen: make(map[PageException]bool)}
+	tab.on("Runtime.exceptionThrown", func(params json.RawMessage) {
+
+		var event struct {
+			ExceptionDetails struct {
+				Text         string `json:"text"`
+				URL          string `json:"url"`
+				LineNumber   int    `json:"lineNumber"`
+				ColumnNumber int    `json:"columnNumber"`
+				Exception    *struct {
+					Description string      `json:"description"`
+					Value       interface{} `json:"value"`
+				} `json:"exception"`
+				StackTrace *struct {
+					CallFrames []struct {
+						URL string `json:"url"`
+					} `json:"callFrames"`
+				} `json:"stackTrace"`
+			} `json:"exceptionDetails"`
+		}
+		if err := json.Unmarshal(params, &event); err != nil {
+			return
+		}
+
+		details := event.ExceptionDetails
+		exception := PageException{Message: details.Text, URL: details.URL, Line: details.LineNumber + 1, Column: details.ColumnNumber + 1}
+
+		// the description holds the error and its stack, of which
+		// only the first line is kept
+		if details.Exception != nil {
+			if details.Exception.Description != "" {
+				exception.Message = strings.SplitN(details.Exception.Description, "\n", 2)[0]
+			} else if value, ok := details.Exception.Value.(string); ok && value != "" {
+				exception.Message = details.Text + " " + value
+			}
+		}
+
+		if exception.URL == "" && details.StackTrace != nil && len(details.StackTrace.CallFrames) > 0 {
+			exception.URL = details.StackTrace.CallFrames[0].URL
+		}
+
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+
+		if recorder.seen[exception] || len(recorder.exceptions) >= maxExceptions {
+			return
+		}
+		recorder.seen[exception] = true
+		recorder.exceptions = append(recorder.exceptions, exception)
+	})
+
+	if err := tab.call(ctx, "Runtime.enable", nil, nil); err != nil {
+		return nil, err
+	}
+
+	return recorder, nil
+}
+
+// list returns the exceptions recorded so far
+func (recorder *exceptionRecorder) list() []PageException {
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	return append([]PageException(nil), recorder.exceptions...)
+}
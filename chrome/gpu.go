@@ -0,0 +1,47 @@
+package chrome
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Rendering modes of --gpu
+const (
+	GPUOff      string = "off"
+	GPUSoftware string = "swiftshader"
+	GPUHardware string = "hardware"
+)
+
+// gpuArguments are the Chrome arguments of each rendering mode. Off
+// is headless Chrome's usual software compositing, without WebGL.
+// SwiftShader renders WebGL on the CPU, which works anywhere and suits
+// most canvas and WebGL dashboards. Hardware uses the host's GPU, when
+// it has one Chrome can reach, for apps too heavy for SwiftShader.
+var gpuArguments = map[string][]string{
+	GPUOff:      {"--disable-gpu"},
+	GPUSoftware: {"--use-gl=angle", "--use-angle=swiftshader", "--enable-unsafe-swiftshader"},
+	GPUHardware: {"--enable-gpu", "--ignore-gpu-blocklist", "--enable-gpu-rasterization"},
+}
+
+// GPUArguments returns the Chrome arguments of a rendering mode
+func GPUArguments(mode string) ([]string, error) {
+
+	if mode == "" {
+		mode = GPUOff
+	}
+
+	arguments, ok := gpuArguments[mode]
+	if !ok {
+
+		var modes []string
+		for name := range gpuArguments {
+			modes = append(modes, name)
+		}
+		sort.Strings(modes)
+
+		return nil, errors.Errorf("unknown rendering mode %q, use one of %v", mode, modes)
+	}
+
+	return arguments, nil
+}
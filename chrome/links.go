@@ -0,0 +1,32 @@
+package chrome
+
+// AlternateLink is a rel=alternate link to a translation of a page
+type AlternateLink struct {
+	Lang string `json:"hreflang"`
+	Href string `json:"href"`
+}
+
+// PageLinks are the rel=canonical and hreflang alternate links of a
+// page, and the hrefs of its hyperlinks. The hrefs are as written in
+// the page, and may be relative.
+type PageLinks struct {
+	Canonical  string          `json:"canonical"`
+	Alternates []AlternateLink `json:"alternates"`
+	Anchors    []string        `json:"anchors"`
+}
+
+// PageLinksScript evaluates to the PageLinks of a page. The href
+// attributes are read rather than resolved, as the page may have
+// been loaded through the forwarding proxy.
+const PageLinksScript = `(function() {
+	var canonical = document.querySelector("link[rel~='canonical'][href]");
+	var alternates = Array.prototype.slice.call(document.querySelectorAll("link[rel~='alternate'][hreflang][href]"), 0, 100);
+	var anchors = Array.prototype.slice.call(document.querySelectorAll("a[href], area[href]"), 0, 1000);
+	return {
+		canonical: canonical ? canonical.getAttribute("href") : "",
+		alternates: alternates.map(function(link) {
+			return {hreflang: link.getAttribute("hreflang"), href: link.getAttribute("href")};
+		}),
+		anchors: anchors.map(function(anchor) { return anchor.getAttribute("href"); })
+	};
+})()`
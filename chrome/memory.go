@@ -0,0 +1,200 @@
+package chrome
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// memoryPoll is how often memory is checked while a capture
+// is held back by the memory limit
+const memoryPoll = time.Second
+
+// browsers are the browser processes started for running captures
+var browsers = struct {
+	sync.Mutex
+	pids map[int]bool
+}{pids: make(map[int]bool)}
+
+// dispatch lets a single capture at a time check the memory
+// limit and start its browser, so that waiting captures are let
+// through one by one
+var dispatch sync.Mutex
+
+// TrackProcess counts the memory of a browser process, and of the
+// processes it starts, against the memory limit. The returned func
+// stops counting it.
+func TrackProcess(pid int) func() {
+
+	browsers.Lock()
+	browsers.pids[pid] = true
+	browsers.Unlock()
+
+	return func() {
+		browsers.Lock()
+		delete(browsers.pids, pid)
+		browsers.Unlock()
+	}
+}
+
+// MemoryMonitorSupported checks if the memory of processes can be
+// read on this system. Only Linux' /proc is understood.
+func MemoryMonitorSupported() bool {
+
+	_, err := os.Stat("/proc/self/stat")
+	return err == nil
+}
+
+// BrowserMemory returns the resident memory in bytes of the tracked
+// browser processes and all of their children, along with the number
+// of browsers tracked
+func BrowserMemory() (int64, int, error) {
+
+	browsers.Lock()
+	roots := make(map[int]bool, len(browsers.pids))
+	for pid := range browsers.pids {
+		roots[pid] = true
+	}
+	browsers.Unlock()
+
+	if len(roots) == 0 {
+		return 0, 0, nil
+	}
+
+	stats, err := filepath.Glob("/proc/[0-9]*/stat")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	parents := make(map[int]int)
+	resident := make(map[int]int64)
+	for _, stat := range stats {
+
+		pid, ppid, rss, err := readProcStat(stat)
+		if err != nil {
+			// processes exit while we read them
+			continue
+		}
+
+		parents[pid] = ppid
+		resident[pid] = rss
+	}
+
+	var total int64
+	for pid, rss := range resident {
+		if descendsFrom(pid, roots, parents) {
+			total += rss
+		}
+	}
+
+	return total, len(roots), nil
+}
+
+// readProcStat reads the pid, parent pid and resident bytes
+// from a /proc/<pid>/stat file
+func readProcStat(name string) (int, int, int64, error) {
+
+	raw, err := ioutil.ReadFile(name)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	// the command name is in parentheses and may contain spaces,
+	// so the fields are counted from after it
+	stat := string(raw)
+	end := strings.LastIndex(stat, ")")
+	if end < 0 {
+		return 0, 0, 0, os.ErrInvalid
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(stat[:strings.Index(stat, "(")]))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 22 {
+		return 0, 0, 0, os.ErrInvalid
+	}
+
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	pages, err := strconv.ParseInt(fields[21], 10, 64)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return pid, ppid, pages * int64(os.Getpagesize()), nil
+}
+
+// descendsFrom checks if pid is one of roots, or was started by one
+func descendsFrom(pid int, roots map[int]bool, parents map[int]int) bool {
+
+	for depth := 0; pid > 1 && depth < 64; depth++ {
+
+		if roots[pid] {
+			return true
+		}
+
+		pid = parents[pid]
+	}
+
+	return false
+}
+
+// WaitForMemory holds a capture back until the browsers already
+// running leave room for another one under limit bytes. Room is
+// estimated from the average memory of the running browsers. A
+// capture is always let through when no browser is running, so
+// that a scan can not stall.
+//
+// Other captures are held back until the returned func is called,
+// once the browser of this one is tracked, so that they do not all
+// find room for the same browser. Calling it again does nothing.
+func WaitForMemory(limit int64, targetURL string) func() {
+
+	if limit <= 0 {
+		return func() {}
+	}
+
+	dispatch.Lock()
+	var once sync.Once
+	admitted := func() { once.Do(dispatch.Unlock) }
+
+	waiting := false
+	started := time.Now()
+	for {
+
+		used, running, err := BrowserMemory()
+		if err != nil {
+			log.WithField("err", err).Debug("Failed to read browser memory, not limiting this capture")
+			return admitted
+		}
+
+		if running == 0 || used+used/int64(running) <= limit {
+
+			if waiting {
+				log.WithFields(log.Fields{"url": targetURL, "waited": time.Since(started), "memory": used}).
+					Debug("Memory available again, capturing")
+			}
+			return admitted
+		}
+
+		if !waiting {
+			log.WithFields(log.Fields{"url": targetURL, "memory": used, "limit": limit, "browsers": running}).
+				Info("Browsers are near the memory limit, holding captures back")
+			waiting = true
+		}
+
+		time.Sleep(memoryPoll)
+	}
+}
@@ -0,0 +1,28 @@
+package chrome
+
+import (
+	"encoding/json"
+	"sync/atomic"
+)
+
+// DOMNodesScript evaluates to the number of elements in a page
+const DOMNodesScript = `document.getElementsByTagName("*").length`
+
+// watchTransferred sums the bytes transferred over the network for
+// the page in tab, once the Network domain is enabled. The total is
+// read with atomic.LoadInt64.
+func watchTransferred(tab *devtools) *int64 {
+
+	var transferred int64
+	tab.on("Network.loadingFinished", func(params json.RawMessage) {
+
+		var event struct {
+			EncodedDataLength float64 `json:"encodedDataLength"`
+		}
+		if err := json.Unmarshal(params, &event); err == nil {
+			atomic.AddInt64(&transferred, int64(event.EncodedDataLength))
+		}
+	})
+
+	return &transferred
+}
@@ -0,0 +1,77 @@
+package chrome
+
+import (
+	"encoding/json"
+	"net/url"
+	"sync"
+)
+
+// maxMixedContent is the most insecure subresource URLs kept for a page
+const maxMixedContent = 50
+
+// mixedContentRecorder keeps the unique URLs of the insecure
+// subresources a secure page loads
+type mixedContentRecorder struct {
+	mu   sync.Mutex
+	urls []string
+	seen map[string]bool
+}
+
+// watchMixedContent records the subresources loaded over http or ws by
+// the page in tab, once the Network domain is enabled. Secure pages are
+// served to Chrome through the forwarding proxy, so Chrome does not see
+// them as secure and can not report mixed content itself.
+func watchMixedContent(tab *devtools, secure bool) *mixedContentRecorder {
+
+	recorder := &mixedContentRecorder{seen: make(map[string]bool)}
+	if !secure {
+		return recorder
+	}
+
+	record := func(params json.RawMessage) {
+
+		var event struct {
+			URL     string `json:"url"`
+			Request struct {
+				URL string `json:"url"`
+			} `json:"request"`
+		}
+		if err := json.Unmarshal(params, &event); err != nil {
+			return
+		}
+
+		resource := event.Request.URL
+		if resource == "" {
+			resource = event.URL
+		}
+
+		// requests to the proxy are really made over https
+		u, err := url.Parse(resource)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "ws") || u.Hostname() == listeningURL {
+			return
+		}
+
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+
+		if recorder.seen[resource] || len(recorder.urls) >= maxMixedContent {
+			return
+		}
+		recorder.seen[resource] = true
+		recorder.urls = append(recorder.urls, resource)
+	}
+
+	tab.on("Network.requestWillBeSent", record)
+	tab.on("Network.webSocketCreated", record)
+
+	return recorder
+}
+
+// list returns the insecure subresource URLs recorded so far
+func (recorder *mixedContentRecorder) list() []string {
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	return append([]string(nil), recorder.urls...)
+}
@@ -15,6 +15,7 @@ const listeningURL string = "127.0.0.1"
 
 type forwardingProxy struct {
 	targetURL *url.URL
+	resolve   []ResolvePin
 	server    *httputil.ReverseProxy
 	listener  net.Listener
 	port      int
@@ -27,11 +28,14 @@ func (proxy *forwardingProxy) start() error {
 	// *Dont* verify remote certificates.
 	transport := &http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		DialContext:     pinnedDialer(proxy.resolve),
 	}
 
-	// Start the proxy and assign our custom Transport
-	proxy.targetURL.Path = "/" // set the path to / as this becomes the base path
-	proxy.server = httputil.NewSingleHostReverseProxy(proxy.targetURL)
+	// Start the proxy and assign our custom Transport. The base path is
+	// set on a copy, as the caller goes on to use its URL.
+	base := *proxy.targetURL
+	base.Path, base.RawPath, base.RawQuery = "/", "", ""
+	proxy.server = httputil.NewSingleHostReverseProxy(&base)
 	proxy.server.Transport = transport
 
 	// Get an open port for this proxy instance to run on.
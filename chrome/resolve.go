@@ -0,0 +1,98 @@
+package chrome
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ResolvePin connects to Address instead of the address Host resolves
+// to, for connections to Port. Host and Port may be *, matching any.
+// The hostname is still used for SNI and the Host header, so that TLS
+// virtual hosts sharing an address can each be captured.
+type ResolvePin struct {
+	Host    string
+	Port    string
+	Address string
+}
+
+// ParseResolve parses a host:port:address pin, in the style of curl's
+// --resolve. IPv6 addresses are written in brackets.
+func ParseResolve(entry string) (ResolvePin, error) {
+
+	parts := strings.SplitN(entry, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return ResolvePin{}, errors.Errorf("invalid resolve entry %q, use host:port:address", entry)
+	}
+
+	address := strings.TrimSuffix(strings.TrimPrefix(parts[2], "["), "]")
+	if net.ParseIP(address) == nil {
+		return ResolvePin{}, errors.Errorf("invalid resolve entry %q, %q is not an IP address", entry, address)
+	}
+
+	return ResolvePin{Host: strings.ToLower(parts[0]), Port: parts[1], Address: address}, nil
+}
+
+// matches checks if the pin applies to a connection to host:port
+func (pin ResolvePin) matches(host string, port string) bool {
+
+	return (pin.Host == "*" || pin.Host == strings.ToLower(host)) && (pin.Port == "*" || pin.Port == port)
+}
+
+// PinnedAddress returns the address pinned for connections to host:port,
+// or an empty string when none is
+func PinnedAddress(pins []ResolvePin, host string, port string) string {
+
+	for _, pin := range pins {
+		if pin.matches(host, port) {
+			return pin.Address
+		}
+	}
+
+	return ""
+}
+
+// pinnedDialer returns a DialContext connecting to the pinned
+// address of a host, if it has one
+func pinnedDialer(pins []ResolvePin) func(ctx context.Context, network, address string) (net.Conn, error) {
+
+	var dialer net.Dialer
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+
+		if host, port, err := net.SplitHostPort(address); err == nil {
+			if pinned := PinnedAddress(pins, host, port); pinned != "" {
+				address = net.JoinHostPort(pinned, port)
+			}
+		}
+
+		return dialer.DialContext(ctx, network, address)
+	}
+}
+
+// hostResolverRules returns the pins as the value of Chrome's
+// --host-resolver-rules flag
+func hostResolverRules(pins []ResolvePin) string {
+
+	var rules []string
+	for _, pin := range pins {
+
+		address := pin.Address
+		if strings.Contains(address, ":") {
+			address = "[" + address + "]"
+		}
+
+		pattern := pin.Host
+		if pin.Port != "*" {
+			pattern += ":" + pin.Port
+		}
+
+		rules = append(rules, "MAP "+pattern+" "+address)
+	}
+
+	// the forwarding proxy must still be reachable
+	rules = append(rules, "EXCLUDE "+listeningURL)
+
+	return strings.Join(rules, ", ")
+}
@@ -0,0 +1,38 @@
+package chrome
+
+import (
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// sandboxFailure matches the lines Chrome writes to stderr when it can
+// not start its sandbox, and exits
+var sandboxFailure = regexp.MustCompile(`(?i)no usable sandbox|without --no-sandbox`)
+
+// SandboxUnavailable returns why Chrome's sandbox can not work here,
+// or an empty string when it should. Chrome refuses to run sandboxed
+// as root, and containers usually lack the namespaces it relies on.
+func SandboxUnavailable() string {
+
+	if os.Geteuid() == 0 {
+		return "running as root"
+	}
+
+	for _, marker := range []string{"/.dockerenv", "/run/.containerenv"} {
+		if _, err := os.Stat(marker); err == nil {
+			return "running in a container"
+		}
+	}
+
+	if cgroup, err := ioutil.ReadFile("/proc/1/cgroup"); err == nil {
+		for _, runtime := range []string{"docker", "kubepods", "containerd", "lxc"} {
+			if strings.Contains(string(cgroup), runtime) {
+				return "running in a container"
+			}
+		}
+	}
+
+	return ""
+}
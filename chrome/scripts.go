@@ -0,0 +1,53 @@
+package chrome
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// initScripts returns the scripts that should be evaluated in every
+// new document, before any of the page's own scripts run.
+func (chrome *Chrome) initScripts() []string {
+
+	var scripts []string
+
+	if len(chrome.LocalStorage) > 0 || len(chrome.SessionStorage) > 0 {
+		scripts = append(scripts, storageScript(chrome.LocalStorage, chrome.SessionStorage))
+	}
+
+	if chrome.ReducedMotion {
+		scripts = append(scripts, ReducedMotionScript)
+	}
+
+	return scripts
+}
+
+// storageScript returns a script that populates the localStorage
+// and sessionStorage of a document.
+func storageScript(local map[string]string, session map[string]string) string {
+
+	localJSON, _ := json.Marshal(local)
+	sessionJSON, _ := json.Marshal(session)
+
+	return fmt.Sprintf(`(function(local, session) {
+	try { for (var k in local) { window.localStorage.setItem(k, local[k]); } } catch (e) {}
+	try { for (var k in session) { window.sessionStorage.setItem(k, session[k]); } } catch (e) {}
+})(%s, %s)`, localJSON, sessionJSON)
+}
+
+// ReducedMotionScript adds a stylesheet that disables CSS animations
+// and transitions. The document may not have an element to add it to
+// yet, so it is retried once the DOM is ready.
+const ReducedMotionScript = `(function() {
+	var add = function() {
+		var root = document.head || document.documentElement;
+		if (!root || document.getElementById("gowitness-reduced-motion")) { return; }
+		var style = document.createElement("style");
+		style.id = "gowitness-reduced-motion";
+		style.textContent = "*, *::before, *::after { animation: none !important; transition: none !important; " +
+			"scroll-behavior: auto !important; caret-color: transparent !important; }";
+		root.appendChild(style);
+	};
+	add();
+	document.addEventListener("DOMContentLoaded", add);
+})()`
@@ -0,0 +1,61 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// scrollSettle is how long to wait for a scroll to trigger
+// new requests before giving up on the page loading more
+const scrollSettle = 2 * time.Second
+
+// scrollForRequests scrolls to the bottom of the page until
+// ScrollRequests additional network requests have been made, the
+// page stops loading more, or MaxScrolls is reached. The number of
+// scrolls is recorded in the result.
+func (chrome *Chrome) scrollForRequests(ctx context.Context, tab *devtools, result *ScreenshotResult) error {
+
+	var requests int64
+	tab.on("Network.requestWillBeSent", func(params json.RawMessage) {
+		atomic.AddInt64(&requests, 1)
+	})
+
+	if err := tab.call(ctx, "Network.enable", nil, nil); err != nil {
+		return err
+	}
+
+	for result.ScrollIterations < chrome.MaxScrolls && atomic.LoadInt64(&requests) < int64(chrome.ScrollRequests) {
+
+		before := atomic.LoadInt64(&requests)
+		if err := tab.evaluate(ctx, "window.scrollTo(0, document.body.scrollHeight)", nil); err != nil {
+			return err
+		}
+		result.ScrollIterations++
+
+		// wait for the scroll to trigger something
+		deadline := time.Now().Add(scrollSettle)
+		for atomic.LoadInt64(&requests) == before && time.Now().Before(deadline) {
+
+			select {
+			case <-time.After(100 * time.Millisecond):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if atomic.LoadInt64(&requests) == before {
+			log.WithField("scrolls", result.ScrollIterations).Debug("Scrolling stopped triggering requests")
+			break
+		}
+	}
+
+	log.WithFields(log.Fields{"scrolls": result.ScrollIterations, "requests": atomic.LoadInt64(&requests)}).
+		Debug("Finished scrolling")
+
+	// the screenshot starts at the top of the page
+	return tab.evaluate(ctx, "window.scrollTo(0, 0)", nil)
+}
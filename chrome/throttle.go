@@ -0,0 +1,42 @@
+package chrome
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NetworkConditions are the parameters of Chrome's network emulation.
+// Throughputs are in bytes per second, and latency in milliseconds.
+type NetworkConditions struct {
+	Offline            bool    `json:"offline"`
+	Latency            float64 `json:"latency"`
+	DownloadThroughput float64 `json:"downloadThroughput"`
+	UploadThroughput   float64 `json:"uploadThroughput"`
+}
+
+// ThrottlePresets are the network conditions that can be emulated,
+// matching the presets of Chrome's DevTools
+var ThrottlePresets = map[string]NetworkConditions{
+	"slow-3g": {Latency: 2000, DownloadThroughput: 500 * 1000 / 8 * 0.8, UploadThroughput: 500 * 1000 / 8 * 0.8},
+	"fast-3g": {Latency: 562.5, DownloadThroughput: 1.6 * 1000 * 1000 / 8 * 0.9, UploadThroughput: 750 * 1000 / 8 * 0.9},
+}
+
+// ParseThrottle returns the network conditions of a throttle preset
+func ParseThrottle(name string) (NetworkConditions, error) {
+
+	conditions, ok := ThrottlePresets[strings.ToLower(name)]
+	if !ok {
+
+		var names []string
+		for preset := range ThrottlePresets {
+			names = append(names, preset)
+		}
+		sort.Strings(names)
+
+		return NetworkConditions{}, errors.Errorf("unknown throttle %q, use one of %s", name, strings.Join(names, ", "))
+	}
+
+	return conditions, nil
+}
@@ -0,0 +1,65 @@
+package chrome
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// PageHeightScript evaluates to the height of the whole page
+const PageHeightScript = `Math.max(document.documentElement.scrollHeight, document.body ? document.body.scrollHeight : 0)`
+
+// TileFile returns the path of the nth viewport tile of a page, next
+// to the screenshot, which is the first tile
+func TileFile(screenshot string, n int) string {
+
+	extension := filepath.Ext(screenshot)
+	return strings.TrimSuffix(screenshot, extension) + "-tile-" + strconv.Itoa(n) + extension
+}
+
+// writeTiles captures the viewport height tiles below the first one,
+// which is the screenshot, up to Tiles tiles or the end of the page
+func (chrome *Chrome) writeTiles(ctx context.Context, tab *devtools, destination string, result *ScreenshotResult) error {
+
+	var pageHeight int
+	if err := tab.evaluate(ctx, PageHeightScript, &pageHeight); err != nil {
+		return err
+	}
+
+	width, height := chrome.viewport()
+	for n := 2; n <= chrome.Tiles; n++ {
+
+		top := (n - 1) * height
+		if top >= pageHeight {
+			break
+		}
+
+		tileHeight := height
+		if pageHeight-top < tileHeight {
+			tileHeight = pageHeight - top
+		}
+
+		params := map[string]interface{}{
+			"format": "png",
+			"clip": map[string]interface{}{
+				"x": 0, "y": top, "width": width, "height": tileHeight, "scale": 1,
+			},
+			"captureBeyondViewport": true,
+		}
+
+		tile := TileFile(destination, n)
+		if err := saveScreenshot(ctx, tab, params, tile); err != nil {
+			return err
+		}
+
+		result.TileFiles = append(result.TileFiles, tile)
+	}
+
+	log.WithFields(log.Fields{"destination": destination, "tiles": len(result.TileFiles) + 1, "page-height": pageHeight}).
+		Debug("Captured viewport tiles")
+
+	return nil
+}
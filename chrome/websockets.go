@@ -0,0 +1,59 @@
+package chrome
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+// maxWebSockets is the most WebSocket URLs kept for a page, so
+// that chatty pages reconnecting in a loop stay bounded
+const maxWebSockets = 50
+
+// webSocketRecorder keeps the unique URLs of the WebSockets a
+// page opens
+type webSocketRecorder struct {
+	mu   sync.Mutex
+	urls []string
+	seen map[string]bool
+}
+
+// watchWebSockets records the WebSockets the page in tab opens
+// from now on.
+func watchWebSockets(ctx context.Context, tab *devtools) (*webSocketRecorder, error) {
+
+	recorder := &webSocketRecorder{seen: make(map[string]bool)}
+	tab.on("Network.webSocketCreated", func(params json.RawMessage) {
+
+		var event struct {
+			URL string `json:"url"`
+		}
+		if err := json.Unmarshal(params, &event); err != nil {
+			return
+		}
+
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+
+		if recorder.seen[event.URL] || len(recorder.urls) >= maxWebSockets {
+			return
+		}
+		recorder.seen[event.URL] = true
+		recorder.urls = append(recorder.urls, event.URL)
+	})
+
+	if err := tab.call(ctx, "Network.enable", nil, nil); err != nil {
+		return nil, err
+	}
+
+	return recorder, nil
+}
+
+// list returns the WebSocket URLs recorded so far
+func (recorder *webSocketRecorder) list() []string {
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	return append([]string(nil), recorder.urls...)
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/RiskSense-Ops/gowitness/utils"
+)
+
+// exportCertificates writes the certificate chains of entries as PEM,
+// all to stdout, or each to its own file in dir when one is given.
+// Entries captured before chains were stored as PEM are left out.
+func exportCertificates(entries []storage.HTTResponse, dir string) error {
+
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+
+	written := 0
+	names := make(map[string]int)
+	for i := range entries {
+
+		entry := &entries[i]
+		if !hasCertificatePEM(entry) {
+			continue
+		}
+
+		if dir == "" {
+			if err := writeCertificateChain(os.Stdout, entry); err != nil {
+				return err
+			}
+			written++
+			continue
+		}
+
+		name := certificateFileName(entry)
+		names[name]++
+		if names[name] > 1 {
+			name = fmt.Sprintf("%s-%d", name, names[name])
+		}
+
+		file, err := os.Create(filepath.Join(dir, name+".pem"))
+		if err != nil {
+			return err
+		}
+
+		err = writeCertificateChain(file, entry)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+		written++
+	}
+
+	log.WithFields(log.Fields{"entries": len(entries), "chains": written}).Debug("Exported certificate chains")
+
+	return nil
+}
+
+// hasCertificatePEM reports whether any certificate of the entry was
+// stored as PEM
+func hasCertificatePEM(entry *storage.HTTResponse) bool {
+
+	for _, certificate := range entry.SSL.PeerCertificates {
+		if certificate.PEM != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeCertificateChain writes the chain of entry as PEM, each
+// certificate preceded by its subject and issuer. PEM readers skip
+// these lines, along with the URL heading the chain.
+func writeCertificateChain(w io.Writer, entry *storage.HTTResponse) error {
+
+	url := entry.FinalURL
+	if url == "" {
+		url = entry.URL
+	}
+
+	if _, err := fmt.Fprintf(w, "# %s\n", url); err != nil {
+		return err
+	}
+
+	for _, certificate := range entry.SSL.PeerCertificates {
+
+		if certificate.PEM == "" {
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, "# subject: %s\n# issuer: %s\n%s", certificate.Subject, certificate.Issuer, certificate.PEM); err != nil {
+			return err
+		}
+	}
+
+	if entry.SSL.ChainIncomplete {
+		if _, err := fmt.Fprintln(w, "# chain stops short of a trusted root"); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintln(w)
+
+	return err
+}
+
+// certificateFileName names the PEM file of entry after its screenshot,
+// or its URL when it has none
+func certificateFileName(entry *storage.HTTResponse) string {
+
+	if entry.ScreenshotFile != "" {
+		base := filepath.Base(entry.ScreenshotFile)
+		return strings.TrimSuffix(base, filepath.Ext(base))
+	}
+
+	return utils.SafeFileName(entry.URL)
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"bytes"
+	"math/big"
+	"net"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	gwtmpl "github.com/RiskSense-Ops/gowitness/template"
+	"github.com/RiskSense-Ops/gowitness/utils"
+)
+
+// maxCoverageAddresses is the largest range shown in the coverage
+// report, a /20 for IPv4, so that the grid stays readable
+const maxCoverageAddresses = 4096
+
+// States of an address in the coverage report
+const (
+	coverageService string = "service"
+	coverageFailed  string = "failed"
+	coverageNone    string = "none"
+)
+
+// coverageRange is a range of the coverage report, with a cell per
+// address in it
+type coverageRange struct {
+	CIDR      string
+	Addresses int
+	Services  int
+	Failed    int
+	Cells     []coverageCell
+}
+
+// coverageCell is an address of a coverage range. Link opens the
+// screenshot of its first capture, when there is one.
+type coverageCell struct {
+	IP    string
+	State string
+	Link  string
+	URLs  []string
+}
+
+// coverageAddresses returns every address of cidr, including its
+// network and broadcast addresses so that the grid rows line up
+func coverageAddresses(cidr string) ([]net.IP, error) {
+
+	// single addresses are ranges of one, as they are for scan
+	if !strings.Contains(cidr, "/") {
+		if strings.Contains(cidr, ":") {
+			cidr = cidr + "/128"
+		} else {
+			cidr = cidr + "/32"
+		}
+	}
+
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	ones, bits := network.Mask.Size()
+	if size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)); size.Cmp(big.NewInt(maxCoverageAddresses)) > 0 {
+		return nil, errors.Errorf("%s has more than %d addresses", cidr, maxCoverageAddresses)
+	}
+
+	var addresses []net.IP
+	for ip = ip.Mask(network.Mask); network.Contains(ip); ip = nextIP(ip) {
+		addresses = append(addresses, ip)
+	}
+
+	return addresses, nil
+}
+
+// nextIP returns the address after ip
+func nextIP(ip net.IP) net.IP {
+
+	next := append(net.IP(nil), ip...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] > 0 {
+			break
+		}
+	}
+
+	return next
+}
+
+// buildCoverage returns a range per cidr, marking the addresses that
+// entries were captured from. An entry belongs to the address in its
+// URL, or to those its hostname resolved to.
+func buildCoverage(cidrs []string, entries []storage.HTTResponse, screenshotPrefix string) []coverageRange {
+
+	byAddress := make(map[string][]storage.HTTResponse)
+	for _, entry := range entries {
+
+		u, err := url.Parse(entry.URL)
+		if err != nil {
+			continue
+		}
+
+		addresses := entry.Addresses
+		if ip := net.ParseIP(u.Hostname()); ip != nil {
+			addresses = []string{u.Hostname()}
+		}
+
+		for _, address := range addresses {
+			if ip := net.ParseIP(address); ip != nil {
+				byAddress[ip.String()] = append(byAddress[ip.String()], entry)
+			}
+		}
+	}
+
+	var ranges []coverageRange
+	for _, cidr := range cidrs {
+
+		addresses, err := coverageAddresses(cidr)
+		if err != nil {
+			log.WithFields(log.Fields{"cidr": cidr, "err": err}).Warn("Skipping a range of the coverage report")
+			continue
+		}
+
+		coverage := coverageRange{CIDR: cidr, Addresses: len(addresses)}
+		for _, ip := range addresses {
+
+			cell := coverageCell{IP: ip.String(), State: coverageNone}
+			for _, entry := range byAddress[cell.IP] {
+
+				cell.URLs = append(cell.URLs, entry.URL)
+				if entry.ResponseCode == 0 {
+					if cell.State == coverageNone {
+						cell.State = coverageFailed
+					}
+					continue
+				}
+
+				cell.State = coverageService
+				if cell.Link == "" && entry.ScreenshotFile != "" {
+					if screenshot := resolveScreenshot(entry.ScreenshotFile); screenshot != gwtmpl.PlaceHolderImage {
+						cell.Link = reportScreenshot(screenshot, screenshotPrefix)
+					}
+				}
+			}
+
+			switch cell.State {
+			case coverageService:
+				coverage.Services++
+			case coverageFailed:
+				coverage.Failed++
+			}
+
+			coverage.Cells = append(coverage.Cells, cell)
+		}
+
+		ranges = append(ranges, coverage)
+	}
+
+	return ranges
+}
+
+// writeCoverageReport writes coverage.html, showing a grid of the
+// addresses of each of cidrs colored by whether a web service was
+// found on them
+func writeCoverageReport(reportDir string, cidrs []string, screenshotPrefix string) {
+
+	entries, err := db.GetHTTPData()
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to read entries for the coverage report")
+	}
+
+	tmpl, err := template.New("coverage-page").Parse(gwtmpl.CoverageContent)
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to parse coverage template")
+	}
+
+	ranges := buildCoverage(cidrs, entries, screenshotPrefix)
+
+	var page bytes.Buffer
+	if err := tmpl.Execute(&page, struct{ Ranges []coverageRange }{ranges}); err != nil {
+		log.WithField("err", err).Fatal("Failed to render coverage template")
+	}
+
+	coverageFile := filepath.Join(reportDir, "coverage.html")
+	if err := utils.WriteFileAtomic(coverageFile, page.Bytes(), 0640); err != nil {
+		log.WithField("err", err).Fatal("Failed to write coverage report")
+	}
+
+	log.WithFields(log.Fields{"report-file": coverageFile, "ranges": len(ranges)}).Info("Coverage report generated")
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// crawlLinks captures the links the captures so far queued with
+// --crawl-depth, a depth at a time, until there are none left
+func crawlLinks() {
+
+	if options.Crawler == nil {
+		return
+	}
+
+	for {
+
+		links := options.Crawler.Next()
+		if len(links) == 0 {
+			return
+		}
+
+		var targets []fileTarget
+		for _, link := range links {
+			targets = append(targets, fileTarget{url: link.URL, depth: link.Depth})
+		}
+
+		depth := links[0].Depth
+		log.WithFields(log.Fields{"depth": depth, "max-depth": options.Crawler.MaxDepth, "links": len(targets)}).Info("Crawling links")
+		captureFileTargets(targets, fmt.Sprintf("Crawling links (depth %d/%d)", depth, options.Crawler.MaxDepth))
+	}
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/RiskSense-Ops/gowitness/utils"
+)
+
+// dedupeFileTargets removes targets whose URL was already seen,
+// keeping the first. Nothing is removed with --allow-duplicates.
+func dedupeFileTargets(targets []fileTarget) []fileTarget {
+
+	if allowDuplicates {
+		return targets
+	}
+
+	seen := make(map[string]bool)
+	unique := targets[:0]
+	for _, target := range targets {
+
+		key := utils.NormalizeURL(target.url)
+		if seen[key] {
+			skipTarget(target.url.String(), storage.SkipDuplicate)
+			continue
+		}
+
+		seen[key] = true
+		unique = append(unique, target)
+	}
+
+	logDuplicates(len(targets) - len(unique))
+
+	return unique
+}
+
+// dedupePermutations removes repeated scan permutations, such as
+// those from overlapping CIDR ranges, keeping the first
+func dedupePermutations(permutations []string) []string {
+
+	if allowDuplicates {
+		return permutations
+	}
+
+	seen := make(map[string]bool)
+	var unique []string
+	for _, permutation := range permutations {
+
+		key := permutation
+		if u, err := url.Parse(permutation); err == nil {
+			key = utils.NormalizeURL(u)
+		}
+
+		if seen[key] {
+			skipTarget(permutation, storage.SkipDuplicate)
+			continue
+		}
+
+		seen[key] = true
+		unique = append(unique, permutation)
+	}
+
+	logDuplicates(len(permutations) - len(unique))
+
+	return unique
+}
+
+func logDuplicates(removed int) {
+
+	if removed > 0 {
+		log.WithField("duplicates", removed).Info("Removed duplicate targets (use --allow-duplicates to keep them)")
+	}
+}
+
+// --one-per-host-keep choices of the URL kept for a host
+const (
+	hostKeepRoot  string = "root"
+	hostKeepFirst string = "first"
+)
+
+// onePerHost returns the indexes of the URLs kept when sampling one
+// URL per hostname. The first URL of a host is kept, unless keeping
+// the root, when the first of its URLs with the path / and no query
+// is preferred.
+func onePerHost(urls []*url.URL, keep string) []int {
+
+	kept := make(map[string]int)
+	var hosts []string
+	for i, u := range urls {
+
+		host := strings.ToLower(u.Hostname())
+		first, seen := kept[host]
+		if !seen {
+			kept[host] = i
+			hosts = append(hosts, host)
+			continue
+		}
+
+		if keep == hostKeepRoot && !isRootURL(urls[first]) && isRootURL(u) {
+			kept[host] = i
+		}
+	}
+
+	indexes := make([]int, 0, len(hosts))
+	for _, host := range hosts {
+		indexes = append(indexes, kept[host])
+	}
+	sort.Ints(indexes)
+
+	log.WithFields(log.Fields{"hosts": len(hosts), "left-out": len(urls) - len(hosts)}).Info("Keeping one URL per host")
+
+	return indexes
+}
+
+// isRootURL checks if a URL is the root of its host
+func isRootURL(u *url.URL) bool {
+
+	return (u.Path == "" || u.Path == "/") && u.RawQuery == ""
+}
+
+// sampleFileTargets keeps one of the targets of every host
+func sampleFileTargets(targets []fileTarget, keep string) []fileTarget {
+
+	var urls []*url.URL
+	for _, target := range targets {
+		urls = append(urls, target.url)
+	}
+
+	kept := make(map[int]bool)
+	var sampled []fileTarget
+	for _, i := range onePerHost(urls, keep) {
+		kept[i] = true
+		sampled = append(sampled, targets[i])
+	}
+
+	for i, target := range targets {
+		if !kept[i] {
+			skipTarget(target.url.String(), storage.SkipOnePerHost)
+		}
+	}
+
+	return sampled
+}
+
+// samplePermutations keeps one of the scan permutations of every host
+func samplePermutations(permutations []string) []string {
+
+	var urls []*url.URL
+	var valid []string
+	for _, permutation := range permutations {
+		if u, err := url.Parse(permutation); err == nil {
+			urls = append(urls, u)
+			valid = append(valid, permutation)
+		}
+	}
+
+	kept := make(map[int]bool)
+	var sampled []string
+	for _, i := range onePerHost(urls, hostKeepFirst) {
+		kept[i] = true
+		sampled = append(sampled, valid[i])
+	}
+
+	for i, permutation := range valid {
+		if !kept[i] {
+			skipTarget(permutation, storage.SkipOnePerHost)
+		}
+	}
+
+	return sampled
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/tls"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	gwtmpl "github.com/RiskSense-Ops/gowitness/template"
+	"github.com/RiskSense-Ops/gowitness/utils"
+)
+
+// Report layouts of the generate command
+const (
+	layoutGrid     string = "grid"
+	layoutEvidence string = "evidence"
+)
+
+// evidenceEntry is an entry of the evidence report, along with the
+// details of its caption that are not stored as they are shown
+type evidenceEntry struct {
+	storage.HTTResponse
+	Number int
+	Server string
+	TLS    string
+}
+
+// evidenceTLS describes the certificate and cipher suite of an entry,
+// or returns an empty string when it was not captured over TLS
+func evidenceTLS(entry storage.HTTResponse) string {
+
+	if len(entry.SSL.PeerCertificates) == 0 {
+		return ""
+	}
+
+	leaf := entry.SSL.PeerCertificates[0]
+	parts := []string{"subject " + leaf.SubjectCommonName, "issued by " + leaf.IssuerCommonName}
+	if entry.SSL.Validity != "" {
+		parts = append(parts, "certificate "+entry.SSL.Validity)
+	}
+	if entry.SSL.CipherSuite != 0 {
+		parts = append(parts, tls.CipherSuiteName(entry.SSL.CipherSuite))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// writeEvidenceReport writes evidence.html, a single document showing
+// one capture per printed page with a full caption, for including in
+// deliverables. The entries' screenshots must already be resolved.
+func writeEvidenceReport(reportDir string, entries []storage.HTTResponse) {
+
+	var evidence []evidenceEntry
+	for i, entry := range entries {
+
+		item := evidenceEntry{HTTResponse: entry, Number: i + 1, TLS: evidenceTLS(entry)}
+		for _, header := range entry.Headers {
+			if strings.ToLower(header.Key) == "server" {
+				item.Server = header.Value
+			}
+		}
+
+		evidence = append(evidence, item)
+	}
+
+	tmpl, err := template.New("evidence-page").Parse(gwtmpl.EvidenceContent)
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to parse evidence template")
+	}
+
+	var page bytes.Buffer
+	if err := tmpl.Execute(&page, struct{ Entries []evidenceEntry }{evidence}); err != nil {
+		log.WithField("err", err).Fatal("Failed to render evidence template")
+	}
+
+	evidenceFile := filepath.Join(reportDir, "evidence.html")
+	if err := utils.WriteFileAtomic(evidenceFile, page.Bytes(), 0640); err != nil {
+		log.WithField("err", err).Fatal("Failed to write evidence report")
+	}
+
+	log.WithFields(log.Fields{"report-file": evidenceFile, "entries": len(evidence)}).Info("Evidence report generated")
+}
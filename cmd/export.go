@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export results from a database file",
+	Long: `
+Export the results found in a gowitness.db file, optionally filtered
+by response code and detected technology.
+
+The urls format prints only the final URL of each matching entry,
+one per line, which is useful to feed into other tools.
+
+The csv format prints a summary of each entry. With --spreadsheet
+excel or sheets its screenshot column holds a formula linking to the
+screenshot, so that the file can be opened straight in a spreadsheet
+for triage. Screenshots are linked as local files, or below
+--screenshot-base-url, which Google Sheets needs to show them as
+thumbnails.
+
+The tree format prints the entries as JSON nested by host and then by
+path segment, with the number of captures below each node. This is
+handy to review the structure of --paths and crawled scans.
+
+The pem format prints the certificate chain each https entry was
+presented, leaf first, preceded by the URL and the subject and issuer
+of each certificate. With --pem-dir each chain is written to its own
+file instead, named after the entry's screenshot.
+
+With --skipped the targets that were not captured are exported instead,
+along with why: invalid-url, duplicate, one-per-host, disk-limit,
+match-body or unchanged. A target captured later is no longer listed.
+Their urls can be given straight back to the file command to try them
+again.
+
+Targets that could not be reached are not skipped: they are stored as
+entries with their error, and listed in the errors.html report of the
+generate command. Nothing is skipped for being out of scope, as scans
+capture every target given (check them with scope-check first), nor for
+returning a soft 404, which is captured like any other page.
+
+For example:
+
+$ gowitness export --format urls --status 200
+$ gowitness export --format urls --status 200 --technology WordPress
+$ gowitness export --format json --technology Jenkins > jenkins.json
+$ gowitness export --format tree --status 200 > tree.json
+$ gowitness export --format pem > chains.pem
+$ gowitness export --format pem --pem-dir chains/
+$ gowitness export --format csv --spreadsheet excel > triage.csv
+$ gowitness export --format csv --spreadsheet sheets --screenshot-base-url https://cdn.example.com/screenshots > triage.csv
+$ gowitness export --format urls --directory-listing
+$ gowitness export --format urls --auth-scheme Basic --auth-scheme Digest
+$ gowitness export --skipped --format json > skipped.json
+$ gowitness export --skipped --skip-reason disk-limit > retry.txt && gowitness file -s retry.txt`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		if exportSkipped {
+			exportSkippedTargets()
+			return
+		}
+
+		entries, err := db.GetHTTPData()
+		if err != nil {
+			log.WithField("err", err).Fatal("Failed to read entries from the database")
+		}
+
+		if exportSpreadsheet != "" && exportFormat != "csv" {
+			log.WithField("format", exportFormat).Fatal("--spreadsheet requires the csv format")
+		}
+		if exportSpreadsheet != "" && exportSpreadsheet != spreadsheetExcel && exportSpreadsheet != spreadsheetSheets {
+			log.WithField("spreadsheet", exportSpreadsheet).Fatal("Invalid spreadsheet provided. Use excel or sheets")
+		}
+
+		filtered := exportFilter.filter(entries)
+
+		log.WithFields(log.Fields{"total": len(entries), "matched": len(filtered)}).Debug("Filtered entries to export")
+
+		switch exportFormat {
+
+		case "urls":
+			for _, entry := range filtered {
+
+				// entries that failed never reached a final URL
+				if entry.FinalURL == "" {
+					continue
+				}
+
+				fmt.Println(entry.FinalURL)
+			}
+
+		case "json":
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(filtered); err != nil {
+				log.WithField("err", err).Fatal("Failed to encode entries")
+			}
+
+		case "csv":
+			if err := writeEntriesCSV(os.Stdout, filtered, exportSpreadsheet); err != nil {
+				log.WithField("err", err).Fatal("Failed to write entries as CSV")
+			}
+
+		case "pem":
+			if err := exportCertificates(filtered, exportPEMDir); err != nil {
+				log.WithField("err", err).Fatal("Failed to export certificate chains")
+			}
+
+		case "tree":
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(buildSitemap(filtered)); err != nil {
+				log.WithField("err", err).Fatal("Failed to encode entries")
+			}
+
+		default:
+			log.WithField("format", exportFormat).Fatal("Invalid export format. Use urls, json, csv, tree or pem")
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "f", "urls", "Export format (urls, json, csv, tree or pem)")
+	exportCmd.Flags().StringVarP(&exportPEMDir, "pem-dir", "", "", "With the pem format, write the certificate chain of each entry to its own file in this directory")
+	exportCmd.Flags().StringVarP(&exportSpreadsheet, "spreadsheet", "", "", "Write the csv screenshot column as formulas for this spreadsheet (excel or sheets)")
+	exportCmd.Flags().StringVarP(&exportScreenshotBaseURL, "screenshot-base-url", "", "", "URL the spreadsheet should link screenshots below, instead of their local files")
+	exportCmd.Flags().BoolVarP(&exportSkipped, "skipped", "", false, "Export the targets that were skipped instead of captured, and why")
+	exportCmd.Flags().StringSliceVarP(&exportSkipReasons, "skip-reason", "", []string{}, "Only export skipped targets with this reason, eg: duplicate or disk-limit (Can specify more than one --skip-reason)")
+	exportCmd.Flags().IntSliceVarP(&exportFilter.Status, "status", "s", []int{}, "Only export entries with this response code (Can specify more than one --status)")
+	exportCmd.Flags().StringSliceVarP(&exportFilter.Technology, "technology", "", []string{}, "Only export entries with this detected technology (Can specify more than one --technology)")
+	exportCmd.Flags().BoolVarP(&exportFilter.MixedContent, "mixed-content", "", false, "Only export https entries that loaded insecure subresources")
+	exportCmd.Flags().BoolVarP(&exportFilter.AuthRequired, "auth-required", "", false, "Only export entries that answered 401, asking for authentication")
+	exportCmd.Flags().StringSliceVarP(&exportFilter.AuthScheme, "auth-scheme", "", []string{}, "Only export entries asking for this authentication scheme, eg: Basic or NTLM (Can specify more than one --auth-scheme)")
+	exportCmd.Flags().BoolVarP(&exportFilter.DirectoryListing, "directory-listing", "", false, "Only export entries that are directory listings")
+	exportCmd.Flags().StringSliceVarP(&exportFilter.Lang, "lang", "", []string{}, "Only export entries with this page language, eg: en or pt-BR (Can specify more than one --lang)")
+}
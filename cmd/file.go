@@ -2,17 +2,26 @@ package cmd
 
 import (
 	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"sync/atomic"
 	"text/template"
 	"time"
 
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
 	"github.com/reconquest/barely"
 	log "github.com/sirupsen/logrus"
 
-	"github.com/remeh/sizedwaitgroup" // <3
+	"github.com/RiskSense-Ops/gowitness/storage"
 	"github.com/RiskSense-Ops/gowitness/utils"
+	"github.com/remeh/sizedwaitgroup" // <3
 	"github.com/spf13/cobra"
 )
 
@@ -24,82 +33,502 @@ var fileCmd = &cobra.Command{
 Screenshot URLs sourced from a file. URLs in the source file should be
 newline separated. Invalid URLs are simply logged and ignored.
 
+Source files with a .csv extension are read as CSV, with the URL in
+the first column and an optional per-target timeout (in seconds) in
+the second column that overrides --timeout. A header row naming the
+url and timeout columns may be used instead.
+
+Source files with a .jsonl extension are read as one JSON object per
+line, holding the url and optionally the timeout, resolution,
+user_agent, headers and cookies to capture it with. Settings that are
+not specified default to the global flags.
+
+Lines without a scheme, such as example.com or 10.0.0.1:8080, are
+bare hosts. They are captured over the first scheme they answer on,
+trying --prefer-scheme first, or over both with --both-schemes.
+
+The source may also be a http(s) URL, in which case the list is
+fetched before scanning, using any proxy set in the environment.
+
+Shodan (shodan download) and Censys search exports are read with
+--source-format, capturing their http(s) services. The product and
+organisation they reported are kept on the entries.
+
 For Example:
 
 $ gowitness file -s ~/Desktop/urls
 $ gowitness file --source ~/Desktop/urls --threads -2
+$ gowitness file --source ~/Desktop/targets.csv
+$ gowitness file --source https://internal/targets.txt
+$ gowitness file --source jobs.jsonl
+$ gowitness file --source results.json.gz --source-format shodan
+$ gowitness file --source ~/Desktop/urls --paths-file paths.txt --dry-run
+
+Where jobs.jsonl contains lines such as:
+
+{"url": "https://example.com", "timeout": 10, "resolution": "1024,768", "headers": {"X-Scan": "1"}}
 `,
+	PreRun: checkSandbox,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		log.WithField("source", sourceFile).Debug("Reading source file")
 
+		if err := validSourceFormat(sourceFormat); err != nil {
+			log.WithField("err", err).Fatal("Invalid source format provided")
+		}
+
 		// process the source file
-		file, err := os.Open(sourceFile)
+		source, err := openSource(sourceFile)
 		if err != nil {
 			log.WithFields(log.Fields{"error": err, "source": sourceFile}).Fatal("Unable to read source file")
 		}
 
-		// close the file when we are done with it
-		defer file.Close()
+		targets := readFileTargets(sourceFile, source)
+		source.Close()
 
-		// read each line and populate the channel used to
-		// start screenshotting
-		scanner := bufio.NewScanner(file)
-		swg := sizedwaitgroup.New(maxThreads)
+		if dryRun {
+			fileDryRun(targets, readPaths())
+			return
+		}
 
-		// Prepare the progress bar to use.
-		format, err := template.New("status-bar").
-			Parse("  > Processing file: {{if .Updated}}{{end}}{{.Done}}")
-		if err != nil {
-			log.WithField("err", err).Fatal("Unable to prepare progress bar to use.")
+		targets = dedupeFileTargets(expandFileTargets(targets, readPaths()))
+		if sampleHosts {
+			targets = sampleFileTargets(targets, sampleHostsKeep)
+		}
+
+		// an unreachable or empty remote list is almost certainly a
+		// mistake, so refuse to carry on with nothing to scan
+		if isRemoteSource(sourceFile) && len(targets) == 0 {
+			log.WithField("source", sourceFile).Fatal("Remote source list contained no valid URLs")
+		}
+
+		saveJob(cmd, targets)
+		captureFileTargets(targets, "Processing file")
+		crawlLinks()
+
+		log.WithFields(log.Fields{"run-time": time.Since(startTime)}).Info("Complete")
+
+	},
+}
+
+// fileDryRun prints the breakdown of the URLs the targets and
+// paths multiply to
+func fileDryRun(targets []fileTarget, paths []string) {
+
+	var urls []*url.URL
+	for _, target := range targets {
+		urls = append(urls, target.url)
+	}
+
+	unique := dedupeFileTargets(expandFileTargets(targets, paths))
+	plan := &scanPlan{unique: int64(len(unique))}
+	if sampleHosts {
+		plan.sampled = int64(len(sampleFileTargets(unique, sampleHostsKeep)))
+	}
+	plan.factor("urls", int64(len(urls)), "URLs", urlsDetail(urls))
+	plan.factor("paths", int64(len(paths)+1), "paths", pathsDetail(paths))
+	plan.write(os.Stdout)
+}
+
+// captureFileTargets captures the targets, rendering a progress
+// bar with label as they complete
+func captureFileTargets(targets []fileTarget, label string) {
+
+	recordSkips()
+
+	// links to the targets are not crawled, they are captured anyway
+	if options.Crawler != nil {
+		for _, target := range targets {
+			options.Crawler.Visit(target.url)
+		}
+	}
+
+	swg := sizedwaitgroup.New(maxThreads)
+
+	// Prepare the progress bar to use.
+	format, err := template.New("status-bar").
+		Parse("  > " + label + ": {{if .Updated}}{{end}}{{.Done}}/{{.Total}}")
+	if err != nil {
+		log.WithField("err", err).Fatal("Unable to prepare progress bar to use.")
+	}
+	bar := barely.NewStatusBar(format)
+	status := &struct {
+		Total   int
+		Done    int64
+		Updated int64
+	}{
+		Total: len(targets),
+	}
+	bar.SetStatus(status)
+	bar.Render(os.Stdout)
+	options.Checkpoint.AddTotal(len(targets))
+
+	// fragile hosts get no more than --per-host-concurrency captures
+	// at a time, whatever the number of threads
+	order := make([]int, len(targets))
+	for i := range order {
+		order[i] = i
+	}
+	if options.HostLimiter != nil {
+		hosts := make([]string, len(targets))
+		for i, target := range targets {
+			hosts[i] = target.url.Hostname()
 		}
-		bar := barely.NewStatusBar(format)
-		status := &struct {
-			Done    int64
-			Updated int64
-		}{}
-		bar.SetStatus(status)
-		bar.Render(os.Stdout)
+		order = utils.InterleaveHosts(hosts)
+	}
 
-		for scanner.Scan() {
+	for _, i := range order {
 
-			candidate := scanner.Text()
+		target := targets[i]
+		release := options.HostLimiter.Acquire(target.url.Hostname())
+		swg.Add()
 
-			u, err := url.ParseRequestURI(candidate)
-			if err != nil {
+		// Goroutine to run the URL processor
+		go func(target fileTarget) {
 
-				log.WithField("url", candidate).Warn("Skipping Invalid URL")
+			defer swg.Done()
+			defer release()
+
+			// per-target options override the global ones
+			targetOptions := options
+			if target.timeout > 0 {
+				targetOptions.Timeout = target.timeout
+			}
+			targetOptions.Path = target.path
+			targetOptions.BareHost = target.bare
+			targetOptions.Source = target.source
+			targetOptions.CrawlDepth = target.depth
+
+			targetChrome := target.chrome()
+			if targetChrome != &chrome {
+				targetOptions.Engine = engineFor(targetChrome)
+			}
+
+			utils.ProcessURL(target.url, targetChrome, &db, &targetOptions)
+
+			// update the progress bar
+			atomic.AddInt64(&status.Done, 1)
+			atomic.AddInt64(&status.Updated, 1)
+			bar.Render(os.Stdout)
+
+		}(target)
+	}
+
+	swg.Wait()
+	bar.Clear(os.Stdout)
+}
+
+// fileTarget is a URL read from the source file, along
+// with any options specific to it
+type fileTarget struct {
+	url     *url.URL
+	timeout int
+	path    string
+
+	// depth is how many links away from the source the target
+	// was found, when crawling
+	depth int
+
+	// bare is set for hosts listed without a scheme, which
+	// is chosen when capturing them
+	bare bool
+
+	// capture settings read from JSONL sources. These
+	// override the global flags when set.
+	resolution string
+	userAgent  string
+	headers    map[string]string
+	cookies    map[string]string
+
+	// source is what Shodan or Censys reported about the
+	// target, when it was read from one of their exports
+	source *storage.SourceInfo
+}
+
+// jsonlTarget is a line of a JSONL source file
+type jsonlTarget struct {
+	URL        string            `json:"url"`
+	Timeout    int               `json:"timeout,omitempty"`
+	Resolution string            `json:"resolution,omitempty"`
+	UserAgent  string            `json:"user_agent,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Cookies    map[string]string `json:"cookies,omitempty"`
+}
+
+// expandFileTargets adds a target for each of the paths appended to
+// every source URL, dropping duplicate URLs
+func expandFileTargets(targets []fileTarget, paths []string) []fileTarget {
+
+	var expanded []fileTarget
+	seen := make(map[string]bool)
+	for _, target := range targets {
+
+		for _, pathTarget := range expandPaths(target.url, paths) {
+
+			if seen[pathTarget.url.String()] {
 				continue
 			}
+			seen[pathTarget.url.String()] = true
+
+			expandedTarget := target
+			expandedTarget.url = pathTarget.url
+			expandedTarget.path = pathTarget.path
+			expanded = append(expanded, expandedTarget)
+		}
+	}
+
+	return expanded
+}
 
-			swg.Add()
+// isRemoteSource checks if the source is a http(s) URL rather
+// than a local file
+func isRemoteSource(source string) bool {
 
-			// Goroutine to run the URL processor
-			go func(url *url.URL) {
+	return strings.HasPrefix(source, utils.HTTP) || strings.HasPrefix(source, utils.HTTPS)
+}
+
+// openSource opens a local source file, or fetches a remote one
+// using any proxy configured in the environment
+func openSource(source string) (io.ReadCloser, error) {
+
+	if !isRemoteSource(source) {
+		return os.Open(source)
+	}
+
+	client := &http.Client{
+		Timeout:   time.Duration(options.Timeout) * time.Second,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+	}
+
+	resp, err := client.Get(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected response fetching source list: %s", resp.Status)
+	}
+
+	return resp.Body, nil
+}
 
-				defer swg.Done()
+// chrome returns the Chrome settings to capture the target with. These
+// are the global settings unless the target overrides any of them.
+func (target *fileTarget) chrome() *chrm.Chrome {
 
-				utils.ProcessURL(url, &chrome, &db, waitTimeout)
+	if target.resolution == "" && target.userAgent == "" && target.headers == nil && target.cookies == nil {
+		return &chrome
+	}
 
-				// update the progress bar
-				atomic.AddInt64(&status.Done, 1)
-				atomic.AddInt64(&status.Updated, 1)
-				bar.Render(os.Stdout)
+	targetChrome := chrome
+	if target.resolution != "" {
+		targetChrome.Resolution = target.resolution
+	}
+	if target.userAgent != "" {
+		targetChrome.UserAgent = target.userAgent
+	}
+	if target.headers != nil {
+		targetChrome.Headers = target.headers
+	}
+	if target.cookies != nil {
+		targetChrome.Cookies = target.cookies
+	}
 
-			}(u)
+	return &targetChrome
+}
+
+// readFileTargets reads the targets from a source file. Sources with
+// a .csv extension are read as CSV, otherwise one URL per line is
+// expected. Blank lines and lines starting with # are ignored.
+func readFileTargets(name string, source io.Reader) []fileTarget {
+
+	if u, err := url.Parse(name); err == nil && isRemoteSource(name) {
+		name = u.Path
+	}
+
+	switch sourceFormat {
+	case sourceFormatShodan:
+		return readShodanTargets(source)
+	case sourceFormatCensys:
+		return readCensysTargets(source)
+	}
+
+	if strings.HasSuffix(strings.ToLower(name), ".csv") {
+		return readCSVTargets(source)
+	}
+
+	if strings.HasSuffix(strings.ToLower(name), ".jsonl") {
+		return readJSONLTargets(source)
+	}
+
+	var targets []fileTarget
+	scanner := bufio.NewScanner(source)
+	for scanner.Scan() {
+
+		candidate := strings.TrimSpace(scanner.Text())
+		if candidate == "" || strings.HasPrefix(candidate, "#") {
+			continue
 		}
 
-		swg.Wait()
-		bar.Clear(os.Stdout)
+		if !strings.Contains(candidate, "://") {
+			targets = append(targets, bareHostTargets(candidate)...)
+			continue
+		}
 
-		log.WithFields(log.Fields{"run-time": time.Since(startTime)}).Info("Complete")
+		u, err := url.ParseRequestURI(candidate)
+		if err != nil {
 
-	},
+			log.WithField("url", candidate).Warn("Skipping Invalid URL")
+			skipTarget(candidate, storage.SkipInvalidURL)
+			continue
+		}
+
+		targets = append(targets, fileTarget{url: u})
+	}
+
+	return targets
+}
+
+// bareHostTargets returns the targets of a host listed without a
+// scheme: one whose scheme is probed, or one per scheme with
+// --both-schemes
+func bareHostTargets(host string) []fileTarget {
+
+	u, err := url.ParseRequestURI(utils.HTTPS + host)
+	if err != nil || u.Host == "" {
+
+		log.WithField("url", host).Warn("Skipping Invalid URL")
+		skipTarget(host, storage.SkipInvalidURL)
+		return nil
+	}
+
+	if !bothSchemes {
+		return []fileTarget{{url: u, bare: true}}
+	}
+
+	plain := *u
+	plain.Scheme = "http"
+
+	return []fileTarget{{url: &plain}, {url: u}}
+}
+
+// readCSVTargets reads targets from a CSV file. The first column is
+// the URL and the optional second column a timeout in seconds. If
+// the first row is a header, its columns are matched by name instead.
+func readCSVTargets(source io.Reader) []fileTarget {
+
+	reader := csv.NewReader(source)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+
+	columns := map[string]int{"url": 0, "timeout": 1}
+
+	var targets []fileTarget
+	for line := 1; ; line++ {
+
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.WithFields(log.Fields{"line": line, "err": err}).Warn("Skipping invalid CSV row")
+			continue
+		}
+
+		// a header row names the columns
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "url") {
+
+			columns = make(map[string]int)
+			for i, name := range record {
+				columns[strings.ToLower(strings.TrimSpace(name))] = i
+			}
+			continue
+		}
+
+		column := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		u, err := url.ParseRequestURI(column("url"))
+		if err != nil {
+
+			log.WithFields(log.Fields{"line": line, "url": column("url")}).Warn("Skipping Invalid URL")
+			skipTarget(column("url"), storage.SkipInvalidURL)
+			continue
+		}
+
+		target := fileTarget{url: u}
+		if timeout := column("timeout"); timeout != "" {
+
+			if target.timeout, err = strconv.Atoi(timeout); err != nil || target.timeout < 0 {
+				log.WithFields(log.Fields{"line": line, "timeout": timeout}).Warn("Ignoring invalid timeout")
+				target.timeout = 0
+			}
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
+// readJSONLTargets reads targets from a JSONL file, where every line
+// is a JSON object with the url and any capture settings for it
+func readJSONLTargets(source io.Reader) []fileTarget {
+
+	var targets []fileTarget
+	scanner := bufio.NewScanner(source)
+	for line := 1; scanner.Scan(); line++ {
+
+		candidate := strings.TrimSpace(scanner.Text())
+		if candidate == "" || strings.HasPrefix(candidate, "#") {
+			continue
+		}
+
+		var parsed jsonlTarget
+		if err := json.Unmarshal([]byte(candidate), &parsed); err != nil {
+			log.WithFields(log.Fields{"line": line, "err": err}).Warn("Skipping invalid JSONL line")
+			continue
+		}
+
+		u, err := url.ParseRequestURI(parsed.URL)
+		if err != nil {
+
+			log.WithFields(log.Fields{"line": line, "url": parsed.URL}).Warn("Skipping Invalid URL")
+			skipTarget(parsed.URL, storage.SkipInvalidURL)
+			continue
+		}
+
+		if parsed.Timeout < 0 {
+			log.WithFields(log.Fields{"line": line, "timeout": parsed.Timeout}).Warn("Ignoring invalid timeout")
+			parsed.Timeout = 0
+		}
+
+		if parsed.Resolution != "" && !validResolution(parsed.Resolution) {
+			log.WithFields(log.Fields{"line": line, "resolution": parsed.Resolution}).Warn("Ignoring invalid resolution")
+			parsed.Resolution = ""
+		}
+
+		targets = append(targets, fileTarget{
+			url: u, timeout: parsed.Timeout, resolution: parsed.Resolution, userAgent: parsed.UserAgent,
+			headers: parsed.Headers, cookies: parsed.Cookies,
+		})
+	}
+
+	return targets
 }
 
 func init() {
 	RootCmd.AddCommand(fileCmd)
 
-	fileCmd.Flags().StringVarP(&sourceFile, "source", "s", "", "The source file containing urls")
+	fileCmd.Flags().StringVarP(&sourceFile, "source", "s", "", "The source file (or http(s) URL) containing urls")
 	fileCmd.Flags().IntVarP(&maxThreads, "threads", "t", 4, "Maximum concurrent threads to run")
+	fileCmd.Flags().StringVarP(&sourceFormat, "source-format", "", "", "Read the source as a Shodan or Censys JSON export (shodan or censys)")
+	fileCmd.Flags().BoolVarP(&bothSchemes, "both-schemes", "", false, "Capture hosts listed without a scheme over both http and https, instead of the first that answers")
+	fileCmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "Print how many URLs and paths the source covers, and the URLs they multiply to, without capturing anything")
+	fileCmd.Flags().StringVarP(&saveJobFile, "save-job", "", "", "Save the resolved targets and flags to this job file, to run again with replay")
 }
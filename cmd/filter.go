@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// entryFilter filters database entries by response code,
+// detected technology, page language, mixed content, directory
+// listings and the authentication schemes asked for
+type entryFilter struct {
+	Status           []int
+	Technology       []string
+	Lang             []string
+	MixedContent     bool
+	DirectoryListing bool
+	AuthRequired     bool
+	AuthScheme       []string
+}
+
+// matches checks if an entry matches the filter
+func (filter *entryFilter) matches(entry *storage.HTTResponse) bool {
+
+	if len(filter.Status) > 0 {
+
+		matched := false
+		for _, status := range filter.Status {
+			if entry.ResponseCode == status {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	for _, want := range filter.Technology {
+
+		matched := false
+		for _, technology := range entry.Technologies {
+			if strings.EqualFold(technology, want) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	// languages match on their primary subtag too, so that
+	// en matches en-US
+	if len(filter.Lang) > 0 {
+
+		matched := false
+		for _, lang := range filter.Lang {
+			if strings.EqualFold(entry.Lang, lang) || strings.HasPrefix(strings.ToLower(entry.Lang), strings.ToLower(lang)+"-") {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	if filter.MixedContent && len(entry.MixedContent) == 0 {
+		return false
+	}
+
+	if filter.DirectoryListing && !entry.DirectoryListing {
+		return false
+	}
+
+	if filter.AuthRequired && len(entry.AuthRequired) == 0 {
+		return false
+	}
+
+	if len(filter.AuthScheme) > 0 {
+
+		matched := false
+		for _, scheme := range filter.AuthScheme {
+			for _, method := range entry.AuthRequired {
+				if strings.EqualFold(method.Scheme, scheme) {
+					matched = true
+				}
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filter returns the entries matching the filter
+func (filter *entryFilter) filter(entries []storage.HTTResponse) []storage.HTTResponse {
+
+	var filtered []storage.HTTResponse
+	for _, entry := range entries {
+		if filter.matches(&entry) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
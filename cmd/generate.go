@@ -3,22 +3,27 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
-	"io/ioutil"
+	"fmt"
+	"net/url"
 	"os"
-	"sort"
 	"path/filepath"
+	"sort"
 	"strings"
-	"fmt"
 	"text/template"
 
 	gwtmpl "github.com/RiskSense-Ops/gowitness/template"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/RiskSense-Ops/gowitness/utils"
 	"github.com/spf13/cobra"
 	"github.com/tidwall/buntdb"
 )
 
+// screenshotPathOriginal is the --screenshot-path keeping the
+// screenshot paths stored in the database
+const screenshotPathOriginal = "keep-original"
+
 // generateCmd represents the generate command
 var generateCmd = &cobra.Command{
 	Use:   "generate",
@@ -26,32 +31,102 @@ var generateCmd = &cobra.Command{
 	Long: `
 Generate an HTML report of the screenshot information found in a gowitness.db file
 
+Entries are sorted by page title. Sorting by capture time with
+--sort captured puts the newest captures first, which makes for a
+near live view of a running scan when regenerated periodically.
+
+The evidence layout writes evidence.html instead, showing a single
+screenshot per printed page, captioned with its URL, address, status,
+title, capture time, server and TLS details. Print it to PDF for
+client appendices.
+
+Estates of identical appliances can be reduced to one entry per page
+title and Server header with --unique-by title-server. The entry kept
+notes how many others it stands in for. --dedupe-by picks the fields
+to compare instead, any of title, favicon, server and status. Favicons
+are only known for captures taken with --fetch-favicon.
+
 For example:
 
-$ gowitness generate`,
+$ gowitness generate
+$ gowitness generate --sort captured
+$ gowitness generate --layout evidence
+$ gowitness generate --unique-by title-server
+$ gowitness generate --dedupe-by favicon,server
+$ gowitness generate --sitemap
+$ gowitness generate --coverage 192.168.0.0/24
+$ gowitness generate --directory-listing
+$ gowitness generate --screenshot-base-url https://cdn.example.com/screenshots
+$ gowitness generate --tag-rules rules.yaml
+
+Where rules.yaml tags the entries matching each rule:
+
+rules:
+  - tag: admin-panel
+    when: status == 200 && title contains "admin"
+  - tag: legacy
+    when: header.x-powered-by matches "PHP/5" || technology == "IIS"`,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		// Populate a variable with the data the template will
 		// want to parse
+		var tagRules []utils.TagRule
+		if tagRulesFile != "" {
+
+			rules, err := utils.LoadTagRules(tagRulesFile)
+			if err != nil {
+				log.WithFields(log.Fields{"tag-rules": tagRulesFile, "err": err}).Fatal("Failed to read the tag rules")
+			}
+			tagRules = rules
+		}
+
 		var screenshotEntries []storage.HTTResponse
 		var errorsIgnored = 0
+		var tagged = 0
+		var errorEntries []storage.HTTResponse
 		err := db.Db.View(func(tx *buntdb.Tx) error {
 
 			tx.Ascend("", func(key, value string) bool {
 
+				// only the latest capture of a URL is reported on
+				if storage.IsHistoryKey(key) || storage.IsReviewKey(key) || storage.IsSkippedKey(key) {
+					return true
+				}
+
 				data := storage.HTTResponse{}
-				log.WithField("url", value).Debug("Generating screenshot entry for"+value)
+				log.WithField("url", value).Debug("Generating screenshot entry for" + value)
 				if err := json.Unmarshal([]byte(value), &data); err != nil {
 					log.Fatal(err)
 				}
 
-				// check if the screenshot path exists. if not, slide in
-				// a placeholder image
-				if _, err := os.Stat(data.ScreenshotFile); os.IsNotExist(err) {
+				data.ScreenshotFile = resolveScreenshot(data.ScreenshotFile)
+				if data.HeroFile != "" {
+					data.HeroFile = resolveScreenshot(data.HeroFile)
+				}
+				if data.BeforeDismissFile != "" {
+					data.BeforeDismissFile = resolveScreenshot(data.BeforeDismissFile)
+				}
+				if data.BlurredFile != "" {
+					data.BlurredFile = resolveScreenshot(data.BlurredFile)
+				}
+				if data.CaptureLog != "" {
+					data.CaptureLog = resolveScreenshot(data.CaptureLog)
+				}
+				for i, tile := range data.TileFiles {
+					data.TileFiles[i] = resolveScreenshot(tile)
+				}
+
+				if onlyListings && !data.DirectoryListing {
+					return true
+				}
+
+				if data.AutoTags = utils.AutoTags(tagRules, &data); len(data.AutoTags) > 0 {
+					tagged++
+				}
 
-					log.WithField("screenshot-file", data.ScreenshotFile).
-						Debug("Adding placeholder for missing screenshot")
-					data.ScreenshotFile = gwtmpl.PlaceHolderImage
+				// keep track of failed entries for the errors report
+				if data.ErrorKind != "" {
+					errorEntries = append(errorEntries, data)
 				}
 
 				log.WithField("url", data.FinalURL).Debug("Generating screenshot entry")
@@ -68,36 +143,156 @@ $ gowitness generate`,
 			return nil
 		})
 
+		if len(tagRules) > 0 {
+			log.WithFields(log.Fields{"rules": len(tagRules), "tagged": tagged}).Info("Tagged entries with the tag rules")
+		}
+
 		// sort entries by page title
-		sort.Slice(screenshotEntries, func(i,j int) bool {
-			return strings.ToLower(screenshotEntries[i].PageTitle) < strings.ToLower(screenshotEntries[j].PageTitle);
+		sort.Slice(screenshotEntries, func(i, j int) bool {
+			return strings.ToLower(reportTitle(&screenshotEntries[i])) < strings.ToLower(reportTitle(&screenshotEntries[j]))
 		})
 
 		// sort untitled pages by Server header. untitled pages are at the beginning of screenshotEntries
 		// so we find the index of the last titled screen to define our server header sort range
 		var lastSortIndex = 0
 		for i, screen := range screenshotEntries {
-			if screen.PageTitle != "" {
+			if reportTitle(&screen) != "" {
 				lastSortIndex = i
 				break
 			}
 		}
-		sort.Slice(screenshotEntries[:lastSortIndex], func(i,j int) bool {
+		sort.Slice(screenshotEntries[:lastSortIndex], func(i, j int) bool {
 			var server_i = ""
 			var server_j = ""
 			for _, h := range screenshotEntries[i].Headers {
-				if strings.ToLower(h.Key) == "server" { server_i = strings.ToLower(h.Value) }
+				if strings.ToLower(h.Key) == "server" {
+					server_i = strings.ToLower(h.Value)
+				}
 			}
 			for _, h := range screenshotEntries[j].Headers {
-				if strings.ToLower(h.Key) == "server" { server_j = strings.ToLower(h.Value) }
+				if strings.ToLower(h.Key) == "server" {
+					server_j = strings.ToLower(h.Value)
+				}
 			}
 			return server_i < server_j
 		})
 
+		if pages > 0 && cmd.Flags().Changed("page-size") {
+			log.Fatal("--pages and --page-size can not be used together")
+		}
+		if pages < 0 || pageSize < 1 {
+			log.WithFields(log.Fields{"pages": pages, "page-size": pageSize}).Fatal("Invalid pagination provided")
+		}
+		if indexWindow < 0 {
+			log.WithField("index-window", indexWindow).Fatal("Invalid index window provided")
+		}
+		switch uniqueBy {
+		case "":
+		case uniqueTitleServer:
+			if len(dedupeBy) > 0 {
+				log.Fatal("--unique-by can not be combined with --dedupe-by")
+			}
+			dedupeBy = []string{"title", "server"}
+		default:
+			log.WithField("unique-by", uniqueBy).Fatal("Invalid unique by provided. Use title-server")
+		}
+		if len(dedupeBy) > 0 && !validDedupeFields(dedupeBy) {
+			log.WithField("dedupe-by", dedupeBy).Fatal("Invalid dedupe by provided. Use a combination of title, favicon, server and status")
+		}
+		if linkTarget != "_blank" && linkTarget != "_self" {
+			log.WithField("link-target", linkTarget).Fatal("Invalid link target provided. Use _blank or _self")
+		}
+
+		if len(groupOrder) > 0 && groupBy == "" {
+			log.Fatal("--group-order needs --group-by")
+		}
+
+		if reportScreenshotBaseURL != "" {
+
+			base, err := url.Parse(reportScreenshotBaseURL)
+			if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+				log.WithField("screenshot-base-url", reportScreenshotBaseURL).Fatal("Invalid screenshot base URL provided. Use http(s)://host/path")
+			}
+			if reportScreenshotPath != "" {
+				log.Fatal("--screenshot-base-url and --screenshot-path can not be used together")
+			}
+		}
+
+		if reportLayout != layoutGrid && reportLayout != layoutEvidence {
+			log.WithField("layout", reportLayout).Fatal("Invalid layout provided. Use grid or evidence")
+		}
+
+		if sortOrder != "" && sortOrder != "asc" && sortOrder != "desc" {
+			log.WithField("sort-order", sortOrder).Fatal("Invalid sort order provided. Use asc or desc")
+		}
+
+		switch sortBy {
+		case "title":
+			if sortOrder == "desc" {
+				reverseEntries(screenshotEntries)
+			}
+		case "captured":
+			sortByCaptured(screenshotEntries, sortOrder != "asc")
+		case "complexity":
+			sortByComplexity(screenshotEntries, sortOrder != "asc")
+		default:
+			log.WithField("sort", sortBy).Fatal("Invalid sort provided. Use title, captured or complexity")
+		}
+
+		// the first entry of each duplicate, in the order sorted, stands in for the rest
+		var similar map[string]int
+		var similarBy string
+		if len(dedupeBy) > 0 {
+			screenshotEntries, similar = uniqueByFields(screenshotEntries, dedupeBy)
+			similarBy = dedupeDescription(dedupeBy)
+		}
+
+		// captures of --paths are kept together with the rest of their host
+		screenshotEntries = groupPathCaptures(screenshotEntries)
+
+		var groups []reportGroup
+		switch groupBy {
+		case "":
+		case "favicon":
+			screenshotEntries, groups = groupByFavicon(screenshotEntries)
+		case "auth-scheme":
+			screenshotEntries, groups = groupByAuthScheme(screenshotEntries)
+		case "status":
+			screenshotEntries, groups = groupByStatus(screenshotEntries)
+		default:
+			log.WithField("group-by", groupBy).Fatal("Invalid grouping provided. Use favicon, auth-scheme or status")
+		}
+		if len(groupOrder) > 0 {
+			screenshotEntries, groups = orderGroups(screenshotEntries, groups, groupOrder)
+		}
+
 		if err != nil {
 			log.Fatal(err)
 		}
 
+		// reports in a workspace are written to its reports directory,
+		// with screenshots referenced relative to it
+		reportDir = "."
+		var screenshotPrefix = ""
+		if outputDir != "" {
+			reportDir = filepath.Join(outputDir, workspaceReports)
+			screenshotPrefix = "../" + workspaceScreenshots + "/"
+			os.MkdirAll(reportDir, 0750)
+		}
+
+		// the errors report is written even if nothing was captured
+		if len(errorEntries) > 0 {
+			writeErrorsReport(reportDir, errorEntries)
+		}
+
+		if filmstrip {
+			writeFilmstripReport(reportDir, screenshotPrefix)
+		}
+
+		if len(coverageCIDRs) > 0 {
+			writeCoverageReport(reportDir, coverageCIDRs, screenshotPrefix)
+		}
+
 		if len(screenshotEntries) <= 0 {
 			log.WithField("count", len(screenshotEntries)).Error("No screenshot entries exist to create a report")
 			return
@@ -105,13 +300,25 @@ $ gowitness generate`,
 
 		// Prepare and render the template
 		type TemplateData struct {
-			ScreenShots []storage.HTTResponse
-			PageIndex string
-			PageCount int
-			PageNext string
-			PagePrev string
-			PageNumber int
-			ErrorsIgnored int
+			ScreenShots     []storage.HTTResponse
+			PageIndex       string
+			PageCount       int
+			EntryCount      int
+			PageNext        string
+			PagePrev        string
+			PageNumber      int
+			PagePosition    int
+			ErrorsIgnored   int
+			ErrorsReport    bool
+			FilmstripReport bool
+			SitemapReport   bool
+			CoverageReport  bool
+			Groups          map[int]*reportGroup
+			Legend          []legendItem
+			LinkTarget      string
+			MobileStrip     bool
+			Similar         map[string]int
+			SimilarBy       string
 		}
 		templateData := TemplateData{ScreenShots: screenshotEntries}
 
@@ -120,20 +327,45 @@ $ gowitness generate`,
 			log.WithField("err", err).Fatal("Failed to parse template")
 		}
 
-		var pageno = 0
-		var pageIndex bytes.Buffer
-		for i := 0; i < len(screenshotEntries); i += pageSize {
-			var pageFile = fmt.Sprintf("page-%v.html",  pageno)
-			pageIndex.WriteString(fmt.Sprintf("&#8226;<a class=\"page-number\" href=\"%v\">%v</a>", pageFile, pageno))
-			pageno += 1
+		// the legend only explains what the report actually shows
+		var legend []legendItem
+		if showLegend {
+			legend = reportLegend(screenshotEntries)
 		}
 
-		pageCount := pageno
-		pageno = 0
+		var pageno = 0
+		var starts = pageStarts(len(screenshotEntries), pageSize, pages)
+		pageCount := len(starts)
 		for i, screen := range screenshotEntries {
 			if screen.ScreenshotFile != gwtmpl.PlaceHolderImage {
-				screenshotEntries[i].ScreenshotFile = filepath.Base(screen.ScreenshotFile)
+				screenshotEntries[i].ScreenshotFile = reportScreenshot(screen.ScreenshotFile, screenshotPrefix)
+			}
+			// without its screenshot the hero would open nothing
+			if screen.HeroFile == gwtmpl.PlaceHolderImage || screen.ScreenshotFile == gwtmpl.PlaceHolderImage {
+				screenshotEntries[i].HeroFile = ""
+			} else if screen.HeroFile != "" {
+				screenshotEntries[i].HeroFile = reportScreenshot(screen.HeroFile, screenshotPrefix)
+			}
+			if screen.BlurredFile == gwtmpl.PlaceHolderImage || screen.ScreenshotFile == gwtmpl.PlaceHolderImage {
+				screenshotEntries[i].BlurredFile = ""
+			} else if screen.BlurredFile != "" {
+				screenshotEntries[i].BlurredFile = reportScreenshot(screen.BlurredFile, screenshotPrefix)
+			}
+			if screen.BeforeDismissFile == gwtmpl.PlaceHolderImage {
+				screenshotEntries[i].BeforeDismissFile = ""
+			} else if screen.BeforeDismissFile != "" {
+				screenshotEntries[i].BeforeDismissFile = reportScreenshot(screen.BeforeDismissFile, screenshotPrefix)
+			}
+			if screen.CaptureLog != "" {
+				screenshotEntries[i].CaptureLog = reportScreenshot(screen.CaptureLog, screenshotPrefix)
 			}
+			var tiles []string
+			for _, tile := range screen.TileFiles {
+				if tile != gwtmpl.PlaceHolderImage {
+					tiles = append(tiles, reportScreenshot(tile, screenshotPrefix))
+				}
+			}
+			screenshotEntries[i].TileFiles = tiles
 			var headers []storage.HTTPHeader
 			for _, header := range screenshotEntries[i].Headers {
 				if strings.ToLower(header.Key) == "server" {
@@ -142,37 +374,672 @@ $ gowitness generate`,
 			}
 			screenshotEntries[i].Headers = headers
 		}
-		//os.MkdirAll(reportDir, 0750);
-		reportDir = "."
-		for i := 0; i < len(screenshotEntries); i += pageSize {
+
+		if sitemap {
+			writeSitemapReport(reportDir, screenshotEntries)
+		}
+
+		// the evidence layout is a single document, meant for printing
+		if reportLayout == layoutEvidence {
+			writeEvidenceReport(reportDir, screenshotEntries)
+			return
+		}
+
+		writeReportIndex(reportDir, 0, pageCount, len(screenshotEntries))
+		for p, i := range starts {
 			var page bytes.Buffer
 			var end = len(screenshotEntries) - i
-			if pageSize < end { end = pageSize }
-			var prev = fmt.Sprintf("<a id=\"prev-page\" href=\"page-%v.html\">Prev</a>", (pageno + pageCount - 1) % pageCount)
-			var next = fmt.Sprintf("&#8226;<a id=\"next-page\" href=\"page-%v.html\">Next</a>", (pageno + 1) % pageCount)
+			if p+1 < len(starts) {
+				end = starts[p+1] - i
+			}
+			var prev = fmt.Sprintf("<a id=\"prev-page\" href=\"page-%v.html\">Prev</a>", (pageno+pageCount-1)%pageCount)
+			var next = fmt.Sprintf("&#8226;<a id=\"next-page\" href=\"page-%v.html\">Next</a>", (pageno+1)%pageCount)
 			templateData = TemplateData{
-				ScreenShots: screenshotEntries[i:i+end],
-				PageIndex: pageIndex.String(),
-				PageCount: len(screenshotEntries),
-				PageNext: next,
-				PagePrev: prev,
-				PageNumber: pageno,
-				ErrorsIgnored: errorsIgnored,
+				ScreenShots:     screenshotEntries[i : i+end],
+				PageIndex:       pageIndexLinks(pageno, pageCount, indexWindow),
+				PageCount:       pageCount,
+				EntryCount:      len(screenshotEntries),
+				PageNext:        next,
+				PagePrev:        prev,
+				PageNumber:      pageno,
+				PagePosition:    pageno + 1,
+				ErrorsIgnored:   errorsIgnored,
+				ErrorsReport:    len(errorEntries) > 0,
+				FilmstripReport: filmstrip,
+				SitemapReport:   sitemap,
+				CoverageReport:  len(coverageCIDRs) > 0,
+				Groups:          pageGroups(groups, i, end),
+				Legend:          legend,
+				LinkTarget:      linkTarget,
+				MobileStrip:     mobileStrip,
+				Similar:         similar,
+				SimilarBy:       similarBy,
 			}
 			tmplPage.Execute(&page, templateData)
 			var pageFile = fmt.Sprintf("%v/page-%v.html", reportDir, pageno)
-			ioutil.WriteFile(pageFile, []byte(page.String()), 0640)
+			if err := utils.WriteFileAtomic(pageFile, page.Bytes(), 0640); err != nil {
+				log.WithFields(log.Fields{"page-file": pageFile, "err": err}).Fatal("Failed to write report page")
+			}
 			pageno += 1
+
+			// keep the index current, so that the report can be browsed
+			// while the remaining pages are generated
+			writeReportIndex(reportDir, pageno, pageCount, len(screenshotEntries))
+			log.WithFields(log.Fields{"page": pageno, "pages": pageCount}).Debug("Wrote report page")
 		}
 
-		log.WithField("report-file", "page-0.html").Info("Report generated")
+		log.WithField("report-file", filepath.Join(reportDir, "index.html")).Info("Report generated")
 	},
 }
 
+// sortByCaptured sorts entries by the time they were captured,
+// newest first if descending
+func sortByCaptured(entries []storage.HTTResponse, descending bool) {
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if descending {
+			return entries[i].CapturedAt.After(entries[j].CapturedAt)
+		}
+		return entries[i].CapturedAt.Before(entries[j].CapturedAt)
+	})
+}
+
+// sortByComplexity sorts entries by the number of DOM nodes in their
+// page, and then by the bytes transferred to load it. Tiny pages tend
+// to be error and parking pages.
+func sortByComplexity(entries []storage.HTTResponse, descending bool) {
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if descending {
+			a, b = b, a
+		}
+		if a.DOMNodes != b.DOMNodes {
+			return a.DOMNodes < b.DOMNodes
+		}
+		return a.TransferredBytes < b.TransferredBytes
+	})
+}
+
+// reverseEntries reverses the order of entries in place
+func reverseEntries(entries []storage.HTTResponse) {
+
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+}
+
+// groupPathCaptures moves the --paths captures of a host to follow
+// the first entry for that host, keeping the order otherwise
+func groupPathCaptures(entries []storage.HTTResponse) []storage.HTTResponse {
+
+	host := func(entry storage.HTTResponse) string {
+		if u, err := url.Parse(entry.URL); err == nil {
+			return u.Host
+		}
+		return entry.URL
+	}
+
+	paths := make(map[string][]storage.HTTResponse)
+	for _, entry := range entries {
+		if entry.Path != "" {
+			paths[host(entry)] = append(paths[host(entry)], entry)
+		}
+	}
+
+	// nothing to do when no paths were captured
+	if len(paths) == 0 {
+		return entries
+	}
+
+	grouped := make([]storage.HTTResponse, 0, len(entries))
+	placed := make(map[string]bool)
+	for _, entry := range entries {
+
+		h := host(entry)
+		if entry.Path == "" {
+			grouped = append(grouped, entry)
+		}
+
+		if !placed[h] {
+			grouped = append(grouped, paths[h]...)
+			placed[h] = true
+		}
+	}
+
+	return grouped
+}
+
+// reportGroup is a heading shown before the entries of a group. Key
+// names the group in --group-order.
+type reportGroup struct {
+	Key       string
+	Start     int
+	Count     int
+	Heading   string
+	Favicon   string
+	Continued bool
+}
+
+// groupByFavicon orders entries so that those sharing a favicon hash
+// follow each other, largest group first. Entries without a favicon
+// are placed last.
+func groupByFavicon(entries []storage.HTTResponse) ([]storage.HTTResponse, []reportGroup) {
+
+	var order []int32
+	byHash := make(map[int32][]storage.HTTResponse)
+	favicons := make(map[int32]string)
+	var missing []storage.HTTResponse
+	for _, entry := range entries {
+
+		if entry.Favicon == nil {
+			missing = append(missing, entry)
+			continue
+		}
+
+		hash := entry.Favicon.Hash
+		if _, ok := byHash[hash]; !ok {
+			order = append(order, hash)
+			favicons[hash] = entry.Favicon.Data
+		}
+		byHash[hash] = append(byHash[hash], entry)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(byHash[order[i]]) > len(byHash[order[j]])
+	})
+
+	grouped := make([]storage.HTTResponse, 0, len(entries))
+	var groups []reportGroup
+	for _, hash := range order {
+
+		groups = append(groups, reportGroup{
+			Key: fmt.Sprint(hash), Start: len(grouped), Count: len(byHash[hash]), Heading: fmt.Sprintf("favicon %d", hash), Favicon: favicons[hash],
+		})
+		grouped = append(grouped, byHash[hash]...)
+	}
+
+	if len(missing) > 0 {
+		groups = append(groups, reportGroup{Key: "none", Start: len(grouped), Count: len(missing), Heading: "no favicon"})
+		grouped = append(grouped, missing...)
+	}
+
+	return grouped, groups
+}
+
+// groupByAuthScheme orders entries so that those asking for the same
+// authentication scheme follow each other, largest group first. An
+// entry offering several schemes is grouped by the first. Entries not
+// asking for authentication are placed last.
+func groupByAuthScheme(entries []storage.HTTResponse) ([]storage.HTTResponse, []reportGroup) {
+
+	var order []string
+	byScheme := make(map[string][]storage.HTTResponse)
+	var open []storage.HTTResponse
+	for _, entry := range entries {
+
+		if len(entry.AuthRequired) == 0 {
+			open = append(open, entry)
+			continue
+		}
+
+		scheme := entry.AuthRequired[0].Scheme
+		if _, ok := byScheme[scheme]; !ok {
+			order = append(order, scheme)
+		}
+		byScheme[scheme] = append(byScheme[scheme], entry)
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(byScheme[order[i]]) > len(byScheme[order[j]])
+	})
+
+	grouped := make([]storage.HTTResponse, 0, len(entries))
+	var groups []reportGroup
+	for _, scheme := range order {
+
+		groups = append(groups, reportGroup{Key: strings.ToLower(scheme), Start: len(grouped), Count: len(byScheme[scheme]), Heading: scheme + " authentication"})
+		grouped = append(grouped, byScheme[scheme]...)
+	}
+
+	if len(open) > 0 {
+		groups = append(groups, reportGroup{Key: "none", Start: len(grouped), Count: len(open), Heading: "no authentication asked for"})
+		grouped = append(grouped, open...)
+	}
+
+	return grouped, groups
+}
+
+// groupByStatus orders entries by the class of their status code, such
+// as 2xx, lowest first. Entries that got no response are placed last.
+func groupByStatus(entries []storage.HTTResponse) ([]storage.HTTResponse, []reportGroup) {
+
+	var order []int
+	byClass := make(map[int][]storage.HTTResponse)
+	var failed []storage.HTTResponse
+	for _, entry := range entries {
+
+		if entry.ResponseCode == 0 {
+			failed = append(failed, entry)
+			continue
+		}
+
+		class := entry.ResponseCode / 100
+		if _, ok := byClass[class]; !ok {
+			order = append(order, class)
+		}
+		byClass[class] = append(byClass[class], entry)
+	}
+
+	sort.Ints(order)
+
+	grouped := make([]storage.HTTResponse, 0, len(entries))
+	var groups []reportGroup
+	for _, class := range order {
+
+		key := fmt.Sprintf("%dxx", class)
+		groups = append(groups, reportGroup{Key: key, Start: len(grouped), Count: len(byClass[class]), Heading: key + " responses"})
+		grouped = append(grouped, byClass[class]...)
+	}
+
+	if len(failed) > 0 {
+		groups = append(groups, reportGroup{Key: "none", Start: len(grouped), Count: len(failed), Heading: "no response"})
+		grouped = append(grouped, failed...)
+	}
+
+	return grouped, groups
+}
+
+// orderGroups reorders the groups of entries so that those named in
+// order come first, in that order, followed by the rest sorted by key
+func orderGroups(entries []storage.HTTResponse, groups []reportGroup, order []string) ([]storage.HTTResponse, []reportGroup) {
+
+	rank := make(map[string]int)
+	for i, key := range order {
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		if _, ok := rank[key]; !ok {
+			rank[key] = i + 1
+		}
+	}
+
+	known := make(map[string]bool)
+	for _, group := range groups {
+		known[group.Key] = true
+	}
+	for _, key := range order {
+		if key = strings.ToLower(strings.TrimSpace(key)); !known[key] {
+			log.WithField("group", key).Warn("No entries fall into a group named in --group-order")
+		}
+	}
+
+	ordered := append([]reportGroup(nil), groups...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+
+		rankI, rankJ := rank[ordered[i].Key], rank[ordered[j].Key]
+		if rankI > 0 && rankJ > 0 {
+			return rankI < rankJ
+		}
+		if rankI > 0 || rankJ > 0 {
+			return rankI > 0
+		}
+
+		return ordered[i].Key < ordered[j].Key
+	})
+
+	reordered := make([]storage.HTTResponse, 0, len(entries))
+	for i := range ordered {
+
+		group := &ordered[i]
+		start := len(reordered)
+		reordered = append(reordered, entries[group.Start:group.Start+group.Count]...)
+		group.Start = start
+	}
+
+	return reordered, ordered
+}
+
+// pageGroups returns the group headings to show on a page of count
+// entries from start, keyed by their index on the page. A group that
+// started on a previous page is continued at the top of this one.
+func pageGroups(groups []reportGroup, start int, count int) map[int]*reportGroup {
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	headings := make(map[int]*reportGroup)
+	for _, group := range groups {
+
+		group := group
+
+		if group.Start >= start+count || group.Start+group.Count <= start {
+			continue
+		}
+
+		if group.Start < start {
+			group.Continued = true
+			headings[0] = &group
+			continue
+		}
+
+		headings[group.Start-start] = &group
+	}
+
+	return headings
+}
+
+// pageStarts returns the index of the first entry of each page.
+// With pages set, the entries are split over that many pages of
+// roughly the same size instead of pages of pageSize entries.
+func pageStarts(count int, pageSize int, pages int) []int {
+
+	var starts []int
+	if pages < 1 {
+		for i := 0; i < count; i += pageSize {
+			starts = append(starts, i)
+		}
+
+		return starts
+	}
+
+	if pages > count {
+		pages = count
+	}
+
+	for page := 0; page < pages; page++ {
+		starts = append(starts, page*count/pages)
+	}
+
+	return starts
+}
+
+// pageIndexLinks returns the links to the report pages shown on page
+// current of count. With a window, only the first and last pages and
+// those within window of current are linked, and the rest are elided
+// so that large reports keep a compact navigation.
+func pageIndexLinks(current int, count int, window int) string {
+
+	var index bytes.Buffer
+	elided := false
+	for page := 0; page < count; page++ {
+
+		if window > 0 && page != 0 && page != count-1 && (page < current-window || page > current+window) {
+			if !elided {
+				index.WriteString("&#8226;<span class=\"page-gap\">&hellip;</span>")
+				elided = true
+			}
+			continue
+		}
+
+		elided = false
+		if page == current {
+			index.WriteString(fmt.Sprintf("&#8226;<a class=\"page-number current-page\" href=\"page-%v.html\">%v</a>", page, page+1))
+		} else {
+			index.WriteString(fmt.Sprintf("&#8226;<a class=\"page-number\" href=\"page-%v.html\">%v</a>", page, page+1))
+		}
+	}
+
+	return index.String()
+}
+
+// resolveScreenshot returns the path to a screenshot as it should be
+// found while generating a report, or a placeholder if it is missing
+func resolveScreenshot(screenshotFile string) string {
+
+	// screenshots served from elsewhere are not needed locally
+	if reportScreenshotBaseURL != "" && screenshotFile != "" {
+		return screenshotFile
+	}
+
+	// screenshots kept in a bucket are fetched to where they
+	// would otherwise have been written
+	if utils.IsArtifactReference(screenshotFile) {
+
+		if options.Artifacts == nil {
+			log.WithField("screenshot-file", screenshotFile).
+				Warn("Screenshot is kept in a bucket, but no --s3-bucket was given to fetch it from")
+			return gwtmpl.PlaceHolderImage
+		}
+
+		local, err := options.Artifacts.Download(screenshotFile, chrome.ScreenshotPath)
+		if err != nil {
+			log.WithFields(log.Fields{"screenshot-file": screenshotFile, "err": err}).Warn("Failed to fetch screenshot from the bucket")
+			return gwtmpl.PlaceHolderImage
+		}
+
+		screenshotFile = local
+	}
+
+	// screenshots in a workspace are found relative to it, so
+	// that the workspace can be moved around
+	if outputDir != "" && screenshotFile != "" {
+		screenshotFile = filepath.Join(outputDir, workspaceScreenshots, filepath.Base(screenshotFile))
+	}
+
+	// check if the screenshot path exists. if not, slide in
+	// a placeholder image
+	if _, err := os.Stat(screenshotFile); os.IsNotExist(err) {
+
+		log.WithField("screenshot-file", screenshotFile).
+			Debug("Adding placeholder for missing screenshot")
+		return gwtmpl.PlaceHolderImage
+	}
+
+	return screenshotFile
+}
+
+// reportScreenshot returns how a report references a screenshot found
+// at screenshotFile. By default screenshots are expected beside the
+// report (or in the workspace screenshots directory), but
+// --screenshot-path may keep their original location or name another
+// directory holding them.
+func reportScreenshot(screenshotFile string, screenshotPrefix string) string {
+
+	if reportScreenshotBaseURL != "" {
+		return remoteScreenshot(reportScreenshotBaseURL, screenshotFile)
+	}
+
+	switch reportScreenshotPath {
+	case "":
+		return screenshotPrefix + filepath.Base(screenshotFile)
+
+	case screenshotPathOriginal:
+		// the report may not be written to the working directory
+		if absolute, err := filepath.Abs(screenshotFile); err == nil {
+			screenshotFile = absolute
+		}
+		return filepath.ToSlash(screenshotFile)
+	}
+
+	return filepath.ToSlash(filepath.Join(reportScreenshotPath, filepath.Base(screenshotFile)))
+}
+
+// remoteScreenshot returns the URL of a screenshot served from base. The
+// key of screenshots kept in a bucket is used, so that base may be the
+// bucket's public URL, or the file name otherwise.
+func remoteScreenshot(base string, screenshotFile string) string {
+
+	name := filepath.Base(screenshotFile)
+	if utils.IsArtifactReference(screenshotFile) {
+		name = utils.ArtifactKey(screenshotFile)
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(name, "/") {
+		segments = append(segments, url.PathEscape(segment))
+	}
+
+	return strings.TrimSuffix(base, "/") + "/" + strings.Join(segments, "/")
+}
+
+// writeFilmstripReport writes filmstrip.html, showing the captures
+// of each host in the order they were taken
+func writeFilmstripReport(reportDir string, screenshotPrefix string) {
+
+	history, err := db.GetHTTPHistory()
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to read capture history")
+	}
+
+	type Filmstrip struct {
+		Host   string
+		Frames []storage.HTTResponse
+	}
+
+	var filmstrips []*Filmstrip
+	hosts := make(map[string]*Filmstrip)
+	for _, entry := range history {
+
+		// failed captures have nothing to show
+		if entry.ScreenshotFile == "" {
+			continue
+		}
+
+		host := entry.URL
+		if u, err := url.Parse(entry.URL); err == nil {
+			host = u.Host
+		}
+
+		if _, ok := hosts[host]; !ok {
+			hosts[host] = &Filmstrip{Host: host}
+			filmstrips = append(filmstrips, hosts[host])
+		}
+
+		entry.ScreenshotFile = resolveScreenshot(entry.ScreenshotFile)
+		if entry.ScreenshotFile != gwtmpl.PlaceHolderImage {
+			entry.ScreenshotFile = reportScreenshot(entry.ScreenshotFile, screenshotPrefix)
+		}
+
+		hosts[host].Frames = append(hosts[host].Frames, entry)
+	}
+
+	sort.Slice(filmstrips, func(i, j int) bool {
+		return filmstrips[i].Host < filmstrips[j].Host
+	})
+
+	tmpl, err := template.New("filmstrip-page").Parse(gwtmpl.FilmstripContent)
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to parse filmstrip template")
+	}
+
+	var page bytes.Buffer
+	if err := tmpl.Execute(&page, struct {
+		Filmstrips []*Filmstrip
+	}{Filmstrips: filmstrips}); err != nil {
+		log.WithField("err", err).Fatal("Failed to render filmstrip template")
+	}
+
+	filmstripFile := filepath.Join(reportDir, "filmstrip.html")
+	if err := utils.WriteFileAtomic(filmstripFile, page.Bytes(), 0640); err != nil {
+		log.WithField("err", err).Fatal("Failed to write filmstrip report")
+	}
+
+	log.WithFields(log.Fields{"report-file": filmstripFile, "hosts": len(filmstrips)}).Info("Filmstrip report generated")
+}
+
+// writeErrorsReport writes errors.html, grouping the failed
+// entries by their error kind
+func writeErrorsReport(reportDir string, entries []storage.HTTResponse) {
+
+	type ErrorGroup struct {
+		Kind    string
+		Entries []storage.HTTResponse
+	}
+
+	var groups []ErrorGroup
+	for _, kind := range storage.ErrorKinds {
+
+		group := ErrorGroup{Kind: kind}
+		for _, entry := range entries {
+			if entry.ErrorKind == kind {
+				group.Entries = append(group.Entries, entry)
+			}
+		}
+
+		if len(group.Entries) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	tmpl, err := template.New("errors-page").Parse(gwtmpl.ErrorsContent)
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to parse errors template")
+	}
+
+	var page bytes.Buffer
+	if err := tmpl.Execute(&page, struct {
+		Total  int
+		Groups []ErrorGroup
+	}{Total: len(entries), Groups: groups}); err != nil {
+		log.WithField("err", err).Fatal("Failed to render errors template")
+	}
+
+	errorsFile := filepath.Join(reportDir, "errors.html")
+	if err := utils.WriteFileAtomic(errorsFile, page.Bytes(), 0640); err != nil {
+		log.WithField("err", err).Fatal("Failed to write errors report")
+	}
+
+	log.WithFields(log.Fields{"report-file": errorsFile, "errors": len(entries)}).Info("Errors report generated")
+}
+
+// writeReportIndex writes index.html, linking to the pages written so far
+func writeReportIndex(reportDir string, written int, pageCount int, entryCount int) {
+
+	type indexPage struct {
+		Number int
+		File   string
+	}
+
+	var pages []indexPage
+	for pageno := 0; pageno < written; pageno++ {
+		pages = append(pages, indexPage{Number: pageno + 1, File: fmt.Sprintf("page-%v.html", pageno)})
+	}
+
+	tmplIndex, err := template.New("report-index").Parse(gwtmpl.IndexContent)
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to parse index template")
+	}
+
+	var index bytes.Buffer
+	if err := tmplIndex.Execute(&index, struct {
+		Pages      []indexPage
+		Written    int
+		PageCount  int
+		EntryCount int
+	}{pages, written, pageCount, entryCount}); err != nil {
+		log.WithField("err", err).Fatal("Failed to render index template")
+	}
+
+	indexFile := filepath.Join(reportDir, "index.html")
+	if err := utils.WriteFileAtomic(indexFile, index.Bytes(), 0640); err != nil {
+		log.WithFields(log.Fields{"index-file": indexFile, "err": err}).Fatal("Failed to write report index")
+	}
+}
+
 func init() {
 	RootCmd.AddCommand(generateCmd)
 
 	//generateCmd.Flags().StringVarP(&reportDir, "report-dir", "n", "gowitnessReport", "Destination report directory")
 	generateCmd.Flags().IntVarP(&pageSize, "page-size", "p", 40, "Results Per Page")
+	generateCmd.Flags().IntVarP(&pages, "pages", "", 0, "Split the results over exactly this many pages of roughly equal size, instead of using --page-size")
+	generateCmd.Flags().IntVarP(&indexWindow, "index-window", "", 0, "Only link the first and last pages and this many pages either side of the current one from each page, eliding the rest (default links every page)")
 	generateCmd.Flags().BoolVarP(&includeErrors, "include-errors", "i", false, "Include non-200 responses")
+	generateCmd.Flags().BoolVarP(&onlyListings, "directory-listing", "", false, "Only include the entries that are directory listings")
+	generateCmd.Flags().StringVarP(&tagRulesFile, "tag-rules", "", "", "A YAML file of rules tagging the entries they match, eg: status == 200 && title contains \"admin\"")
+	generateCmd.Flags().BoolVarP(&showLegend, "legend", "", true, "Include a collapsible legend explaining the indicators shown in the report")
+	generateCmd.Flags().StringVarP(&linkTarget, "link-target", "", "_blank", "Where screenshots open when clicked without the lightbox (no JavaScript): _blank for a new tab or _self for the same one")
+	generateCmd.Flags().StringVarP(&reportScreenshotBaseURL, "screenshot-base-url", "", "", "URL the report should load screenshots from, such as a CDN or the public URL of the --s3-bucket, so that the report can be hosted apart from them")
+	generateCmd.Flags().StringVarP(&reportScreenshotPath, "screenshot-path", "", "", "Directory the report should load screenshots from, or keep-original to use the paths stored in the database (default is beside the report)")
+	generateCmd.Flags().StringVarP(&reportLayout, "layout", "", layoutGrid, "The report layout. Use evidence for a printable evidence.html, with a single captioned screenshot per page")
+	generateCmd.Flags().BoolVarP(&mobileStrip, "mobile-strip", "", false, "Show screenshots as narrow strips scrolling within their card, which keeps tall mobile captures readable")
+	generateCmd.Flags().BoolVarP(&sitemap, "sitemap", "", false, "Also generate sitemap.html, showing the captured URLs as a collapsible tree of their hosts and paths")
+	generateCmd.Flags().StringSliceVarP(&coverageCIDRs, "coverage", "", []string{}, "Also generate coverage.html, showing a grid of the addresses of this CIDR colored by whether a web service was found on them (Can specify more than one --coverage)")
+	generateCmd.Flags().BoolVarP(&filmstrip, "filmstrip", "", false, "Also generate filmstrip.html, showing the captures of each host over time (see --history)")
+	generateCmd.Flags().StringVarP(&sortBy, "sort", "", "title", "Sort the report entries by title, captured (the capture time) or complexity (the DOM size)")
+	generateCmd.Flags().StringVarP(&sortOrder, "sort-order", "", "", "The order to sort in, asc or desc (default is asc for title, desc otherwise)")
+	generateCmd.Flags().StringSliceVarP(&dedupeBy, "dedupe-by", "", []string{}, "Keep a single entry of those matching on all of these fields, counting the rest: any of title, favicon, server and status, eg: favicon,server")
+	generateCmd.Flags().StringVarP(&uniqueBy, "unique-by", "", "", "Keep a single entry of those sharing a value, counting the rest. Use title-server to keep one per page title and Server header")
+	generateCmd.Flags().StringVarP(&groupBy, "group-by", "", "", "Group the report entries. Use favicon to cluster entries sharing a favicon (captured with --fetch-favicon), auth-scheme to cluster 401 entries by the authentication they ask for (with --include-errors), or status to cluster them by status class")
+	generateCmd.Flags().StringSliceVarP(&groupOrder, "group-order", "", []string{}, "The order of the --group-by groups, eg: 5xx,4xx or ntlm,basic. Favicon groups are named by their hash, and entries outside every group by none. Groups not listed follow in alphabetical order")
 }
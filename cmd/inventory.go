@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/spf13/cobra"
+)
+
+// technologySummary is the inventory of a single technology
+type technologySummary struct {
+	Technology string   `json:"technology"`
+	Hosts      int      `json:"hosts"`
+	URLs       []string `json:"urls"`
+}
+
+// inventoryCmd represents the inventory command
+var inventoryCmd = &cobra.Command{
+	Use:   "inventory",
+	Short: "Summarise the detected technologies of a database file",
+	Long: `
+Summarise the technologies detected in a gowitness.db file, counting the
+hosts running each of them, most common first. Add --urls to list the
+URLs behind each count.
+
+The summary can be printed as text, or exported as json or csv with
+one row per technology and URL.
+
+For example:
+
+$ gowitness inventory
+$ gowitness inventory --urls --status 200
+$ gowitness inventory --format csv > technologies.csv`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		entries, err := db.GetHTTPData()
+		if err != nil {
+			log.WithField("err", err).Fatal("Failed to read entries from the database")
+		}
+
+		summaries := summariseTechnologies(inventoryFilter.filter(entries))
+
+		switch inventoryFormat {
+
+		case "text":
+			for _, summary := range summaries {
+
+				fmt.Printf("%s: %d hosts\n", summary.Technology, summary.Hosts)
+				if inventoryURLs {
+					for _, u := range summary.URLs {
+						fmt.Printf("  %s\n", u)
+					}
+				}
+			}
+
+		case "json":
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(summaries); err != nil {
+				log.WithField("err", err).Fatal("Failed to encode the summary")
+			}
+
+		case "csv":
+			writer := csv.NewWriter(os.Stdout)
+			writer.Write([]string{"technology", "hosts", "url"})
+			for _, summary := range summaries {
+				for _, u := range summary.URLs {
+					writer.Write([]string{summary.Technology, strconv.Itoa(summary.Hosts), u})
+				}
+			}
+			writer.Flush()
+
+		default:
+			log.WithField("format", inventoryFormat).Fatal("Invalid format. Use text, json or csv")
+		}
+
+		log.WithFields(log.Fields{"entries": len(entries), "technologies": len(summaries)}).Debug("Summarised technologies")
+	},
+}
+
+// summariseTechnologies counts the hosts each technology was detected
+// on, ordered by the number of hosts and then by name
+func summariseTechnologies(entries []storage.HTTResponse) []technologySummary {
+
+	type inventory struct {
+		summary technologySummary
+		hosts   map[string]bool
+		urls    map[string]bool
+	}
+
+	technologies := make(map[string]*inventory)
+	for _, entry := range entries {
+
+		target := entry.FinalURL
+		if target == "" {
+			target = entry.URL
+		}
+
+		host := target
+		if u, err := url.Parse(target); err == nil && u.Host != "" {
+			host = strings.ToLower(u.Host)
+		}
+
+		for _, technology := range entry.Technologies {
+
+			key := strings.ToLower(technology)
+			if _, ok := technologies[key]; !ok {
+				technologies[key] = &inventory{
+					summary: technologySummary{Technology: technology},
+					hosts:   make(map[string]bool), urls: make(map[string]bool),
+				}
+			}
+
+			found := technologies[key]
+			found.hosts[host] = true
+			if !found.urls[target] {
+				found.urls[target] = true
+				found.summary.URLs = append(found.summary.URLs, target)
+			}
+		}
+	}
+
+	var summaries []technologySummary
+	for _, found := range technologies {
+		found.summary.Hosts = len(found.hosts)
+		sort.Strings(found.summary.URLs)
+		summaries = append(summaries, found.summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Hosts != summaries[j].Hosts {
+			return summaries[i].Hosts > summaries[j].Hosts
+		}
+		return strings.ToLower(summaries[i].Technology) < strings.ToLower(summaries[j].Technology)
+	})
+
+	return summaries
+}
+
+func init() {
+	RootCmd.AddCommand(inventoryCmd)
+
+	inventoryCmd.Flags().StringVarP(&inventoryFormat, "format", "f", "text", "Summary format (text, json or csv)")
+	inventoryCmd.Flags().BoolVarP(&inventoryURLs, "urls", "", false, "List the URLs each technology was detected on in the text summary")
+	inventoryCmd.Flags().IntSliceVarP(&inventoryFilter.Status, "status", "s", []int{}, "Only summarise entries with this response code (Can specify more than one --status)")
+	inventoryCmd.Flags().StringSliceVarP(&inventoryFilter.Lang, "lang", "", []string{}, "Only summarise entries with this page language, eg: en or pt-BR (Can specify more than one --lang)")
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/RiskSense-Ops/gowitness/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// jobVersion is the version of the job file format
+const jobVersion = 1
+
+// jobTargetFlags produce the targets of a scan. The targets are saved
+// already expanded, so these flags are not.
+var jobTargetFlags = map[string]bool{
+	"source": true, "cidr": true, "file-cidr": true, "ports": true, "no-http": true, "no-https": true,
+	"random": true, "paths": true, "paths-file": true, "save-job": true, "config": true, "source-format": true,
+	"both-schemes": true,
+}
+
+// scanJob is a saved scan: the targets it resolved to and the flags
+// it was run with, so that it can be run again identically
+type scanJob struct {
+	Version   int               `json:"version"`
+	CreatedAt time.Time         `json:"created_at"`
+	Command   string            `json:"command"`
+	Flags     map[string]string `json:"flags"`
+	Targets   []jobTarget       `json:"targets"`
+}
+
+// jobTarget is a target of a saved scan
+type jobTarget struct {
+	jsonlTarget
+	Path   string              `json:"path,omitempty"`
+	Bare   bool                `json:"bare,omitempty"`
+	Source *storage.SourceInfo `json:"source,omitempty"`
+}
+
+// saveJob writes the targets of a scan and the flags set for it to
+// --save-job, if it was given
+func saveJob(cmd *cobra.Command, targets []fileTarget) {
+
+	if saveJobFile == "" {
+		return
+	}
+
+	job := scanJob{Version: jobVersion, CreatedAt: time.Now().UTC(), Command: cmd.Name(), Flags: make(map[string]string)}
+
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if !jobTargetFlags[flag.Name] {
+			job.Flags[flag.Name] = flag.Value.String()
+		}
+	})
+
+	for _, target := range targets {
+		job.Targets = append(job.Targets, jobTarget{
+			jsonlTarget: jsonlTarget{
+				URL: target.url.String(), Timeout: target.timeout, Resolution: target.resolution,
+				UserAgent: target.userAgent, Headers: target.headers, Cookies: target.cookies,
+			},
+			Path:   target.path,
+			Bare:   target.bare,
+			Source: target.source,
+		})
+	}
+
+	encoded, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to encode the job file")
+	}
+
+	if err := utils.WriteFileAtomic(saveJobFile, encoded, 0640); err != nil {
+		log.WithFields(log.Fields{"save-job": saveJobFile, "err": err}).Fatal("Failed to write the job file")
+	}
+
+	log.WithFields(log.Fields{"save-job": saveJobFile, "targets": len(job.Targets), "flags": len(job.Flags)}).
+		Info("Saved job file")
+}
+
+// readJob reads a job file saved with --save-job
+func readJob(name string) scanJob {
+
+	var job scanJob
+
+	encoded, err := ioutil.ReadFile(name)
+	if err != nil {
+		log.WithFields(log.Fields{"job": name, "err": err}).Fatal("Failed to read the job file")
+	}
+
+	if err := json.Unmarshal(encoded, &job); err != nil {
+		log.WithFields(log.Fields{"job": name, "err": err}).Fatal("Failed to parse the job file")
+	}
+
+	if job.Version != jobVersion {
+		log.WithFields(log.Fields{"job": name, "version": job.Version}).Fatal("Unsupported job file version")
+	}
+
+	return job
+}
+
+// applyJobFlags sets the flags a job was saved with. Flags given on the
+// command line take precedence.
+func applyJobFlags(cmd *cobra.Command, flags map[string]string) {
+
+	for name, value := range flags {
+
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil {
+			log.WithField("flag", name).Debug("Ignoring job flag that does not apply to replay")
+			continue
+		}
+
+		if flag.Changed {
+			continue
+		}
+
+		if err := setJobFlag(cmd.Flags(), flag, value); err != nil {
+			log.WithFields(log.Fields{"flag": name, "value": value, "err": err}).Fatal("Invalid flag in the job file")
+		}
+	}
+}
+
+// setJobFlag sets a flag from its saved string form. Lists are saved
+// as [a,b], and are set an element at a time where needed.
+func setJobFlag(flags *pflag.FlagSet, flag *pflag.Flag, value string) error {
+
+	switch flag.Value.Type() {
+
+	case "stringSlice", "intSlice":
+		return flags.Set(flag.Name, strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"))
+
+	case "stringArray":
+		elements, err := csv.NewReader(strings.NewReader(strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"))).Read()
+		if err != nil {
+			return err
+		}
+
+		for _, element := range elements {
+			if err := flags.Set(flag.Name, element); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return flags.Set(flag.Name, value)
+}
+
+// jobFileTargets converts the targets of a job back to the
+// targets they were saved from
+func jobFileTargets(job scanJob) []fileTarget {
+
+	var targets []fileTarget
+	for _, saved := range job.Targets {
+
+		u, err := url.ParseRequestURI(saved.URL)
+		if err != nil {
+			log.WithField("url", saved.URL).Warn("Skipping Invalid URL")
+			skipTarget(saved.URL, storage.SkipInvalidURL)
+			continue
+		}
+
+		targets = append(targets, fileTarget{
+			url: u, timeout: saved.Timeout, path: saved.Path, bare: saved.Bare, resolution: saved.Resolution,
+			userAgent: saved.UserAgent, headers: saved.Headers, cookies: saved.Cookies, source: saved.Source,
+		})
+	}
+
+	return targets
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import "github.com/RiskSense-Ops/gowitness/storage"
+
+// legendItem explains an indicator shown in the report
+type legendItem struct {
+	Class       string
+	Label       string
+	Description string
+}
+
+// legendIndicators are the indicators of the report cards, in the
+// order they are shown, along with a check for the entries showing them
+var legendIndicators = []struct {
+	legendItem
+	shown func(entry *storage.HTTResponse) bool
+}{
+	{legendItem{"badge-dark", "content type", "The response was structured data, kept as text instead of a screenshot"},
+		func(entry *storage.HTTResponse) bool { return entry.StructuredBody != "" }},
+	{legendItem{"badge-dark", "text only", "The page timed out or was too large to render, so only its text was kept (--text-fallback)"},
+		func(entry *storage.HTTResponse) bool { return entry.TextOnly != "" }},
+	{legendItem{"badge-info", "capture #n", "One of several repeated captures of the URL"},
+		func(entry *storage.HTTResponse) bool { return entry.Repeat > 0 }},
+	{legendItem{"badge-primary", "/path", "The --paths entry captured against the input URL"},
+		func(entry *storage.HTTResponse) bool { return entry.Path != "" }},
+	{legendItem{"badge-success", "tag", "A tag applied by one of the --tag-rules rules the entry matched"},
+		func(entry *storage.HTTResponse) bool { return len(entry.AutoTags) > 0 }},
+	{legendItem{"badge-light", "+?key=value", "Query parameters --append-query added to the captured URL"},
+		func(entry *storage.HTTResponse) bool { return entry.AppendedQuery != "" }},
+	{legendItem{"badge-light", "shodan: product", "The target came from a Shodan or Censys export, which reported this product. Hover for the organisation and hostnames"},
+		func(entry *storage.HTTResponse) bool { return entry.Source != nil }},
+	{legendItem{"badge-danger", "directory listing", "The server generated a listing of the files in the directory, exposing them all"},
+		func(entry *storage.HTTResponse) bool { return entry.DirectoryListing }},
+	{legendItem{"badge-warning", "default favicon", "The page uses the favicon an application ships with, suggesting a default install"},
+		func(entry *storage.HTTResponse) bool { return entry.Favicon != nil && entry.Favicon.DefaultApp != "" }},
+	{legendItem{"badge-light", "firefox", "Captured with Firefox instead of Chrome"},
+		func(entry *storage.HTTResponse) bool { return entry.Engine == "firefox" }},
+	{legendItem{"badge-info", "ntlm authenticated", "The server asked for authentication, which was answered with --ntlm-user"},
+		func(entry *storage.HTTResponse) bool { return entry.AuthScheme != "" }},
+	{legendItem{"badge-danger", "allows PUT, DELETE", "The server allows methods that can change what it holds or reflect requests back. Hover for every allowed method"},
+		func(entry *storage.HTTResponse) bool {
+			return entry.AllowedMethods != nil && len(entry.AllowedMethods.Dangerous) > 0
+		}},
+	{legendItem{"badge-light", "allows GET, POST", "The methods the server allows, from an OPTIONS request (--check-methods) or a 405 response"},
+		func(entry *storage.HTTResponse) bool {
+			return entry.AllowedMethods != nil && len(entry.AllowedMethods.Dangerous) == 0
+		}},
+	{legendItem{"badge-info", "auth required: scheme", "The server answered 401, asking for this authentication scheme in the realm shown"},
+		func(entry *storage.HTTResponse) bool { return len(entry.AuthRequired) > 0 }},
+	{legendItem{"badge-warning", "auth gated, bounced to login", "The URL redirected to a login page, so the resource itself needs authentication"},
+		func(entry *storage.HTTResponse) bool { return entry.LoginRedirect }},
+	{legendItem{"badge-light", "meta refresh", "A hop of the redirect chain was a page redirecting with a meta refresh tag rather than a 3xx response (see --follow-meta-refresh)"},
+		func(entry *storage.HTTResponse) bool {
+			for _, hop := range entry.RedirectChain {
+				if hop.MetaRefresh {
+					return true
+				}
+			}
+			return false
+		}},
+	{legendItem{"badge-light", "pinned to address", "The host was connected to at a --resolve address instead of resolving it"},
+		func(entry *storage.HTTResponse) bool { return entry.PinnedAddress != "" }},
+	{legendItem{"badge-warning", "downgraded to http", "The TLS handshake failed, so the URL was captured over http instead"},
+		func(entry *storage.HTTResponse) bool { return entry.Downgraded }},
+	{legendItem{"badge-light", "no screenshot", "The response code was not one of --screenshot-statuses, so only the metadata of the response was kept"},
+		func(entry *storage.HTTResponse) bool { return entry.MetadataOnly }},
+	{legendItem{"badge-light", "HTTP/1.0", "The pre-flight requests were sent as HTTP/1.0 with --http10. Hover for the protocol the server answered with"},
+		func(entry *storage.HTTResponse) bool { return entry.HTTP10 }},
+	{legendItem{"badge-light", "chrome crashed", "Chrome crashed while capturing the page, which was retried in a new process up to --crash-retries times"},
+		func(entry *storage.HTTResponse) bool { return entry.ChromeCrashes > 0 }},
+	{legendItem{"badge-light", "crawled", "The URL was not an input, it was found by following the links of the pages captured, this many links deep"},
+		func(entry *storage.HTTResponse) bool { return entry.CrawlDepth > 0 }},
+	{legendItem{"badge-light", "probed scheme", "The host was listed without a scheme, so it was captured over the first one it answered on"},
+		func(entry *storage.HTTResponse) bool { return entry.ProbedScheme != "" }},
+	{legendItem{"badge-danger", "mixed content", "The https page loaded subresources over http"},
+		func(entry *storage.HTTResponse) bool { return len(entry.MixedContent) > 0 }},
+	{legendItem{"badge-warning", "charset mismatch", "The charset the page declared disagrees with another declaration or with how the body is encoded, a sign of a misconfigured or legacy server"},
+		func(entry *storage.HTTResponse) bool { return entry.CharsetMismatch != nil }},
+	{legendItem{"badge-danger", "content-type mismatch", "The Content-Type the server sent disagrees with what the body sniffs as, such as HTML sent as text/plain, which browsers that sniff it may render as HTML. Shown as a warning when the response sends X-Content-Type-Options: nosniff"},
+		func(entry *storage.HTTResponse) bool { return entry.SniffMismatch != nil }},
+	{legendItem{"badge-warning", "js errors", "The page threw JavaScript exceptions it did not catch, which often means a broken or misconfigured application"},
+		func(entry *storage.HTTResponse) bool { return len(entry.JSExceptions) > 0 }},
+	{legendItem{"badge-light", "challenge passed", "The page showed a JavaScript challenge, such as Cloudflare checking the browser, which resolved into the real page that was captured"},
+		func(entry *storage.HTTResponse) bool { return entry.Challenge != nil && entry.Challenge.Solved }},
+	{legendItem{"badge-warning", "challenge", "The page showed a JavaScript challenge that was not solved, so the challenge itself was captured. Try a longer --challenge-wait"},
+		func(entry *storage.HTTResponse) bool { return entry.Challenge != nil && !entry.Challenge.Solved }},
+	{legendItem{"badge-warning", "unlabeled (a11y)", "Buttons, images, links or form fields in the accessibility tree have no name a screen reader could announce"},
+		func(entry *storage.HTTResponse) bool { return entry.AXTree != nil && entry.AXTree.Unlabeled() > 0 }},
+	{legendItem{"badge-info", "links to other hosts", "The canonical or hreflang alternate links point to hosts other than the page's own"},
+		func(entry *storage.HTTResponse) bool { return len(entry.LinkedHosts) > 0 }},
+	{legendItem{"badge-danger", "weak protocol", "The server accepts TLS 1.0 or 1.1"},
+		func(entry *storage.HTTResponse) bool {
+			for _, version := range entry.SSL.Versions {
+				if version.Accepted && version.Weak {
+					return true
+				}
+			}
+			return false
+		}},
+	{legendItem{"badge-danger", "certificate problem", "The certificate is expired, self-signed, for another host or otherwise untrusted"},
+		func(entry *storage.HTTResponse) bool {
+			return entry.SSL.Validity != "" && entry.SSL.Validity != storage.CertificateValid
+		}},
+	{legendItem{"badge-danger", "incomplete chain", "The certificate chain the server presented stops short of a trusted root, as when it leaves an intermediate out"},
+		func(entry *storage.HTTResponse) bool { return entry.SSL.ChainIncomplete }},
+	{legendItem{"badge-info", "dialog dismissed", "A consent dialog was clicked away before the screenshot"},
+		func(entry *storage.HTTResponse) bool { return entry.DialogDismissed }},
+	{legendItem{"badge-info", "clicked through", "A --click-selector was clicked before the screenshot"},
+		func(entry *storage.HTTResponse) bool { return len(entry.Clicked) > 0 }},
+	{legendItem{"badge-warning", "wait timed out", "The --wait-for-selector element never appeared"},
+		func(entry *storage.HTTResponse) bool { return entry.WaitTimedOut }},
+	{legendItem{"badge-light", "scrolled nx", "The page was scrolled to load more content"},
+		func(entry *storage.HTTResponse) bool { return entry.ScrollIterations > 0 }},
+	{legendItem{"badge-light", "accept", "The Accept header sent while capturing, which chose the representation shown (see --accept)"},
+		func(entry *storage.HTTResponse) bool { return entry.Accept != "" }},
+	{legendItem{"badge-light", "throttled", "The page was captured under an emulated slow network (see --throttle)"},
+		func(entry *storage.HTTResponse) bool { return entry.Throttle != "" }},
+	{legendItem{"badge-light", "touch", "The page was captured with an emulated touch screen (see --touch)"},
+		func(entry *storage.HTTResponse) bool { return entry.Touch }},
+	{legendItem{"badge-light", "mobile", "The page was captured emulating a mobile device (see --mobile)"},
+		func(entry *storage.HTTResponse) bool { return entry.Mobile }},
+	{legendItem{"badge-light", "scale", "The device pixel ratio the page was captured at (see --scale-factor)"},
+		func(entry *storage.HTTResponse) bool { return entry.ScaleFactor > 0 }},
+	{legendItem{"badge-light", "gpu mode", "The page was rendered with --gpu swiftshader or hardware, which draw canvas and WebGL content that is otherwise blank"},
+		func(entry *storage.HTTResponse) bool { return entry.Rendering != "" && entry.Rendering != "off" }},
+	{legendItem{"badge-light", "background", "The color the page was rendered against"},
+		func(entry *storage.HTTResponse) bool { return entry.Background != "" }},
+	{legendItem{"badge-light", "reduced motion", "Animations and transitions were disabled"},
+		func(entry *storage.HTTResponse) bool { return entry.ReducedMotion }},
+	{legendItem{"badge-light", "clipped", "The screenshot was clipped to this height"},
+		func(entry *storage.HTTResponse) bool { return entry.ClippedHeight > 0 }},
+	{legendItem{"badge-light", "lang", "The language the page declares"},
+		func(entry *storage.HTTResponse) bool { return entry.Lang != "" }},
+	{legendItem{"badge-secondary", "technology", "A technology detected from the headers and body"},
+		func(entry *storage.HTTResponse) bool { return len(entry.Technologies) > 0 }},
+}
+
+// reportLegend returns the legend for the indicators shown
+// by at least one of the entries
+func reportLegend(entries []storage.HTTResponse) []legendItem {
+
+	var legend []legendItem
+	for _, indicator := range legendIndicators {
+
+		for i := range entries {
+			if indicator.shown(&entries[i]) {
+				legend = append(legend, indicator.legendItem)
+				break
+			}
+		}
+	}
+
+	return legend
+}
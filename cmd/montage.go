@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	gwtmpl "github.com/RiskSense-Ops/gowitness/template"
+	"github.com/RiskSense-Ops/gowitness/utils"
+	"github.com/spf13/cobra"
+)
+
+// montageCmd represents the montage command
+var montageCmd = &cobra.Command{
+	Use:   "montage",
+	Short: "Generate a single overview image of all screenshots",
+	Long: `
+Generate a single contact-sheet image containing thumbnails of the
+screenshots found in a gowitness.db file, laid out in a grid. The
+same --status and --technology filters as the export command may be
+used to limit the screenshots included.
+
+For example:
+
+$ gowitness montage
+$ gowitness montage --output overview.png --columns 10 --captions
+$ gowitness montage --status 200 --technology Jenkins --thumb-width 480`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		if montageColumns < 1 {
+			log.WithField("columns", montageColumns).Fatal("Invalid number of columns provided")
+		}
+
+		if montageThumbWidth < 1 {
+			log.WithField("thumb-width", montageThumbWidth).Fatal("Invalid thumbnail width provided")
+		}
+
+		entries, err := db.GetHTTPData()
+		if err != nil {
+			log.WithField("err", err).Fatal("Failed to read entries from the database")
+		}
+
+		var tiles []utils.MontageTile
+		for _, entry := range montageFilter.filter(entries) {
+
+			screenshot := resolveScreenshot(entry.ScreenshotFile)
+			if screenshot == gwtmpl.PlaceHolderImage {
+				log.WithField("screenshot-file", entry.ScreenshotFile).Debug("Skipping missing screenshot")
+				continue
+			}
+
+			tiles = append(tiles, utils.MontageTile{File: screenshot, Caption: entry.URL})
+		}
+
+		if len(tiles) <= 0 {
+			log.WithField("count", len(tiles)).Error("No screenshots exist to create a montage")
+			return
+		}
+
+		log.WithFields(log.Fields{"screenshots": len(tiles), "columns": montageColumns}).Info("Generating montage")
+		montage := utils.Montage(tiles, montageColumns, montageThumbWidth, montageCaptions)
+
+		if err := utils.WritePNG(montageOutput, montage); err != nil {
+			log.WithFields(log.Fields{"output": montageOutput, "err": err}).Fatal("Failed to write montage")
+		}
+
+		log.WithField("montage-file", montageOutput).Info("Montage generated")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(montageCmd)
+
+	montageCmd.Flags().StringVarP(&montageOutput, "output", "o", "montage.png", "The PNG file to write the montage to")
+	montageCmd.Flags().IntVarP(&montageColumns, "columns", "c", 8, "Number of thumbnails per row")
+	montageCmd.Flags().IntVarP(&montageThumbWidth, "thumb-width", "w", 320, "Width in pixels of each thumbnail")
+	montageCmd.Flags().BoolVarP(&montageCaptions, "captions", "", false, "Caption each thumbnail with its URL")
+	montageCmd.Flags().IntSliceVarP(&montageFilter.Status, "status", "s", []int{}, "Only include entries with this response code (Can specify more than one --status)")
+	montageCmd.Flags().StringSliceVarP(&montageFilter.Technology, "technology", "", []string{}, "Only include entries with this detected technology (Can specify more than one --technology)")
+	montageCmd.Flags().StringSliceVarP(&montageFilter.Lang, "lang", "", []string{}, "Only include entries with this page language, eg: en or pt-BR (Can specify more than one --lang)")
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"bufio"
+	"net/url"
+	"os"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// maxPaths bounds the number of --paths captured per host, so that
+// a large paths file cannot explode the number of URLs to process
+const maxPaths int = 100
+
+// readPaths reads the paths from the --paths flags and --paths-file,
+// removing duplicates
+func readPaths() []string {
+
+	candidates := append([]string{}, capturePaths...)
+
+	if capturePathsFile != "" {
+
+		file, err := os.Open(capturePathsFile)
+		if err != nil {
+			log.WithFields(log.Fields{"file": capturePathsFile, "err": err}).Fatal("Error reading paths file")
+		}
+		defer file.Close()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			candidates = append(candidates, scanner.Text())
+		}
+	}
+
+	var paths []string
+	seen := make(map[string]bool)
+	for _, path := range candidates {
+
+		path = strings.TrimSpace(path)
+		if path == "" || strings.HasPrefix(path, "#") {
+			continue
+		}
+
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		paths = append(paths, path)
+	}
+
+	if len(paths) > maxPaths {
+		log.WithFields(log.Fields{"paths": len(paths), "max": maxPaths}).Warn("Too many paths, only using the first ones")
+		paths = paths[:maxPaths]
+	}
+
+	return paths
+}
+
+// pathTarget is a URL generated by appending a path
+// to an input URL
+type pathTarget struct {
+	url  *url.URL
+	path string
+}
+
+// expandPaths returns the URL along with a URL for each of the
+// paths appended to it
+func expandPaths(u *url.URL, paths []string) []pathTarget {
+
+	targets := []pathTarget{{url: u}}
+	for _, path := range paths {
+
+		target := *u
+		target.Path = strings.TrimRight(u.Path, "/") + path
+		target.RawPath = ""
+
+		targets = append(targets, pathTarget{url: &target, path: path})
+	}
+
+	return targets
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+// defaultPorts are the ports of URLs that do not give one
+var defaultPorts = map[string]string{"http": "80", "https": "443"}
+
+// scanPlan is the breakdown of the URLs a scan resolves to, printed
+// by --dry-run instead of capturing them
+type scanPlan struct {
+	rows    [][2]string
+	factors []int64
+	units   []string
+	unique  int64
+	sampled int64
+}
+
+// factor adds a dimension that multiplies the number of URLs
+func (plan *scanPlan) factor(name string, count int64, unit string, detail string) {
+
+	row := strconv.FormatInt(count, 10)
+	if detail != "" {
+		row += " (" + detail + ")"
+	}
+
+	plan.rows = append(plan.rows, [2]string{name, row})
+	plan.factors = append(plan.factors, count)
+	plan.units = append(plan.units, unit)
+}
+
+// total multiplies the factors of the plan
+func (plan *scanPlan) total() int64 {
+
+	total := int64(1)
+	for _, count := range plan.factors {
+		total *= count
+	}
+
+	return total
+}
+
+// write prints the plan, showing how the total was arrived at
+func (plan *scanPlan) write(out io.Writer) {
+
+	writer := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	for _, row := range plan.rows {
+		fmt.Fprintf(writer, "%s\t%s\n", row[0], row[1])
+	}
+
+	var terms []string
+	for i, count := range plan.factors {
+		terms = append(terms, strconv.FormatInt(count, 10)+" "+plan.units[i])
+	}
+	fmt.Fprintf(writer, "total\t%s = %d URLs\n", strings.Join(terms, " × "), plan.total())
+
+	if plan.unique > 0 && plan.unique != plan.total() {
+		fmt.Fprintf(writer, "\t%d after removing duplicates\n", plan.unique)
+	}
+
+	if plan.sampled > 0 {
+		fmt.Fprintf(writer, "\t%d keeping one per host\n", plan.sampled)
+	}
+
+	writer.Flush()
+}
+
+// cidrHostCount returns the number of hosts scan captures for a CIDR,
+// without expanding it. The network and broadcast addresses are not
+// counted, as with utils.Hosts.
+func cidrHostCount(cidr string) (int64, error) {
+
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return 0, err
+	}
+
+	ones, bits := network.Mask.Size()
+	if bits-ones > 62 {
+		return 0, errors.Errorf("%s is too large to scan", cidr)
+	}
+
+	count := int64(1) << uint(bits-ones)
+	if count > 1 {
+		count -= 2
+	}
+
+	return count, nil
+}
+
+// pathsDetail describes the paths captured against every URL
+func pathsDetail(paths []string) string {
+
+	shown := append([]string{"the URL itself"}, paths...)
+	if len(shown) > 5 {
+		return strings.Join(shown[:5], ", ") + ", ..."
+	}
+
+	return strings.Join(shown, ", ")
+}
+
+// urlsDetail describes the hosts, ports and schemes of the URLs read
+// from a source, which are given by each URL rather than multiplied
+func urlsDetail(urls []*url.URL) string {
+
+	hosts := make(map[string]bool)
+	ports := make(map[string]bool)
+	schemes := make(map[string]bool)
+	for _, u := range urls {
+
+		hosts[u.Hostname()] = true
+		schemes[u.Scheme] = true
+
+		port := u.Port()
+		if port == "" {
+			port = defaultPorts[u.Scheme]
+		}
+		ports[port] = true
+	}
+
+	return fmt.Sprintf("%d hosts, %d ports, %d schemes", len(hosts), len(ports), len(schemes))
+}
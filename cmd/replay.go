@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// replayCmd represents the replay command
+var replayCmd = &cobra.Command{
+	Use:   "replay [job file]",
+	Short: "Run a scan again from a job file saved with --save-job",
+	Long: `
+Run a scan again from a job file saved with the --save-job flag of the
+file and scan commands. The job holds the targets the scan resolved to,
+after --paths were expanded and duplicates removed, along with the flags
+it was run with, so the same URLs are captured in the same way.
+
+Flags given on the command line take precedence over those in the job.
+
+For example:
+
+$ gowitness file -s urls.txt --paths /admin --save-job job.json
+$ gowitness replay job.json
+$ gowitness replay job.json --output-dir monitoring/2019-01-02`,
+	Args: cobra.ExactArgs(1),
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+
+		// the job's flags have to be in place before the global
+		// options are built from them
+		replayJob = readJob(args[0])
+		applyJobFlags(cmd, replayJob.Flags)
+
+		RootCmd.PersistentPreRun(cmd, args)
+	},
+	PreRun: checkSandbox,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		targets := jobFileTargets(replayJob)
+		log.WithFields(log.Fields{
+			"job": args[0], "command": replayJob.Command, "created-at": replayJob.CreatedAt, "targets": len(targets),
+		}).Info("Replaying job")
+
+		captureFileTargets(targets, "Replaying job")
+		crawlLinks()
+
+		log.WithFields(log.Fields{"run-time": time.Since(startTime)}).Info("Complete")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(replayCmd)
+
+	replayCmd.Flags().IntVarP(&maxThreads, "threads", "t", 4, "Maximum concurrent threads to run")
+}
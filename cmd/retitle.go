@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/spf13/cobra"
+)
+
+// retitleSources are the --from sources of display titles
+var retitleSources = map[string]func(entry *storage.HTTResponse) string{
+	"og:title": func(entry *storage.HTTResponse) string {
+		if entry.TitleSources == nil {
+			return ""
+		}
+		return entry.TitleSources.OGTitle
+	},
+	"h1": func(entry *storage.HTTResponse) string {
+		if entry.TitleSources == nil {
+			return ""
+		}
+		return entry.TitleSources.Heading
+	},
+	"description": func(entry *storage.HTTResponse) string {
+		if entry.TitleSources == nil {
+			return ""
+		}
+		return entry.TitleSources.Description
+	},
+}
+
+// retitleCmd represents the retitle command
+var retitleCmd = &cobra.Command{
+	Use:   "retitle",
+	Short: "Derive display titles for the entries of a database file",
+	Long: `
+Derive a display title for the entries of a gowitness.db file, shown
+in reports and used to sort them in place of the page title. Nothing
+is captured again: the og:title, first h1 heading and meta description
+of pages are recorded when capturing them.
+
+The sources given with --from are tried in order, the first a page
+has being used. With --pattern, a regular expression is matched
+against the response body of pages (recorded with --save-body) before
+the sources. Its first group, or the whole match without one, becomes
+the title.
+
+Only entries whose page title matches --when are retitled, which
+keeps the titles that are helpful already. --clear shows the page
+titles again.
+
+For example:
+
+$ gowitness retitle --from og:title,h1
+$ gowitness retitle --from h1,description --when '^(Home|Dashboard|Login)?$'
+$ gowitness retitle --pattern 'Firmware version ([\d.]+)'
+$ gowitness retitle --clear`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		var pattern *regexp.Regexp
+		if retitlePattern != "" {
+
+			compiled, err := regexp.Compile(retitlePattern)
+			if err != nil {
+				log.WithFields(log.Fields{"pattern": retitlePattern, "err": err}).Fatal("Invalid pattern provided")
+			}
+			pattern = compiled
+		}
+
+		when, err := regexp.Compile(retitleWhen)
+		if err != nil {
+			log.WithFields(log.Fields{"when": retitleWhen, "err": err}).Fatal("Invalid title pattern provided")
+		}
+
+		for _, source := range retitleFrom {
+			if _, ok := retitleSources[source]; !ok {
+				log.WithField("from", source).Fatal("Invalid title source provided. Use og:title, h1 or description")
+			}
+		}
+
+		if !retitleClear && pattern == nil && len(retitleFrom) == 0 {
+			log.Fatal("Give the title sources to use with --from or --pattern, or --clear")
+		}
+
+		entries, err := db.GetHTTPData()
+		if err != nil {
+			log.WithField("err", err).Fatal("Failed to read entries from the database")
+		}
+
+		retitled := 0
+		for _, entry := range entries {
+
+			if !when.MatchString(entry.PageTitle) {
+				continue
+			}
+
+			title := ""
+			if !retitleClear {
+				title = displayTitle(&entry, pattern, retitleFrom)
+				if title == "" {
+					log.WithField("url", entry.URL).Debug("No title source found for entry")
+					continue
+				}
+			}
+
+			if title == entry.DisplayTitle {
+				continue
+			}
+
+			if err := db.SetDisplayTitle(entry.Key(), title); err != nil {
+				log.WithFields(log.Fields{"url": entry.URL, "err": err}).Fatal("Failed to store display title")
+			}
+
+			log.WithFields(log.Fields{"url": entry.URL, "title": entry.PageTitle, "display-title": title}).Debug("Retitled entry")
+			retitled++
+		}
+
+		log.WithFields(log.Fields{"entries": len(entries), "retitled": retitled}).Info("Retitled entries")
+	},
+}
+
+// displayTitle returns the title an entry should be shown with, from
+// the first of pattern and sources that gives one
+func displayTitle(entry *storage.HTTResponse, pattern *regexp.Regexp, sources []string) string {
+
+	if pattern != nil {
+		if match := pattern.FindStringSubmatch(entry.Body); len(match) > 1 {
+			return strings.TrimSpace(match[1])
+		} else if len(match) == 1 {
+			return strings.TrimSpace(match[0])
+		}
+	}
+
+	for _, source := range sources {
+		if title := retitleSources[source](entry); title != "" {
+			return title
+		}
+	}
+
+	return ""
+}
+
+// reportTitle returns the title an entry is shown and sorted with
+func reportTitle(entry *storage.HTTResponse) string {
+
+	if entry.DisplayTitle != "" {
+		return entry.DisplayTitle
+	}
+
+	return entry.PageTitle
+}
+
+func init() {
+	RootCmd.AddCommand(retitleCmd)
+
+	retitleCmd.Flags().StringSliceVarP(&retitleFrom, "from", "", []string{}, "The sources to take titles from, in order: og:title, h1 or description (Can specify more than one --from)")
+	retitleCmd.Flags().StringVarP(&retitlePattern, "pattern", "", "", "A regular expression matched against the response body of pages, recorded with --save-body. Its first group becomes the title")
+	retitleCmd.Flags().StringVarP(&retitleWhen, "when", "", "", "Only retitle entries whose page title matches this regular expression (default is every entry)")
+	retitleCmd.Flags().BoolVarP(&retitleClear, "clear", "", false, "Remove the display titles, showing the page titles again")
+}
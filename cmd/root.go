@@ -3,15 +3,19 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
-	homedir "github.com/mitchellh/go-homedir"
 	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+	homedir "github.com/mitchellh/go-homedir"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/RiskSense-Ops/gowitness/firefox"
 	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/RiskSense-Ops/gowitness/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -21,6 +25,18 @@ var (
 	chrome     chrm.Chrome
 	db         storage.Storage
 	dbLocation string
+	outputDir  string
+	options    utils.Options
+	publisher  *utils.Publisher
+	mailer     *utils.Mailer
+
+	// time series
+	keepHistory bool
+
+	// input de-duplication
+	allowDuplicates bool
+	sampleHosts     bool
+	sampleHostsKeep string
 
 	// logging
 	logLevel  string
@@ -31,15 +47,150 @@ var (
 	resolution    string
 	chromeTimeout int
 	chromePath    string
+	noSandbox     bool
+	engineName    string
+	geckoPath     string
+	firefoxPath   string
 	userAgent     string
+	viewportOnly  bool
+	captureHeight int
+	tiles         int
+
+	// screenshot encoding flags
+	screenshotFormat string
+	jpegQuality      int
+	jpegSubsampling  string
+	pngCompression   int
+
+	// scroll capture flags
+	scrollRequests int
+	maxScrolls     int
+
+	// page content flags
+	saveDOMText bool
+	saveAXTree  bool
+
+	// debugging flags
+	verboseCapture bool
+
+	// reduced motion flags
+	reducedMotion bool
+
+	// device emulation flags
+	touch       bool
+	mobile      bool
+	scaleFactor float64
+
+	// rendering flags
+	gpuMode string
+
+	// background color flags
+	background string
+
+	// network throttling flags
+	throttle string
+
+	// content negotiation flags
+	accept string
+
+	// chrome interaction flags
+	dismissDialogs   bool
+	dismissSelectors []string
+	beforeDismiss    bool
+	clickSelectors   []string
+	waitSelector     string
+	waitForTimeout   int
+	challengeWait    int
+	challengeReloads int
+	crashRetries     int
+	localStorage     []string
+	sessionStorage   []string
+	appendQuery      []string
+
+	// preflight request flags
+	downgradeOnTLSError bool
+	http10              bool
+	screenshotStatuses  []int
+	preferScheme        string
+	dnsConcurrency      int
+	perHostConcurrency  int
+	maxRedirects        int
+	followMetaRefresh   bool
+	resolveEntries      []string
+	saveRequest         bool
+	saveBody            bool
+	rawHeaders          bool
+	checkMethods        bool
+	grabBanner          bool
+	matchBody           string
+	publishBroker       string
+	publishAddress      string
+	publishTopic        string
+	smtpServer          string
+	smtpUser            string
+	smtpPassword        string
+	emailFrom           string
+	emailTo             []string
+	summaryReportURL    string
+	summaryKnownHosts   map[string]bool
+	heroHeight          int
+	blurRadius          int
+	tlsScan             bool
+	http2Push           bool
+	fetchFavicon        bool
+	loginPattern        string
+	ntlmUser            string
+	ntlmPassword        string
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	idleConnTimeout     int
+	connectionClose     bool
+
+	// capture limits
+	maxDisk     string
+	maxMemory   string
+	changedOnly bool
+
+	// progress checkpoints
+	checkpointFile     string
+	checkpointInterval int
+	textFallback       bool
+	textFallbackSize   string
+
+	// link crawling
+	saveLinks        bool
+	crossOriginLinks bool
+	crawlDepth       int
+
+	// tracing
+	otlpEndpoint string
+
+	// artifact store flags
+	s3Endpoint string
+	s3Bucket   string
+	s3Region   string
+	s3Prefix   string
 
 	// screenshot command flags
 	screenshotURL         string
 	screenshotDestination string
+	repeatCount           int
+	repeatInterval        int
+
+	// paths captured against every input URL
+	capturePaths     []string
+	capturePathsFile string
 
 	// file scanner command flags
-	sourceFile string
-	maxThreads int
+	sourceFile   string
+	sourceFormat string
+	maxThreads   int
+	saveJobFile  string
+	dryRun       bool
+	bothSchemes  bool
+
+	// replay command
+	replayJob scanJob
 
 	// range scanner command flags
 	scanCidr           []string
@@ -50,9 +201,67 @@ var (
 	randomPermutations bool
 
 	// generate command
-	reportDir string
-	pageSize int
-	includeErrors bool
+	reportDir               string
+	pageSize                int
+	pages                   int
+	indexWindow             int
+	includeErrors           bool
+	onlyListings            bool
+	tagRulesFile            string
+	filmstrip               bool
+	sitemap                 bool
+	coverageCIDRs           []string
+	showLegend              bool
+	linkTarget              string
+	mobileStrip             bool
+	reportLayout            string
+	reportScreenshotPath    string
+	reportScreenshotBaseURL string
+	groupBy                 string
+	groupOrder              []string
+	uniqueBy                string
+	dedupeBy                []string
+	sortBy                  string
+	sortOrder               string
+
+	// export command
+	exportFormat      string
+	exportFilter      entryFilter
+	exportSkipped     bool
+	exportSkipReasons []string
+	exportSpreadsheet string
+	exportPEMDir      string
+
+	exportScreenshotBaseURL string
+
+	// montage command
+	montageOutput     string
+	montageColumns    int
+	montageThumbWidth int
+	montageCaptions   bool
+	montageFilter     entryFilter
+
+	// inventory command
+	inventoryFormat string
+	inventoryURLs   bool
+	inventoryFilter entryFilter
+
+	// retitle command
+	retitleFrom    []string
+	retitlePattern string
+	retitleWhen    string
+	retitleClear   bool
+
+	// scope-check command
+	scopeInput string
+	scopeCIDRs []string
+
+	// server command
+	serverAddress string
+
+	// visual-diff command
+	visualDiffURL    string
+	visualDiffOutput string
 
 	// execution time
 	startTime = time.Now()
@@ -61,6 +270,13 @@ var (
 	version = "2.0.0"
 )
 
+// the layout of an --output-dir workspace
+const (
+	workspaceDb          string = "gowitness.db"
+	workspaceScreenshots string = "screenshots"
+	workspaceReports     string = "reports"
+)
+
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
 	Use:   "gowitness",
@@ -74,9 +290,250 @@ var RootCmd = &cobra.Command{
 			Resolution:    resolution,
 			ChromeTimeout: chromeTimeout,
 			Path:          chromePath,
+			NoSandbox:     noSandbox,
 			UserAgent:     userAgent,
+			ViewportOnly:  viewportOnly,
+			CaptureHeight: captureHeight,
+			Tiles:         tiles,
+
+			ScrollRequests: scrollRequests,
+			MaxScrolls:     maxScrolls,
+			SaveDOMText:    saveDOMText,
+			SaveAXTree:     saveAXTree,
+			ReducedMotion:  reducedMotion,
+			Touch:          touch,
+			Mobile:         mobile,
+			ScaleFactor:    scaleFactor,
+			GPU:            gpuMode,
+			Background:     background,
+			Throttle:       strings.ToLower(throttle),
+			Accept:         accept,
+
+			AuthUsername: ntlmUser,
+			AuthPassword: ntlmPassword,
+
+			DismissDialogs:       dismissDialogs,
+			DismissSelectors:     dismissSelectors,
+			CaptureBeforeDismiss: beforeDismiss,
+
+			ClickSelectors:   clickSelectors,
+			WaitSelector:     waitSelector,
+			WaitTimeout:      waitForTimeout,
+			ChallengeWait:    challengeWait,
+			ChallengeReloads: challengeReloads,
+			CrashRetries:     crashRetries,
+			LocalStorage:     parseKeyValues("local-storage", localStorage),
+			SessionStorage:   parseKeyValues("session-storage", sessionStorage),
+		}
+
+		if maxMemory != "" {
+
+			limit, err := utils.ParseSize(maxMemory)
+			if err != nil || limit == 0 {
+				log.WithFields(log.Fields{"max-memory": maxMemory, "error": err}).Fatal("Invalid memory limit provided")
+			}
+
+			if chrm.MemoryMonitorSupported() {
+				chrome.MaxMemory = limit
+			} else {
+				log.Warn("Browser memory can only be measured on Linux, --max-memory is ignored")
+			}
+		}
+
+		for _, entry := range resolveEntries {
+
+			pin, err := chrm.ParseResolve(entry)
+			if err != nil {
+				log.WithField("error", err).Fatal("Invalid --resolve entry provided")
+			}
+
+			chrome.Resolve = append(chrome.Resolve, pin)
+		}
+
+		// Firefox shares the screenshot settings with Chrome, but
+		// does not need a Chrome installation
+		var engine chrm.Engine = &chrome
+		switch engineName {
+
+		case "chrome":
+			chrome.Setup()
+
+		case "firefox":
+			gecko := &firefox.Firefox{Chrome: &chrome, GeckodriverPath: geckoPath, FirefoxPath: firefoxPath}
+			gecko.Setup()
+			engine = gecko
+		}
+
+		// Prepare the options used when processing URLs
+		resolver := utils.NewResolver(dnsConcurrency)
+		resolver.Pin(chrome.Resolve)
+		if len(chrome.Resolve) > 0 && engineName == "firefox" {
+			log.Warn("Firefox can not pin addresses with --resolve, only the pre-flight requests will")
+		}
+		if chrome.Throttle != "" && engineName == "firefox" {
+			log.Warn("Firefox can not emulate network conditions, --throttle is ignored")
+		}
+		if (touch || mobile || scaleFactor > 0) && engineName == "firefox" {
+			log.Warn("Firefox can not emulate devices, --touch, --mobile and --scale-factor are ignored")
+		}
+		if tiles > 0 && engineName == "firefox" {
+			log.Warn("Firefox can not capture tiles, --tiles is ignored")
+		}
+		if challengeWait > 0 && engineName == "firefox" {
+			log.Warn("Firefox can not wait for challenge pages, --challenge-wait is ignored")
+		}
+		if saveAXTree && engineName == "firefox" {
+			log.Warn("Firefox can not read the accessibility tree, --save-ax-tree is ignored")
+		}
+		if gpuMode != chrm.GPUOff && engineName == "firefox" {
+			log.Warn("Firefox picks its own rendering, --gpu is ignored")
+		}
+		if chrome.Accept != "" && engineName == "firefox" {
+			log.Warn("Firefox can not set the Accept header, only the pre-flight requests will send --accept")
+		}
+		options = utils.Options{
+			Timeout:             waitTimeout,
+			DowngradeOnTLSError: downgradeOnTLSError,
+			MaxRedirects:        maxRedirects,
+			FollowMetaRefresh:   followMetaRefresh,
+			Resolver:            resolver,
+			Transport: utils.NewTransport(resolver, utils.TransportTuning{
+				MaxIdleConns:        maxIdleConns,
+				MaxIdleConnsPerHost: maxIdleConnsPerHost,
+				IdleConnTimeout:     time.Duration(idleConnTimeout) * time.Second,
+				HTTP10:              http10,
+				ConnectionClose:     connectionClose,
+			}),
+			HTTP10:             http10,
+			ScreenshotStatuses: screenshotStatuses,
+			Engine:             engine,
+			SaveRequest:        saveRequest,
+			SaveBody:           saveBody,
+			RawHeaders:         rawHeaders,
+			CheckMethods:       checkMethods,
+			GrabBanner:         grabBanner,
+			TLSScan:            tlsScan,
+			HTTP2Push:          http2Push,
+			FetchFavicon:       fetchFavicon,
+			HeroHeight:         heroHeight,
+			BlurRadius:         blurRadius,
+			ScreenshotFormat:   screenshotFormat,
+			JPEGQuality:        jpegQuality,
+			JPEGSubsampling:    jpegSubsampling,
+			PNGCompression:     pngCompression,
+			TextFallback:       textFallback,
+			SaveLinks:          saveLinks || crawlDepth > 0,
+			CrossOriginLinks:   crossOriginLinks,
+		}
+
+		if crawlDepth > 0 {
+			options.Crawler = utils.NewCrawler(crawlDepth)
+		}
+
+		if perHostConcurrency > 0 {
+			options.HostLimiter = utils.NewHostLimiter(perHostConcurrency)
+		}
+
+		if textFallbackSize != "" {
+
+			limit, err := utils.ParseSize(textFallbackSize)
+			if err != nil || limit == 0 {
+				log.WithFields(log.Fields{"text-fallback-size": textFallbackSize, "error": err}).Fatal("Invalid text fallback size provided")
+			}
+
+			options.TextFallbackSize = limit
+		}
+
+		if len(appendQuery) > 0 {
+
+			params, err := utils.ParseQueryParams(appendQuery)
+			if err != nil {
+				log.WithFields(log.Fields{"append-query": appendQuery, "err": err}).Fatal("Invalid query parameters provided")
+			}
+
+			options.AppendQuery = params
+		}
+
+		if verboseCapture {
+			options.CaptureLogs = utils.NewCaptureLogs()
+		}
+
+		if maxDisk != "" {
+
+			limit, err := utils.ParseSize(maxDisk)
+			if err != nil || limit <= 0 {
+				log.WithFields(log.Fields{"max-disk": maxDisk, "error": err}).Fatal("Invalid disk limit provided")
+			}
+
+			options.Disk = utils.NewDiskBudget(limit)
+		}
+
+		if changedOnly {
+			options.Changes = utils.NewChangeTracker()
+		}
+
+		if checkpointFile != "" {
+			options.Checkpoint = utils.NewCheckpoint(checkpointFile, time.Duration(checkpointInterval)*time.Second)
+		}
+
+		schemes, err := utils.NewSchemeChooser(preferScheme)
+		if err != nil {
+			log.WithField("err", err).Fatal("Invalid scheme preference provided")
+		}
+		options.Schemes = schemes
+
+		if ntlmUser != "" {
+
+			if ntlmPassword == "" {
+				ntlmPassword = os.Getenv("GOWITNESS_NTLM_PASSWORD")
+				chrome.AuthPassword = ntlmPassword
+			}
+
+			options.NTLM = utils.ParseNTLMUser(ntlmUser, ntlmPassword)
+			if engineName == "firefox" {
+				log.Warn("Firefox can not authenticate with NTLM, only the pre-flight requests will")
+			}
+		}
+
+		if matchBody != "" {
+
+			pattern, err := regexp.Compile(matchBody)
+			if err != nil {
+				log.WithFields(log.Fields{"match-body": matchBody, "error": err}).Fatal("Invalid body match provided")
+			}
+
+			options.MatchBody = pattern
+		}
+
+		if loginPattern != "" {
+
+			pattern, err := regexp.Compile(loginPattern)
+			if err != nil {
+				log.WithFields(log.Fields{"login-pattern": loginPattern, "error": err}).Fatal("Invalid login pattern provided")
+			}
+
+			options.LoginPattern = pattern
+		}
+
+		if otlpEndpoint != "" {
+			options.Tracer = utils.NewTracer(otlpEndpoint, os.Getenv("TRACEPARENT"))
+		}
+
+		if s3Bucket != "" {
+
+			store, err := utils.NewArtifactStore(s3Endpoint, s3Bucket, s3Region, s3Prefix)
+			if err != nil {
+				log.WithField("err", err).Fatal("Invalid artifact store options provided")
+			}
+
+			options.Artifacts = store
+		}
+
+		// A single output directory holds the database, screenshots
+		// and reports of a scan
+		if outputDir != "" {
+			setupWorkspace(cmd)
 		}
-		chrome.Setup()
 
 		// Setup the destination directory
 		if err := chrome.SetScreenshotPath(screenshotDestination); err != nil {
@@ -84,11 +541,78 @@ var RootCmd = &cobra.Command{
 		}
 
 		// open the database
-		db = storage.Storage{}
+		db = storage.Storage{History: keepHistory}
 		db.Open(dbLocation)
+
+		if publishBroker != "" {
+
+			var err error
+			publisher, err = utils.NewPublisher(publishBroker, publishAddress, publishTopic)
+			if err != nil {
+				log.WithField("err", err).Fatal("Invalid publishing options provided")
+			}
+
+			db.Publish = publisher.Publish
+		}
+
+		if len(emailTo) > 0 {
+
+			if smtpPassword == "" {
+				smtpPassword = os.Getenv("GOWITNESS_SMTP_PASSWORD")
+			}
+
+			var err error
+			mailer, err = utils.NewMailer(smtpServer, emailFrom, emailTo, smtpUser, smtpPassword)
+			if err != nil {
+				log.WithField("err", err).Fatal("Invalid summary email options provided")
+			}
+
+			// new hosts are those the database did not know of yet
+			entries, err := db.GetHTTPData()
+			if err != nil {
+				log.WithField("err", err).Fatal("Failed to read entries from the database")
+			}
+			summaryKnownHosts = entryHosts(entries)
+		}
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+
+		publisher.Close(10 * time.Second)
+		options.Checkpoint.Close()
+
+		if err := options.Tracer.Flush(); err != nil {
+			log.WithFields(log.Fields{"otlp-endpoint": otlpEndpoint, "err": err}).Warn("Failed to export spans")
+		}
+
+		if options.Disk != nil && options.Disk.Exceeded() {
+			log.WithFields(log.Fields{
+				"limit": options.Disk.Limit, "written": options.Disk.Written(), "skipped": options.Disk.Skipped(),
+			}).Warn("Scan stopped early after reaching the disk limit. Captured entries are intact")
+		}
+
+		if options.Changes != nil && options.Changes.Unchanged() > 0 {
+			log.WithField("unchanged", options.Changes.Unchanged()).Info("Unchanged URLs kept their previous capture")
+		}
+
+		if mailer != nil {
+			sendSummaryEmail()
+		}
 	},
 }
 
+// engineFor returns the configured screenshot engine, using the
+// settings of c instead of the global ones
+func engineFor(c *chrm.Chrome) chrm.Engine {
+
+	if gecko, ok := options.Engine.(*firefox.Firefox); ok {
+		copied := *gecko
+		copied.Chrome = c
+		return &copied
+	}
+
+	return c
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
@@ -98,22 +622,157 @@ func Execute() {
 	}
 }
 
+// checkSandbox runs Chrome without its sandbox where the sandbox can
+// not start, which is the case as root and in containers. Chrome exits
+// straight away otherwise. It is the PreRun of the commands that take
+// screenshots, as the others never start Chrome, and does nothing for
+// a --dry-run.
+func checkSandbox(cmd *cobra.Command, args []string) {
+
+	if engineName != "chrome" || chrome.NoSandbox || dryRun {
+		return
+	}
+
+	if reason := chrm.SandboxUnavailable(); reason != "" {
+		log.WithField("reason", reason).Warn("Chrome's sandbox does not work here, running Chrome with --no-sandbox")
+		chrome.NoSandbox = true
+	}
+}
+
 func init() {
 	// cobra.OnInitialize(initConfig)
 
 	// logging
 	RootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "one of debug, info, warn, error, or fatal")
 	RootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "text", "specify output (text or json)")
+	RootCmd.PersistentFlags().BoolVarP(&verboseCapture, "verbose-capture", "", false, "Write a debug log of every capture next to its screenshot, linked from the report, without debug logging on the console")
 
 	// Global flags
 	RootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.gowitness.yaml)")
 	RootCmd.PersistentFlags().IntVarP(&waitTimeout, "timeout", "T", 3, "Time in seconds to wait for a HTTP connection")
 	RootCmd.PersistentFlags().IntVarP(&chromeTimeout, "chrome-timeout", "", 90, "Time in seconds to wait for Google Chrome to finish a screenshot")
 	RootCmd.PersistentFlags().StringVarP(&chromePath, "chrome-path", "", "", "Full path to the Chrome executable to use. By default, gowitness will search for Google Chrome")
+	RootCmd.PersistentFlags().BoolVarP(&noSandbox, "no-sandbox", "", false, "Run Chrome without its sandbox. This is done anyway when running as root or in a container, where the sandbox does not work")
+	RootCmd.PersistentFlags().StringVarP(&engineName, "engine", "", "chrome", "The browser engine to take screenshots with (chrome or firefox)")
+	RootCmd.PersistentFlags().StringVarP(&geckoPath, "geckodriver-path", "", "", "Full path to the geckodriver executable to use with --engine firefox. By default, gowitness will search the PATH")
+	RootCmd.PersistentFlags().StringVarP(&firefoxPath, "firefox-path", "", "", "Full path to the Firefox executable to use with --engine firefox")
 	RootCmd.PersistentFlags().StringVarP(&userAgent, "user-agent", "", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/60.0.3112.50 Safari/537.36", "Alernate UserAgent string to use for Google Chrome")
 	RootCmd.PersistentFlags().StringVarP(&resolution, "resolution", "R", "1440,900", "screenshot resolution")
+	RootCmd.PersistentFlags().BoolVarP(&viewportOnly, "viewport-only", "", false, "Clip every screenshot to a fixed height, regardless of the page length")
+	RootCmd.PersistentFlags().IntVarP(&captureHeight, "capture-height", "", 0, "Height in pixels to clip screenshots to with --viewport-only (default is the resolution height)")
+	RootCmd.PersistentFlags().IntVarP(&tiles, "tiles", "", 0, "Capture pages as up to this many viewport height tiles down the page, shown in sequence in the report, instead of a single full page screenshot")
+	RootCmd.PersistentFlags().StringVarP(&screenshotFormat, "screenshot-format", "", "png", "The image format to save screenshots in (png or jpeg)")
+	RootCmd.PersistentFlags().IntVarP(&heroHeight, "hero-height", "", 0, "Also store the top this many pixels of each screenshot (eg: 600), shown in report cards instead of the full page")
+	RootCmd.PersistentFlags().IntVarP(&blurRadius, "blur", "", 0, "Also store a copy of each screenshot blurred with this radius in pixels (eg: 8), to share without exposing what pages show")
+	RootCmd.PersistentFlags().IntVarP(&jpegQuality, "jpeg-quality", "", 90, "The quality (1-100) of jpeg screenshots")
+	RootCmd.PersistentFlags().StringVarP(&jpegSubsampling, "jpeg-subsampling", "", utils.Subsampling444, "Chroma subsampling of jpeg screenshots. 444 keeps small text crisp, 420 gives smaller files")
+	RootCmd.PersistentFlags().IntVarP(&pngCompression, "png-compression", "", utils.DefaultPNGCompression, "The zlib compression level (0-9) of png screenshots. Higher levels give smaller files for more CPU time, 9 taking several times as long as 6 for a few percent. Screenshots are only re-encoded at levels other than 6")
+	RootCmd.PersistentFlags().IntVarP(&scrollRequests, "scroll-requests", "", 0, "Scroll the page until this many additional network requests have been made before taking a screenshot")
+	RootCmd.PersistentFlags().IntVarP(&maxScrolls, "max-scrolls", "", 10, "Maximum number of times to scroll with --scroll-requests")
+	RootCmd.PersistentFlags().BoolVarP(&saveDOMText, "save-dom-text", "", false, "Save the visible text of every page for offline searching")
+	RootCmd.PersistentFlags().BoolVarP(&saveAXTree, "save-ax-tree", "", false, "Save the accessibility tree of every page, counting the unlabeled buttons, images, links and form fields")
+	RootCmd.PersistentFlags().BoolVarP(&reducedMotion, "reduced-motion", "", false, "Disable animations and transitions, and emulate prefers-reduced-motion for stable screenshots")
+	RootCmd.PersistentFlags().BoolVarP(&touch, "touch", "", false, "Emulate a touch screen, to capture touch gated UI. Combines with any --resolution")
+	RootCmd.PersistentFlags().BoolVarP(&mobile, "mobile", "", false, "Emulate a mobile device, for pages that check for one. Combines with any --resolution")
+	RootCmd.PersistentFlags().Float64VarP(&scaleFactor, "scale-factor", "", 0, "The device pixel ratio to capture at, eg: 2 for high DPI screenshots, without changing --resolution (default is Chrome's 1)")
+	RootCmd.PersistentFlags().StringVarP(&gpuMode, "gpu", "", chrm.GPUOff, "How Chrome renders pages: off (no WebGL), swiftshader (software WebGL, for most canvas and WebGL dashboards) or hardware (the host's GPU, for apps too heavy for swiftshader)")
+	RootCmd.PersistentFlags().StringVarP(&throttle, "throttle", "", "", "Emulate a slow network while capturing, using a preset (slow-3g or fast-3g). Consider raising --chrome-timeout")
+	RootCmd.PersistentFlags().StringVarP(&accept, "accept", "", "", "Accept header to send, choosing the representation content negotiating pages serve, eg: text/html")
+	RootCmd.PersistentFlags().StringVarP(&background, "background", "", "", "Background color (#rrggbb or #rrggbbaa) to render transparent pages against")
 	RootCmd.PersistentFlags().StringVarP(&screenshotDestination, "destination", "d", ".", "Destination directory for screenshots")
 	RootCmd.PersistentFlags().StringVarP(&dbLocation, "db", "D", "gowitness.db", "Destination for the gowitness database")
+	RootCmd.PersistentFlags().BoolVarP(&keepHistory, "history", "", false, "Keep every capture of a URL across runs, rather than only the latest")
+	RootCmd.PersistentFlags().BoolVarP(&sampleHosts, "one-per-host", "", false, "Capture a single URL per hostname, for a quick breadth first view of an estate")
+	RootCmd.PersistentFlags().StringVarP(&sampleHostsKeep, "one-per-host-keep", "", hostKeepRoot, "The URL kept of each host with --one-per-host: root (its / URL when listed, otherwise the first) or first")
+	RootCmd.PersistentFlags().BoolVarP(&allowDuplicates, "allow-duplicates", "", false, "Capture repeated input URLs every time they appear, rather than only once")
+	RootCmd.PersistentFlags().StringSliceVarP(&capturePaths, "paths", "", []string{}, "A path to also capture against every input URL, eg: /admin (Can specify more than one --paths)")
+	RootCmd.PersistentFlags().StringVarP(&capturePathsFile, "paths-file", "", "", "A file of paths to also capture against every input URL")
+	RootCmd.PersistentFlags().StringVarP(&otlpEndpoint, "otlp-endpoint", "", os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"), "OTLP/HTTP endpoint to export a trace span per capture to, eg: http://localhost:4318. A TRACEPARENT environment variable is continued")
+	RootCmd.PersistentFlags().StringVarP(&checkpointFile, "checkpoint-file", "", "", "Write the progress of the scan (completed, total, failed, rate and ETA) to this JSON file, for scripts to poll")
+	RootCmd.PersistentFlags().IntVarP(&checkpointInterval, "checkpoint-interval", "", 5, "Seconds between writes of the --checkpoint-file")
+	RootCmd.PersistentFlags().BoolVarP(&changedOnly, "changed-only", "", false, "When rescanning into an existing database, only capture URLs whose response changed since their last capture")
+	RootCmd.PersistentFlags().BoolVarP(&textFallback, "text-fallback", "", false, "Keep the text of pages whose capture times out instead of a blank failure")
+	RootCmd.PersistentFlags().StringVarP(&textFallbackSize, "text-fallback-size", "", "", "With --text-fallback, keep only the text of pages whose body is larger than this (eg: 5MB) without trying to render them")
+	RootCmd.PersistentFlags().BoolVarP(&saveLinks, "save-links", "", false, "Keep the hyperlinks of every page that lead to its own origin")
+	RootCmd.PersistentFlags().BoolVarP(&crossOriginLinks, "cross-origin-links", "", false, "Also keep the hyperlinks that lead to other origins")
+	RootCmd.PersistentFlags().IntVarP(&crawlDepth, "crawl-depth", "", 0, "Capture the links of every page that lead to its own origin too, following them this many links deep (implies --save-links)")
+	RootCmd.PersistentFlags().StringVarP(&maxMemory, "max-memory", "", "", "Hold captures back while the running browsers use this much memory (eg: 2GB). Only supported on Linux")
+	RootCmd.PersistentFlags().StringVarP(&s3Bucket, "s3-bucket", "", "", "Store screenshots in this S3 compatible bucket instead of on disk. Credentials are read from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	RootCmd.PersistentFlags().StringVarP(&s3Endpoint, "s3-endpoint", "", "", "Endpoint of the bucket, eg: http://minio:9000 (default is the AWS endpoint of --s3-region)")
+	RootCmd.PersistentFlags().StringVarP(&s3Region, "s3-region", "", "us-east-1", "Region of the bucket")
+	RootCmd.PersistentFlags().StringVarP(&s3Prefix, "s3-prefix", "", "", "Prefix of the object keys screenshots are stored under, eg: scans/acme/")
+	RootCmd.PersistentFlags().StringVarP(&maxDisk, "max-disk", "", "", "Stop capturing once screenshots use this much disk space (eg: 500MB, 10GB)")
+	RootCmd.PersistentFlags().StringVarP(&outputDir, "output-dir", "", "", "A workspace directory holding the database, screenshots/ and reports/ of a scan")
+	RootCmd.PersistentFlags().BoolVarP(&dismissDialogs, "dismiss-dialogs", "", false, "Attempt to dismiss cookie-consent dialogs before taking a screenshot")
+	RootCmd.PersistentFlags().StringSliceVarP(&dismissSelectors, "dismiss-selector", "", []string{}, "Additional CSS selector to click when dismissing dialogs (Can specify more than one --dismiss-selector)")
+	RootCmd.PersistentFlags().BoolVarP(&beforeDismiss, "capture-before-dismiss", "", false, "Also keep a screenshot of the page before a dialog was dismissed (requires --dismiss-dialogs)")
+	RootCmd.PersistentFlags().StringSliceVarP(&clickSelectors, "click-selector", "", []string{}, "CSS selector of an element to click once the page has loaded, eg: a splash page's enter link (Can specify more than one --click-selector, clicked in order)")
+	RootCmd.PersistentFlags().StringVarP(&waitSelector, "wait-for-selector", "", "", "CSS selector of an element to wait for before taking a screenshot, checked after any --click-selector")
+	RootCmd.PersistentFlags().IntVarP(&waitForTimeout, "wait-for-timeout", "", 10, "Time in seconds to wait for --wait-for-selector before capturing anyway")
+	RootCmd.PersistentFlags().IntVarP(&challengeWait, "challenge-wait", "", 0, "Time in seconds to wait for JavaScript challenge pages, such as Cloudflare's browser check, to resolve before capturing. Challenges are only recorded when 0")
+	RootCmd.PersistentFlags().IntVarP(&challengeReloads, "challenge-reloads", "", 0, "How many times to reload a page whose challenge did not resolve within --challenge-wait, waiting again each time")
+	RootCmd.PersistentFlags().IntVarP(&crashRetries, "crash-retries", "", 1, "How many times to retry a capture in a new Chrome process when the one running it crashes. Every capture runs in its own Chrome process, so a crash only fails the capture it was running")
+	RootCmd.PersistentFlags().StringArrayVarP(&appendQuery, "append-query", "", []string{}, "A key=value query parameter to add to every URL captured, eg: debug=1 or a cache buster (Can specify more than one --append-query)")
+	RootCmd.PersistentFlags().StringArrayVarP(&localStorage, "local-storage", "", []string{}, "A key=value pair to set in localStorage before the page loads (Can specify more than one --local-storage)")
+	RootCmd.PersistentFlags().StringArrayVarP(&sessionStorage, "session-storage", "", []string{}, "A key=value pair to set in sessionStorage before the page loads (Can specify more than one --session-storage)")
+	RootCmd.PersistentFlags().IntVarP(&maxRedirects, "max-redirects", "", utils.DefaultMaxRedirects, "The most redirects to follow before recording a URL as a redirect loop")
+	RootCmd.PersistentFlags().BoolVarP(&followMetaRefresh, "follow-meta-refresh", "", false, "Follow pages that redirect with a <meta http-equiv=\"refresh\"> tag, capturing the page they redirect to. Refreshes waiting longer than 10 seconds are not followed")
+	RootCmd.PersistentFlags().BoolVarP(&downgradeOnTLSError, "downgrade-on-tls-error", "", false, "Retry https targets over http when the TLS handshake fails")
+	RootCmd.PersistentFlags().IntSliceVarP(&screenshotStatuses, "screenshot-statuses", "", []int{}, "Only screenshot responses with these status codes, eg: 200,401. Responses with other codes are still recorded without a screenshot")
+	RootCmd.PersistentFlags().BoolVarP(&http10, "http10", "", false, "Send the pre-flight requests as HTTP/1.0, to probe legacy and embedded servers (NTLM authentication still uses HTTP/1.1)")
+	RootCmd.PersistentFlags().StringVarP(&preferScheme, "prefer-scheme", "", "https", "The scheme tried first for hosts listed without one (https, http or random). The scheme a host answered on is tried first for its other paths.")
+	RootCmd.PersistentFlags().BoolVarP(&saveRequest, "save-request", "", false, "Record the request sent for each URL (with secrets redacted) and show it in the report")
+	RootCmd.PersistentFlags().BoolVarP(&saveBody, "save-body", "", false, "Save the response body of every URL (up to 1MB), for retitle --pattern to match")
+	RootCmd.PersistentFlags().StringVarP(&ntlmUser, "ntlm-user", "", "", "Authenticate to sites asking for NTLM or Negotiate as this DOMAIN\\user")
+	RootCmd.PersistentFlags().StringVarP(&ntlmPassword, "ntlm-password", "", "", "The password for --ntlm-user. Defaults to the GOWITNESS_NTLM_PASSWORD environment variable")
+	RootCmd.PersistentFlags().StringVarP(&matchBody, "match-body", "", "", "Only capture pages with a body matching this regular expression, eg: (?i)index of /")
+	RootCmd.PersistentFlags().StringVarP(&loginPattern, "login-pattern", "", utils.DefaultLoginPattern, "Flag entries redirected to a path matching this regular expression as bounced to a login page. Set to an empty string to disable")
+	RootCmd.PersistentFlags().BoolVarP(&tlsScan, "tls-scan", "", false, "Record the TLS versions (1.0 to 1.3) https targets accept and the cipher suite negotiated with each (makes a handshake per version)")
+	RootCmd.PersistentFlags().BoolVarP(&http2Push, "http2-push", "", false, "Record the resources https targets push with HTTP/2 server push (makes an extra request per URL)")
+	RootCmd.PersistentFlags().BoolVarP(&fetchFavicon, "fetch-favicon", "", false, "Fetch and hash the favicon of every page, to spot default installs and group by favicon (makes an extra request per URL)")
+	RootCmd.PersistentFlags().StringSliceVarP(&emailTo, "email-to", "", []string{}, "Email a summary of the scan to this address once it completes (Can specify more than one --email-to)")
+	RootCmd.PersistentFlags().StringVarP(&emailFrom, "email-from", "", "gowitness@localhost", "The sender of the summary email")
+	RootCmd.PersistentFlags().StringVarP(&smtpServer, "smtp-server", "", "localhost:25", "The SMTP server to send the summary email through, as host:port")
+	RootCmd.PersistentFlags().StringVarP(&smtpUser, "smtp-user", "", "", "The username to log in to the SMTP server with, if it needs one")
+	RootCmd.PersistentFlags().StringVarP(&smtpPassword, "smtp-password", "", "", "The password for --smtp-user. Defaults to the GOWITNESS_SMTP_PASSWORD environment variable")
+	RootCmd.PersistentFlags().StringVarP(&summaryReportURL, "report-url", "", "", "The URL the report will be published at, linked from the summary email")
+	RootCmd.PersistentFlags().StringVarP(&publishBroker, "publish-broker", "", "", "Publish every capture as a JSON message to a broker, nats or kafka (through a Kafka REST proxy)")
+	RootCmd.PersistentFlags().StringVarP(&publishAddress, "publish-address", "", "", "The address of the --publish-broker, host:port for nats or the REST proxy URL for kafka (eg: http://localhost:8082)")
+	RootCmd.PersistentFlags().StringVarP(&publishTopic, "publish-topic", "", "gowitness", "The NATS subject or Kafka topic to publish captures to")
+	RootCmd.PersistentFlags().BoolVarP(&checkMethods, "check-methods", "", false, "Record the methods every URL allows from the Allow header of an OPTIONS request, flagging PUT, DELETE and TRACE (makes an extra request per URL)")
+	RootCmd.PersistentFlags().BoolVarP(&grabBanner, "grab-banner", "", false, "When a port is open but does not answer HTTP, record the banner the service sends or the certificate it presents (reported as not-http)")
+	RootCmd.PersistentFlags().BoolVarP(&rawHeaders, "raw-headers", "", false, "Record response headers verbatim, in the order and case they were sent (makes an extra request per URL)")
+	RootCmd.PersistentFlags().StringSliceVarP(&resolveEntries, "resolve", "", []string{}, "Connect to an address instead of resolving a host, as host:port:address (eg: vhost.example.com:443:10.0.0.5). The hostname is still used for SNI and the Host header. Host and port may be *. Can specify more than one --resolve")
+	RootCmd.PersistentFlags().IntVarP(&dnsConcurrency, "dns-concurrency", "", 10, "Maximum concurrent DNS lookups, independent of --threads")
+	RootCmd.PersistentFlags().IntVarP(&perHostConcurrency, "per-host-concurrency", "", 0, "Maximum concurrent captures of any one hostname, whatever the --threads (0 for no limit)")
+	RootCmd.PersistentFlags().IntVarP(&maxIdleConns, "max-idle-conns", "", 1000, "Maximum idle connections kept open for reuse by pre-flight requests")
+	RootCmd.PersistentFlags().IntVarP(&maxIdleConnsPerHost, "max-idle-conns-per-host", "", 4, "Maximum idle connections kept open per host by pre-flight requests")
+	RootCmd.PersistentFlags().IntVarP(&idleConnTimeout, "idle-conn-timeout", "", 30, "Seconds an idle pre-flight connection is kept open for reuse")
+	RootCmd.PersistentFlags().BoolVarP(&connectionClose, "connection-close", "", false, "Send the pre-flight requests with Connection: close, each over a new connection, for servers that mishandle keep-alive (slower)")
+}
+
+// setupWorkspace prepares the --output-dir layout, pointing the
+// database and screenshot destination into it unless they were
+// explicitly set
+func setupWorkspace(cmd *cobra.Command) {
+
+	for _, dir := range []string{workspaceScreenshots, workspaceReports} {
+
+		if err := os.MkdirAll(filepath.Join(outputDir, dir), 0750); err != nil {
+			log.WithFields(log.Fields{"output-dir": outputDir, "error": err}).Fatal("Failed to create output directory")
+		}
+	}
+
+	if !cmd.Flags().Changed("db") {
+		dbLocation = filepath.Join(outputDir, workspaceDb)
+	}
+
+	if !cmd.Flags().Changed("destination") {
+		screenshotDestination = filepath.Join(outputDir, workspaceScreenshots)
+	}
+
+	log.WithFields(log.Fields{"output-dir": outputDir, "db": dbLocation, "destination": screenshotDestination}).
+		Debug("Using output directory")
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -191,6 +850,23 @@ func initLogging() {
 	}
 }
 
+// validResolution checks that value is a x,y resolution
+func validResolution(value string) bool {
+
+	parsed := strings.Split(value, ",")
+	if len(parsed) != 2 {
+		return false
+	}
+
+	for _, dimension := range parsed {
+		if _, err := strconv.Atoi(dimension); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Checks if some of the globally provided arguments are valid.
 func validateFlags() {
 
@@ -209,4 +885,145 @@ func validateFlags() {
 		log.WithField("resolution", resolution).Fatal("Failed to parse resolution y value")
 	}
 
+	if engineName != "chrome" && engineName != "firefox" {
+		log.WithField("engine", engineName).Fatal("Invalid engine provided. Use chrome or firefox")
+	}
+
+	if tiles < 0 {
+		log.WithField("tiles", tiles).Fatal("Invalid tiles provided")
+	}
+	if tiles > 0 && viewportOnly {
+		log.Fatal("--tiles and --viewport-only can not be used together")
+	}
+
+	if captureHeight < 0 {
+		log.WithField("capture-height", captureHeight).Fatal("Invalid capture height provided")
+	}
+
+	if screenshotFormat != "png" && screenshotFormat != "jpeg" {
+		log.WithField("screenshot-format", screenshotFormat).Fatal("Invalid screenshot format provided. Use png or jpeg")
+	}
+
+	if maxIdleConns < 0 || maxIdleConnsPerHost < 0 || idleConnTimeout < 0 {
+		log.WithFields(log.Fields{
+			"max-idle-conns": maxIdleConns, "max-idle-conns-per-host": maxIdleConnsPerHost, "idle-conn-timeout": idleConnTimeout,
+		}).Fatal("Invalid connection pool settings provided")
+	}
+
+	if background != "" {
+		if _, err := chrm.ParseColor(background); err != nil {
+			log.WithFields(log.Fields{"background": background, "err": err}).Fatal("Invalid background color provided")
+		}
+	}
+
+	if sampleHostsKeep != hostKeepRoot && sampleHostsKeep != hostKeepFirst {
+		log.WithField("one-per-host-keep", sampleHostsKeep).Fatal("Invalid URL to keep per host provided. Use root or first")
+	}
+
+	if scaleFactor < 0 || scaleFactor > 10 {
+		log.WithField("scale-factor", scaleFactor).Fatal("Invalid scale factor provided. Use a ratio from 0 to 10")
+	}
+
+	if _, err := chrm.GPUArguments(gpuMode); err != nil {
+		log.WithFields(log.Fields{"gpu": gpuMode, "err": err}).Fatal("Invalid rendering mode provided")
+	}
+
+	if throttle != "" {
+		if _, err := chrm.ParseThrottle(throttle); err != nil {
+			log.WithFields(log.Fields{"throttle": throttle, "err": err}).Fatal("Invalid throttle provided")
+		}
+	}
+
+	if jpegQuality < 1 || jpegQuality > 100 {
+		log.WithField("jpeg-quality", jpegQuality).Fatal("Invalid jpeg quality provided")
+	}
+
+	if pngCompression < 0 || pngCompression > 9 {
+		log.WithField("png-compression", pngCompression).Fatal("Invalid png compression provided")
+	}
+	if pngCompression != utils.DefaultPNGCompression && screenshotFormat != "png" {
+		log.Warn("--png-compression only applies to png screenshots")
+	}
+
+	if heroHeight < 0 {
+		log.WithField("hero-height", heroHeight).Fatal("Invalid hero height provided")
+	}
+
+	if blurRadius < 0 {
+		log.WithField("blur", blurRadius).Fatal("Invalid blur radius provided")
+	}
+
+	if jpegSubsampling != utils.Subsampling444 && jpegSubsampling != utils.Subsampling420 {
+		log.WithField("jpeg-subsampling", jpegSubsampling).Fatal("Invalid jpeg subsampling provided. Use 444 or 420")
+	}
+
+	if beforeDismiss && !dismissDialogs {
+		log.Fatal("--capture-before-dismiss requires --dismiss-dialogs")
+	}
+
+	if textFallbackSize != "" && !textFallback {
+		log.Fatal("--text-fallback-size requires --text-fallback")
+	}
+
+	for _, status := range screenshotStatuses {
+		if status < 100 || status > 599 {
+			log.WithField("screenshot-statuses", status).Fatal("Invalid screenshot status provided")
+		}
+	}
+
+	if perHostConcurrency < 0 {
+		log.WithField("per-host-concurrency", perHostConcurrency).Fatal("Invalid per-host concurrency provided")
+	}
+
+	if checkpointInterval < 1 {
+		log.WithField("checkpoint-interval", checkpointInterval).Fatal("Invalid checkpoint interval provided")
+	}
+
+	if crawlDepth < 0 {
+		log.WithField("crawl-depth", crawlDepth).Fatal("Invalid crawl depth provided")
+	}
+
+	if crossOriginLinks && !saveLinks && crawlDepth == 0 {
+		log.Fatal("--cross-origin-links requires --save-links or --crawl-depth")
+	}
+
+	if maxRedirects < 1 {
+		log.WithField("max-redirects", maxRedirects).Fatal("Invalid max redirects provided")
+	}
+
+	if waitForTimeout < 1 {
+		log.WithField("wait-for-timeout", waitForTimeout).Fatal("Invalid wait for timeout provided")
+	}
+	if challengeWait < 0 || challengeReloads < 0 {
+		log.WithFields(log.Fields{"challenge-wait": challengeWait, "challenge-reloads": challengeReloads}).Fatal("Invalid challenge wait provided")
+	}
+	if crashRetries < 0 {
+		log.WithField("crash-retries", crashRetries).Fatal("Invalid crash retries provided")
+	}
+	if challengeReloads > 0 && challengeWait == 0 {
+		log.Fatal("--challenge-reloads needs --challenge-wait")
+	}
+
+	if scrollRequests < 0 || maxScrolls < 1 {
+		log.WithFields(log.Fields{"scroll-requests": scrollRequests, "max-scrolls": maxScrolls}).
+			Fatal("Invalid scroll settings provided")
+	}
+
+}
+
+// parseKeyValues parses key=value flag values into a map
+func parseKeyValues(flag string, values []string) map[string]string {
+
+	parsed := make(map[string]string)
+	for _, value := range values {
+
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			log.WithFields(log.Fields{"flag": flag, "value": value}).Fatal("Invalid key=value pair provided")
+		}
+
+		parsed[parts[0]] = parts[1]
+	}
+
+	return parsed
 }
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"bufio"
+	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"text/template"
@@ -11,9 +13,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/RiskSense-Ops/gowitness/utils"
 	"github.com/reconquest/barely"
 	"github.com/remeh/sizedwaitgroup" // <3
-	"github.com/RiskSense-Ops/gowitness/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -45,7 +48,10 @@ $ gowitness scan --cidr 192.168.0.0/24 --cidr 10.10.0.0/24
 $ gowitness scan --threads 20 --ports 80,443,8080 --cidr 192.168.0.0/24
 $ gowitness scan --threads 20 --ports 80,443,8080 --cidr 192.168.0.1/32 --no-https
 $ gowitness --log-level debug scan --threads 20 --ports 80,443,8080 --no-http --cidr 192.168.0.0/30
+$ gowitness scan --cidr 10.0.0.0/16 --paths /admin --dry-run
+$ gowitness scan --threads 20 --cidr 192.168.0.0/24 --paths /admin --paths /login --per-host-concurrency 2
 `,
+	PreRun: checkSandbox,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		validateScanCmdFlags()
@@ -62,6 +68,11 @@ $ gowitness --log-level debug scan --threads 20 --ports 80,443,8080 --no-http --
 		cidrs := readCidrs()
 		log.WithField("cidr-count", len(cidrs)).Debug("Using CIDR ranges")
 
+		if dryRun {
+			scanDryRun(cidrs, ports)
+			return
+		}
+
 		// loop and parse the --cidr flags we got
 		for _, cidr := range cidrs {
 
@@ -86,6 +97,16 @@ $ gowitness --log-level debug scan --threads 20 --ports 80,443,8080 --no-http --
 
 		permutations, err := utils.Permutations(ips, ports, skipHTTP, skipHTTPS)
 
+		permutations = dedupePermutations(permutations)
+
+		// every permutation is the root of its host, so keeping one
+		// per host leaves no room for --paths either
+		paths := readPaths()
+		if sampleHosts {
+			permutations = samplePermutations(permutations)
+			paths = nil
+		}
+
 		if randomPermutations {
 			log.WithFields(log.Fields{"cidr-count": len(cidrs)}).Info("Randomizing permutations")
 			permutations = utils.ShufflePermutations(permutations)
@@ -98,6 +119,19 @@ $ gowitness --log-level debug scan --threads 20 --ports 80,443,8080 --no-http --
 		}
 		log.WithField("permutation-count", len(permutations)).Info("Total permutations to be processed")
 
+		if saveJobFile != "" {
+
+			var targets []fileTarget
+			for _, permutation := range permutations {
+				if u, err := url.ParseRequestURI(permutation); err == nil {
+					targets = append(targets, fileTarget{url: u})
+				}
+			}
+			saveJob(cmd, expandFileTargets(targets, paths))
+		}
+
+		recordSkips()
+
 		// Start processing the calculated permutations
 		log.WithField("thread-count", maxThreads).Debug("Maximum threads")
 		swg := sizedwaitgroup.New(maxThreads)
@@ -114,38 +148,67 @@ $ gowitness --log-level debug scan --threads 20 --ports 80,443,8080 --no-http --
 			Done    int64
 			Updated int64
 		}{
-			Total: len(permutations),
+			Total: len(permutations) * (len(paths) + 1),
 		}
 		bar.SetStatus(status)
 		bar.Render(os.Stdout)
+		options.Checkpoint.AddTotal(status.Total)
 
+		var targets []pathTarget
 		for _, permutation := range permutations {
 
 			u, err := url.ParseRequestURI(permutation)
 			if err != nil {
 
 				log.WithField("url", permutation).Warn("Skipping Invalid URL")
+				utils.RecordSkip(&db, permutation, storage.SkipInvalidURL)
 				continue
 			}
 
+			targets = append(targets, expandPaths(u, paths)...)
+		}
+
+		// the paths of a host follow each other, so they are spread out
+		// when --per-host-concurrency holds back a host
+		if options.HostLimiter != nil {
+			hosts := make([]string, len(targets))
+			for i, target := range targets {
+				hosts[i] = target.url.Hostname()
+			}
+
+			interleaved := make([]pathTarget, 0, len(targets))
+			for _, i := range utils.InterleaveHosts(hosts) {
+				interleaved = append(interleaved, targets[i])
+			}
+			targets = interleaved
+		}
+
+		for _, target := range targets {
+
+			release := options.HostLimiter.Acquire(target.url.Hostname())
 			swg.Add()
 
 			// Goroutine to run the URL processor
-			go func(url *url.URL) {
+			go func(target pathTarget) {
 
 				defer swg.Done()
+				defer release()
+
+				targetOptions := options
+				targetOptions.Path = target.path
 
-				utils.ProcessURL(url, &chrome, &db, waitTimeout)
+				utils.ProcessURL(target.url, &chrome, &db, &targetOptions)
 
 				// update the progress bar
 				atomic.AddInt64(&status.Done, 1)
 				atomic.AddInt64(&status.Updated, 1)
 				bar.Render(os.Stdout)
-			}(u)
+			}(target)
 		}
 
 		swg.Wait()
 		bar.Clear(os.Stdout)
+		crawlLinks()
 
 		log.WithFields(log.Fields{"run-time": time.Since(startTime), "permutation-count": len(permutations)}).
 			Info("Complete")
@@ -184,6 +247,48 @@ func readCidrs() []string {
 	return cidrs
 }
 
+// scanDryRun prints the breakdown of the URLs the scan resolves to,
+// without expanding the CIDRs
+func scanDryRun(cidrs []string, ports []int) {
+
+	var hosts int64
+	for _, cidr := range cidrs {
+
+		if !strings.Contains(cidr, "/") {
+			cidr = cidr + "/32"
+		}
+
+		count, err := cidrHostCount(cidr)
+		if err != nil {
+			log.WithFields(log.Fields{"cidr": cidr, "error": err}).Fatal("Failed to parse CIDR")
+		}
+		hosts += count
+	}
+
+	var portNames, schemes []string
+	for _, port := range ports {
+		portNames = append(portNames, strconv.Itoa(port))
+	}
+	if !skipHTTP {
+		schemes = append(schemes, "http")
+	}
+	if !skipHTTPS {
+		schemes = append(schemes, "https")
+	}
+
+	paths := readPaths()
+
+	plan := &scanPlan{}
+	plan.factor("hosts", hosts, "hosts", fmt.Sprintf("%d CIDRs", len(cidrs)))
+	plan.factor("ports", int64(len(ports)), "ports", strings.Join(portNames, ", "))
+	plan.factor("schemes", int64(len(schemes)), "schemes", strings.Join(schemes, ", "))
+	plan.factor("paths", int64(len(paths)+1), "paths", pathsDetail(paths))
+	if sampleHosts {
+		plan.sampled = hosts
+	}
+	plan.write(os.Stdout)
+}
+
 // Validates that the arguments received for scanCmd is valid.
 func validateScanCmdFlags() {
 
@@ -210,4 +315,6 @@ func init() {
 	scanCmd.Flags().StringVarP(&scanPorts, "ports", "p", "80,443,8080,8443", "Ports to scan")
 	scanCmd.Flags().IntVarP(&maxThreads, "threads", "t", 4, "Maximum concurrent threads to run")
 	scanCmd.Flags().BoolVarP(&randomPermutations, "random", "r", false, "Randomize generated permutations")
+	scanCmd.Flags().BoolVarP(&dryRun, "dry-run", "", false, "Print how many hosts, ports, schemes and paths the scan covers, and the URLs they multiply to, without capturing anything")
+	scanCmd.Flags().StringVarP(&saveJobFile, "save-job", "", "", "Save the resolved targets and flags to this job file, to run again with replay")
 }
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/utils"
+	"github.com/remeh/sizedwaitgroup"
+	"github.com/spf13/cobra"
+)
+
+// scope check results
+const (
+	scopeIn         = "in"
+	scopeOut        = "out"
+	scopePartial    = "partial"
+	scopeUnresolved = "unresolved"
+)
+
+// scopeCheckCmd represents the scope-check command
+var scopeCheckCmd = &cobra.Command{
+	Use:   "scope-check",
+	Short: "Check that the targets in a file are in scope, without capturing anything",
+	Long: `
+Resolve every target in a source file and report whether it falls within
+the CIDR ranges given with --scope-cidr. Nothing is captured.
+
+A target is in scope when all of the addresses it resolves to are in
+scope, and partially in scope when only some of them are. The command
+exits with a non-zero status if any target is not completely in scope,
+making it suitable to run before the real scan.
+
+The input accepts the same sources as the file command.
+
+For example:
+
+$ gowitness scope-check --input targets.txt --scope-cidr 10.0.0.0/8
+$ gowitness scope-check --input targets.txt --scope-cidr 192.168.1.0/24 --scope-cidr 203.0.113.10`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		scope, err := utils.ParseScope(scopeCIDRs)
+		if err != nil {
+			log.WithFields(log.Fields{"scope-cidr": scopeCIDRs, "err": err}).Fatal("Invalid scope provided")
+		}
+
+		source, err := openSource(scopeInput)
+		if err != nil {
+			log.WithFields(log.Fields{"error": err, "input": scopeInput}).Fatal("Unable to read input file")
+		}
+
+		targets := readFileTargets(scopeInput, source)
+		source.Close()
+
+		type scopeResult struct {
+			status    string
+			addresses []string
+		}
+
+		results := make([]scopeResult, len(targets))
+		swg := sizedwaitgroup.New(maxThreads)
+		for i, target := range targets {
+
+			swg.Add()
+			go func(i int, target fileTarget) {
+
+				defer swg.Done()
+
+				addresses, err := options.Resolver.Lookup(target.url.Hostname())
+				if err != nil {
+					log.WithFields(log.Fields{"url": target.url, "error": err}).Debug("Failed to resolve host")
+					results[i] = scopeResult{status: scopeUnresolved}
+					return
+				}
+
+				in := 0
+				for _, address := range addresses {
+					if scope.Contains(address) {
+						in++
+					}
+				}
+
+				result := scopeResult{status: scopePartial, addresses: addresses}
+				if in == len(addresses) {
+					result.status = scopeIn
+				} else if in == 0 {
+					result.status = scopeOut
+				}
+				results[i] = result
+
+			}(i, target)
+		}
+		swg.Wait()
+
+		counts := make(map[string]int)
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "SCOPE\tTARGET\tADDRESSES")
+		for i, target := range targets {
+			counts[results[i].status]++
+			fmt.Fprintf(writer, "%s\t%s\t%s\n", results[i].status, target.url, strings.Join(results[i].addresses, ", "))
+		}
+		writer.Flush()
+
+		summary := log.WithFields(log.Fields{
+			"targets": len(targets), "in-scope": counts[scopeIn], "out-of-scope": counts[scopeOut],
+			"partial": counts[scopePartial], "unresolved": counts[scopeUnresolved],
+		})
+
+		if counts[scopeIn] != len(targets) {
+			summary.Fatal("Not all targets are in scope")
+		}
+
+		summary.Info("All targets are in scope")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(scopeCheckCmd)
+
+	scopeCheckCmd.Flags().StringVarP(&scopeInput, "input", "i", "", "The source file of targets to check")
+	scopeCheckCmd.Flags().StringSliceVarP(&scopeCIDRs, "scope-cidr", "", []string{}, "A CIDR range that is in scope (Can specify more than one --scope-cidr)")
+	scopeCheckCmd.MarkFlagRequired("input")
+	scopeCheckCmd.MarkFlagRequired("scope-cidr")
+}
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// Source formats that are not recognised by their extension
+const (
+	sourceFormatShodan string = "shodan"
+	sourceFormatCensys string = "censys"
+)
+
+// shodanBanner is the part of a banner in a Shodan JSON
+// export that is needed to capture it
+type shodanBanner struct {
+	IP        string          `json:"ip_str"`
+	Port      int             `json:"port"`
+	Product   string          `json:"product"`
+	Org       string          `json:"org"`
+	Hostnames []string        `json:"hostnames"`
+	HTTP      json.RawMessage `json:"http"`
+	SSL       json.RawMessage `json:"ssl"`
+	Shodan    struct {
+		Module string `json:"module"`
+	} `json:"_shodan"`
+}
+
+// censysHost is the part of a host in a Censys JSON
+// export that is needed to capture it
+type censysHost struct {
+	IP       string `json:"ip"`
+	Services []struct {
+		Port                int             `json:"port"`
+		ServiceName         string          `json:"service_name"`
+		ExtendedServiceName string          `json:"extended_service_name"`
+		TLS                 json.RawMessage `json:"tls"`
+		Software            []struct {
+			Product string `json:"product"`
+		} `json:"software"`
+	} `json:"services"`
+	AutonomousSystem struct {
+		Name string `json:"name"`
+	} `json:"autonomous_system"`
+	DNS struct {
+		Names []string `json:"names"`
+	} `json:"dns"`
+}
+
+// sourceTarget returns the target for an http(s) service on ip:port
+func sourceTarget(secure bool, ip string, port int, info *storage.SourceInfo) (fileTarget, error) {
+
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
+
+	u, err := url.Parse(scheme + "://" + net.JoinHostPort(ip, strconv.Itoa(port)) + "/")
+	if err != nil {
+		return fileTarget{}, err
+	}
+
+	return fileTarget{url: u, source: info}, nil
+}
+
+// decodeSearchExport decodes every JSON object in an export, which may
+// be gzipped, and may either be a JSON array or one object per line
+func decodeSearchExport(source io.Reader, decoded func(raw json.RawMessage)) error {
+
+	reader := bufio.NewReader(source)
+	if magic, err := reader.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+
+		uncompressed, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer uncompressed.Close()
+
+		reader = bufio.NewReader(uncompressed)
+	}
+
+	decoder := json.NewDecoder(reader)
+	for {
+
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// an array holds the objects themselves
+		var objects []json.RawMessage
+		if strings.HasPrefix(strings.TrimSpace(string(raw)), "[") {
+			if err := json.Unmarshal(raw, &objects); err != nil {
+				return err
+			}
+		} else {
+			objects = []json.RawMessage{raw}
+		}
+
+		for _, object := range objects {
+			decoded(object)
+		}
+	}
+}
+
+// readShodanTargets reads the http(s) services from a Shodan JSON
+// export, as written by shodan download. Banners of other services
+// are skipped.
+func readShodanTargets(source io.Reader) []fileTarget {
+
+	var targets []fileTarget
+	err := decodeSearchExport(source, func(raw json.RawMessage) {
+
+		var banner shodanBanner
+		if err := json.Unmarshal(raw, &banner); err != nil {
+			log.WithField("err", err).Warn("Skipping invalid Shodan banner")
+			return
+		}
+
+		module := strings.ToLower(banner.Shodan.Module)
+		if banner.HTTP == nil && !strings.Contains(module, "http") {
+			log.WithFields(log.Fields{"ip": banner.IP, "port": banner.Port, "module": module}).Debug("Skipping non-http Shodan banner")
+			return
+		}
+
+		secure := banner.SSL != nil || strings.HasPrefix(module, "https")
+		info := &storage.SourceInfo{
+			Provider: sourceFormatShodan, Product: banner.Product, Org: banner.Org, Hostnames: banner.Hostnames,
+		}
+
+		if banner.IP == "" || banner.Port == 0 {
+			log.WithFields(log.Fields{"ip": banner.IP, "port": banner.Port}).Warn("Skipping invalid Shodan banner")
+			return
+		}
+
+		target, err := sourceTarget(secure, banner.IP, banner.Port, info)
+		if err != nil {
+			log.WithFields(log.Fields{"ip": banner.IP, "port": banner.Port, "err": err}).Warn("Skipping invalid Shodan banner")
+			return
+		}
+
+		targets = append(targets, target)
+	})
+
+	if err != nil {
+		log.WithField("err", err).Error("Failed to read the Shodan export, using the banners read so far")
+	}
+
+	return targets
+}
+
+// readCensysTargets reads the http(s) services of the hosts in a
+// Censys JSON export. Services of other protocols are skipped.
+func readCensysTargets(source io.Reader) []fileTarget {
+
+	var targets []fileTarget
+	err := decodeSearchExport(source, func(raw json.RawMessage) {
+
+		var host censysHost
+		if err := json.Unmarshal(raw, &host); err != nil || host.IP == "" {
+			log.WithField("err", err).Warn("Skipping invalid Censys host")
+			return
+		}
+
+		for _, service := range host.Services {
+
+			name := strings.ToUpper(service.ServiceName)
+			extended := strings.ToUpper(service.ExtendedServiceName)
+			if name != "HTTP" && extended != "HTTP" && extended != "HTTPS" {
+				continue
+			}
+
+			info := &storage.SourceInfo{
+				Provider: sourceFormatCensys, Org: host.AutonomousSystem.Name, Hostnames: host.DNS.Names,
+			}
+			if len(service.Software) > 0 {
+				info.Product = service.Software[0].Product
+			}
+
+			if service.Port == 0 {
+				log.WithField("ip", host.IP).Warn("Skipping Censys service without a port")
+				continue
+			}
+
+			target, err := sourceTarget(extended == "HTTPS" || service.TLS != nil, host.IP, service.Port, info)
+			if err != nil {
+				log.WithFields(log.Fields{"ip": host.IP, "port": service.Port, "err": err}).Warn("Skipping invalid Censys service")
+				continue
+			}
+
+			targets = append(targets, target)
+		}
+	})
+
+	if err != nil {
+		log.WithField("err", err).Error("Failed to read the Censys export, using the hosts read so far")
+	}
+
+	return targets
+}
+
+// validSourceFormat checks a --source-format value
+func validSourceFormat(format string) error {
+
+	switch format {
+	case "", sourceFormatShodan, sourceFormatCensys:
+		return nil
+	}
+
+	return fmt.Errorf("unknown source format %q, use shodan or censys", format)
+}
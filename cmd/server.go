@@ -0,0 +1,526 @@
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	gwtmpl "github.com/RiskSense-Ops/gowitness/template"
+	"github.com/RiskSense-Ops/gowitness/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/spf13/cobra"
+	"github.com/tidwall/buntdb"
+)
+
+// serverCmd represents the server command
+var serverCmd = &cobra.Command{
+	Use:   "server",
+	Short: "Serve a browsable, filterable view of a database file",
+	Long: `
+Start a web server to browse the entries found in a gowitness.db file.
+Entries can be filtered by response code, detected technology and page
+language, and the filtered view exported as JSON, CSV or a zip archive
+(optionally including the screenshots) from the /export endpoint.
+The screenshots alone, named after their URLs, are downloaded from
+/api/screenshots.zip, which takes the same filters.
+
+Entries can be triaged from the keyboard: j and k move between
+entries, t tags, n adds a note, x marks reviewed and / searches. The
+review state is stored in the database, and kept across rescans.
+
+For example:
+
+$ gowitness server
+$ gowitness server --address 0.0.0.0:8080
+$ curl 'http://localhost:7171/export?format=csv&status=200'
+$ curl -o jenkins.zip 'http://localhost:7171/export?format=zip&technology=Jenkins&screenshots=true'
+$ curl -o screenshots.zip 'http://localhost:7171/api/screenshots.zip?status=200'`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		tmpl, err := template.New("server-page").Parse(gwtmpl.ServerContent)
+		if err != nil {
+			log.WithField("err", err).Fatal("Failed to parse server template")
+		}
+
+		http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			serverIndex(w, r, tmpl)
+		})
+		http.HandleFunc("/export", serverExport)
+		http.HandleFunc("/api/screenshots.zip", serverScreenshotsZip)
+		http.HandleFunc("/screenshots/", serverScreenshot)
+		http.HandleFunc("/review/", serverReview)
+
+		log.WithField("address", serverAddress).Info("Starting server")
+		if err := http.ListenAndServe(serverAddress, nil); err != nil {
+			log.WithField("err", err).Fatal("Server failed")
+		}
+	},
+}
+
+// queryFilter builds an entry filter from the query string of a
+// request. Values may be repeated or comma separated.
+func queryFilter(query url.Values) entryFilter {
+
+	values := func(name string) []string {
+		var parsed []string
+		for _, value := range query[name] {
+			for _, part := range strings.Split(value, ",") {
+				if part = strings.TrimSpace(part); part != "" {
+					parsed = append(parsed, part)
+				}
+			}
+		}
+		return parsed
+	}
+
+	filter := entryFilter{Technology: values("technology"), Lang: values("lang")}
+	for _, status := range values("status") {
+		if code, err := strconv.Atoi(status); err == nil {
+			filter.Status = append(filter.Status, code)
+		}
+	}
+
+	return filter
+}
+
+// filteredEntries returns the entries matching the filters
+// in the query string of a request
+func filteredEntries(r *http.Request) ([]storage.HTTResponse, error) {
+
+	entries, err := db.GetHTTPData()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := queryFilter(r.URL.Query())
+
+	return filter.filter(entries), nil
+}
+
+// serverIndex renders the filtered entries
+func serverIndex(w http.ResponseWriter, r *http.Request, tmpl *template.Template) {
+
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	entries, err := filteredEntries(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type ServerEntry struct {
+		storage.HTTResponse
+		Key        string
+		Screenshot string
+	}
+
+	var view []ServerEntry
+	for _, entry := range entries {
+
+		screenshot := ""
+		if entry.ScreenshotFile != "" {
+			screenshot = "/screenshots/" + url.PathEscape(filepath.Base(entry.ScreenshotFile))
+		}
+		view = append(view, ServerEntry{HTTResponse: entry, Key: entry.Key(), Screenshot: screenshot})
+	}
+
+	query := r.URL.Query()
+	var page bytes.Buffer
+	if err := tmpl.Execute(&page, struct {
+		Entries    []ServerEntry
+		Status     string
+		Technology string
+		Lang       string
+		Query      string
+	}{
+		Entries:    view,
+		Status:     query.Get("status"),
+		Technology: query.Get("technology"),
+		Lang:       query.Get("lang"),
+		Query:      query.Encode(),
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	page.WriteTo(w)
+}
+
+// serverExport exports the filtered entries as json, csv or a zip
+// archive. Zip archives include the screenshots when requested.
+func serverExport(w http.ResponseWriter, r *http.Request) {
+
+	entries, err := filteredEntries(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	log.WithFields(log.Fields{"query": r.URL.RawQuery, "entries": len(entries)}).Info("Exporting entries")
+
+	switch format := r.URL.Query().Get("format"); format {
+
+	case "", "json":
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", `attachment; filename="gowitness.json"`)
+		writeEntriesJSON(w, entries)
+
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="gowitness.csv"`)
+		writeEntriesCSV(w, entries, "")
+
+	case "zip":
+		screenshots, _ := strconv.ParseBool(r.URL.Query().Get("screenshots"))
+
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="gowitness.zip"`)
+		if err := writeEntriesZip(w, entries, screenshots); err != nil {
+			log.WithField("err", err).Error("Failed to write zip export")
+		}
+
+	default:
+		http.Error(w, "invalid format, use json, csv or zip", http.StatusBadRequest)
+	}
+}
+
+// writeEntriesJSON writes entries as indented JSON
+func writeEntriesJSON(w io.Writer, entries []storage.HTTResponse) error {
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(entries)
+}
+
+// writeEntriesCSV writes a summary of entries as CSV. For a
+// spreadsheet, excel or sheets, the screenshot column links to the
+// screenshots and no other cell can be read as a formula.
+func writeEntriesCSV(w io.Writer, entries []storage.HTTResponse, spreadsheet string) error {
+
+	writer := csv.NewWriter(w)
+	writer.Write([]string{
+		"url", "final_url", "response_code", "title", "lang", "technologies", "dom_nodes", "transferred_bytes",
+		"error_kind", "error", "screenshot", "reviewed", "tags", "note",
+	})
+
+	for _, entry := range entries {
+
+		review := storage.Review{}
+		if entry.Review != nil {
+			review = *entry.Review
+		}
+
+		row := []string{
+			entry.URL, entry.FinalURL, strconv.Itoa(entry.ResponseCode), entry.PageTitle, entry.Lang,
+			strings.Join(entry.Technologies, ";"), strconv.Itoa(entry.DOMNodes), strconv.FormatInt(entry.TransferredBytes, 10),
+			entry.ErrorKind, entry.Error, filepath.Base(entry.ScreenshotFile),
+			strconv.FormatBool(review.Reviewed), strings.Join(review.Tags, ";"), review.Note,
+		}
+
+		if spreadsheet != "" {
+			for i := range row {
+				row[i] = spreadsheetText(row[i])
+			}
+			row[10] = spreadsheetFormula(spreadsheet, entry.ScreenshotFile)
+		}
+
+		writer.Write(row)
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeEntriesZip writes a zip archive with the entries as JSON and
+// CSV, along with their screenshots if requested
+func writeEntriesZip(w io.Writer, entries []storage.HTTResponse, screenshots bool) error {
+
+	archive := zip.NewWriter(w)
+
+	file, err := archive.Create("gowitness.json")
+	if err != nil {
+		return err
+	}
+	if err := writeEntriesJSON(file, entries); err != nil {
+		return err
+	}
+
+	file, err = archive.Create("gowitness.csv")
+	if err != nil {
+		return err
+	}
+	if err := writeEntriesCSV(file, entries, ""); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+
+		if !screenshots || entry.ScreenshotFile == "" {
+			continue
+		}
+
+		screenshot, err := os.Open(resolveScreenshot(entry.ScreenshotFile))
+		if err != nil {
+			log.WithFields(log.Fields{"screenshot-file": entry.ScreenshotFile, "err": err}).Debug("Skipping missing screenshot")
+			continue
+		}
+
+		file, err := archive.Create("screenshots/" + filepath.Base(entry.ScreenshotFile))
+		if err == nil {
+			_, err = io.Copy(file, screenshot)
+		}
+		screenshot.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+// maxZipNameLength bounds the part of a zipped screenshot's name taken
+// from its URL
+const maxZipNameLength = 100
+
+// serverScreenshotsZip streams a zip archive of the screenshots of the
+// filtered entries. Each is read from disk as it is written, so the
+// archive is never held in memory.
+func serverScreenshotsZip(w http.ResponseWriter, r *http.Request) {
+
+	entries, err := filteredEntries(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	log.WithFields(log.Fields{"query": r.URL.RawQuery, "entries": len(entries)}).Info("Zipping screenshots")
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="screenshots.zip"`)
+	if err := writeScreenshotsZip(w, entries); err != nil {
+		log.WithField("err", err).Error("Failed to write screenshots zip")
+	}
+}
+
+// writeScreenshotsZip writes a zip archive of the screenshots of
+// entries, named by their position, response code and URL
+func writeScreenshotsZip(w io.Writer, entries []storage.HTTResponse) error {
+
+	archive := zip.NewWriter(w)
+	for i, entry := range entries {
+
+		if entry.ScreenshotFile == "" {
+			continue
+		}
+
+		path := resolveScreenshot(entry.ScreenshotFile)
+		if path == gwtmpl.PlaceHolderImage {
+			continue
+		}
+
+		screenshot, err := os.Open(path)
+		if err != nil {
+			log.WithFields(log.Fields{"screenshot-file": entry.ScreenshotFile, "err": err}).Debug("Skipping missing screenshot")
+			continue
+		}
+
+		name := utils.SafeFileName(entry.URL)
+		if len(name) > maxZipNameLength {
+			name = name[:maxZipNameLength]
+		}
+
+		// screenshots are compressed already, so they are stored
+		// as they are
+		file, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     fmt.Sprintf("%04d-%d-%s%s", i+1, entry.ResponseCode, name, filepath.Ext(path)),
+			Method:   zip.Store,
+			Modified: entry.CapturedAt,
+		})
+		if err == nil {
+			_, err = io.Copy(file, screenshot)
+		}
+		screenshot.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+// serverScreenshot serves the screenshot of an entry. Only the
+// screenshots of known entries are served.
+func serverScreenshot(w http.ResponseWriter, r *http.Request) {
+
+	name := strings.TrimPrefix(r.URL.Path, "/screenshots/")
+
+	entries, err := db.GetHTTPData()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for _, entry := range entries {
+
+		if entry.ScreenshotFile != "" && filepath.Base(entry.ScreenshotFile) == name {
+
+			if screenshot := resolveScreenshot(entry.ScreenshotFile); screenshot != gwtmpl.PlaceHolderImage {
+				http.ServeFile(w, r, screenshot)
+				return
+			}
+		}
+	}
+
+	http.NotFound(w, r)
+}
+
+// serverReview reads (GET) or replaces (POST) the review state of
+// the entry with the key in the path
+func serverReview(w http.ResponseWriter, r *http.Request) {
+
+	key := strings.TrimPrefix(r.URL.Path, "/review/")
+
+	switch r.Method {
+
+	case http.MethodGet:
+		entries, err := db.GetHTTPData()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, entry := range entries {
+			if entry.Key() == key {
+
+				review := entry.Review
+				if review == nil {
+					review = &storage.Review{}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(review)
+				return
+			}
+		}
+
+		http.NotFound(w, r)
+
+	case http.MethodPost:
+		if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+			http.Error(w, "reviews must be sent as application/json", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		if !serverHost(r.Host) || (r.Header.Get("Origin") != "" && r.Header.Get("Origin") != "http://"+r.Host) {
+			log.WithFields(log.Fields{"host": r.Host, "origin": r.Header.Get("Origin")}).Warn("Refused a review update from another site")
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		var review storage.Review
+		if err := json.NewDecoder(io.LimitReader(r.Body, 64<<10)).Decode(&review); err != nil {
+			http.Error(w, "invalid review: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		review.Tags = reviewTags(review.Tags)
+		if err := db.SetReview(key, &review); err == buntdb.ErrNotFound {
+			http.NotFound(w, r)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		log.WithFields(log.Fields{"key": key, "reviewed": review.Reviewed, "tags": review.Tags}).Debug("Updated review")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(review)
+
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// serverHost reports whether host, from the Host header of a request,
+// names the address the server listens on. Pages on other sites can
+// not send JSON to the server, and a name of theirs rebound to it is
+// refused here.
+func serverHost(host string) bool {
+
+	listenName, listenPort, err := net.SplitHostPort(serverAddress)
+	if err != nil {
+		return false
+	}
+
+	name, port, err := net.SplitHostPort(host)
+	if err != nil {
+		name, port = host, "80"
+	}
+
+	if port != listenPort {
+		return false
+	}
+
+	if strings.EqualFold(name, listenName) {
+		return true
+	}
+
+	ip := net.ParseIP(strings.Trim(name, "[]"))
+	switch listenIP := net.ParseIP(listenName); {
+	case listenName == "" || (listenIP != nil && listenIP.IsUnspecified()):
+		return ip != nil || strings.EqualFold(name, "localhost")
+	case strings.EqualFold(listenName, "localhost") || (listenIP != nil && listenIP.IsLoopback()):
+		return (ip != nil && ip.IsLoopback()) || strings.EqualFold(name, "localhost")
+	}
+
+	return false
+}
+
+// reviewTags trims the tags of a review, dropping empty and
+// repeated ones
+func reviewTags(tags []string) []string {
+
+	var cleaned []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[strings.ToLower(tag)] {
+			continue
+		}
+
+		seen[strings.ToLower(tag)] = true
+		cleaned = append(cleaned, tag)
+	}
+
+	return cleaned
+}
+
+func init() {
+	RootCmd.AddCommand(serverCmd)
+
+	serverCmd.Flags().StringVarP(&serverAddress, "address", "a", "localhost:7171", "The address to listen on")
+}
@@ -23,8 +23,11 @@ For example:
 
 $ gowitness single --url https://twitter.com
 $ gowitness single --destination tweeps_page.png --url https://twitter.com
-$ gowitness single -u https://twitter.com`,
+$ gowitness single -u https://twitter.com
+$ gowitness single --url https://twitter.com --repeat 5 --interval 10
+$ gowitness single --url https://twitter.com --crawl-depth 2`,
 
+	PreRun: checkSandbox,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		u, err := url.ParseRequestURI(screenshotURL)
@@ -33,7 +36,32 @@ $ gowitness single -u https://twitter.com`,
 		}
 
 		// Process this URL
-		utils.ProcessURL(u, &chrome, &db, waitTimeout)
+		if repeatCount <= 1 {
+			options.Checkpoint.AddTotal(1)
+			if options.Crawler != nil {
+				options.Crawler.Visit(u)
+			}
+			utils.ProcessURL(u, &chrome, &db, &options)
+			crawlLinks()
+		}
+
+		// Repeated captures each get their own entry, so that
+		// nondeterministic content can be compared
+		if repeatCount > 1 {
+			options.Checkpoint.AddTotal(repeatCount)
+		}
+		for i := 1; repeatCount > 1 && i <= repeatCount; i++ {
+
+			if i > 1 && repeatInterval > 0 {
+				time.Sleep(time.Duration(repeatInterval) * time.Second)
+			}
+
+			log.WithFields(log.Fields{"url": u, "capture": i, "of": repeatCount}).Info("Repeating capture")
+
+			repeatOptions := options
+			repeatOptions.Repeat = i
+			utils.ProcessURL(u, &chrome, &db, &repeatOptions)
+		}
 
 		log.WithFields(log.Fields{"run-time": time.Since(startTime)}).Info("Complete")
 	},
@@ -43,4 +71,7 @@ func init() {
 	RootCmd.AddCommand(singleCmd)
 
 	singleCmd.Flags().StringVarP(&screenshotURL, "url", "u", "", "The URL to screenshot")
+	singleCmd.Flags().IntVarP(&repeatCount, "repeat", "", 1, "Capture the URL this many times, each as a separate entry")
+	singleCmd.Flags().IntVarP(&repeatInterval, "interval", "", 0, "Time in seconds to wait between --repeat captures")
+	singleCmd.Flags().IntVarP(&maxThreads, "threads", "t", 4, "Maximum concurrent threads to crawl links with (see --crawl-depth)")
 }
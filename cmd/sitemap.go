@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	gwtmpl "github.com/RiskSense-Ops/gowitness/template"
+	"github.com/RiskSense-Ops/gowitness/utils"
+)
+
+// sitemapNode is a host, or a path segment below one, of the tree of
+// captured URLs. Entries holds the captures of the URL ending at the
+// node and Count those of the node and everything below it.
+type sitemapNode struct {
+	Name     string         `json:"name"`
+	Count    int            `json:"count"`
+	Entries  []sitemapEntry `json:"entries,omitempty"`
+	Children []*sitemapNode `json:"children,omitempty"`
+	children map[string]*sitemapNode
+}
+
+// sitemapEntry is a capture shown in the tree
+type sitemapEntry struct {
+	URL            string `json:"url"`
+	ResponseCode   int    `json:"response_code"`
+	PageTitle      string `json:"page_title"`
+	ScreenshotFile string `json:"screenshot_file,omitempty"`
+}
+
+// child returns the child of a node named name, adding it if needed
+func (node *sitemapNode) child(name string) *sitemapNode {
+
+	if node.children == nil {
+		node.children = make(map[string]*sitemapNode)
+	}
+
+	if existing, ok := node.children[name]; ok {
+		return existing
+	}
+
+	added := &sitemapNode{Name: name}
+	node.children[name] = added
+	node.Children = append(node.Children, added)
+
+	return added
+}
+
+// sort orders the children of a node and those below it by name
+func (node *sitemapNode) sort() {
+
+	sort.Slice(node.Children, func(i, j int) bool { return node.Children[i].Name < node.Children[j].Name })
+	for _, child := range node.Children {
+		child.sort()
+	}
+}
+
+// buildSitemap arranges entries in a tree of their hosts, with the
+// segments of their paths below them. The query of a URL is kept on
+// its entry rather than adding a node.
+func buildSitemap(entries []storage.HTTResponse) []*sitemapNode {
+
+	root := &sitemapNode{}
+	for _, entry := range entries {
+
+		u, err := url.Parse(entry.URL)
+		if err != nil || u.Host == "" {
+			continue
+		}
+
+		screenshot := entry.ScreenshotFile
+		if screenshot == gwtmpl.PlaceHolderImage {
+			screenshot = ""
+		}
+
+		node := root.child(u.Scheme + "://" + u.Host)
+		node.Count++
+		for _, segment := range strings.Split(u.Path, "/") {
+			if segment == "" {
+				continue
+			}
+
+			node = node.child(segment)
+			node.Count++
+		}
+
+		node.Entries = append(node.Entries, sitemapEntry{
+			URL: entry.URL, ResponseCode: entry.ResponseCode, PageTitle: reportTitle(&entry), ScreenshotFile: screenshot,
+		})
+	}
+
+	root.sort()
+
+	return root.Children
+}
+
+// writeSitemapReport writes sitemap.html, a collapsible tree of the
+// hosts and paths of entries. The entries' screenshots must already
+// be resolved.
+func writeSitemapReport(reportDir string, entries []storage.HTTResponse) {
+
+	tmpl, err := template.New("sitemap-page").Parse(gwtmpl.SitemapContent)
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to parse sitemap template")
+	}
+
+	hosts := buildSitemap(entries)
+
+	var page bytes.Buffer
+	if err := tmpl.Execute(&page, struct{ Hosts []*sitemapNode }{hosts}); err != nil {
+		log.WithField("err", err).Fatal("Failed to render sitemap template")
+	}
+
+	sitemapFile := filepath.Join(reportDir, "sitemap.html")
+	if err := utils.WriteFileAtomic(sitemapFile, page.Bytes(), 0640); err != nil {
+		log.WithField("err", err).Fatal("Failed to write sitemap report")
+	}
+
+	log.WithFields(log.Fields{"report-file": sitemapFile, "hosts": len(hosts)}).Info("Sitemap report generated")
+}
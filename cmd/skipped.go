@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/RiskSense-Ops/gowitness/utils"
+)
+
+// pendingSkips holds the targets skipped while reading and expanding
+// them. They are only stored once a capture starts, so that dry runs
+// and scope checks leave the database alone.
+var pendingSkips []storage.SkippedTarget
+
+// skipTarget notes that target will not be captured for reason
+func skipTarget(target string, reason string) {
+
+	pendingSkips = append(pendingSkips, storage.SkippedTarget{URL: target, Reason: reason})
+}
+
+// recordSkips stores the targets skipped so far
+func recordSkips() {
+
+	for _, skipped := range pendingSkips {
+		utils.RecordSkip(&db, skipped.URL, skipped.Reason)
+	}
+
+	if len(pendingSkips) > 0 {
+		log.WithField("skipped", len(pendingSkips)).Info("Recorded skipped targets (see export --skipped)")
+	}
+
+	pendingSkips = nil
+}
+
+// exportSkippedTargets prints the targets that were skipped and not
+// captured since, optionally only those skipped for a reason. The
+// urls format can be fed back into the file command.
+func exportSkippedTargets() {
+
+	skipped, err := db.GetSkipped()
+	if err != nil {
+		log.WithField("err", err).Fatal("Failed to read skipped targets from the database")
+	}
+
+	reasons := make(map[string]bool)
+	for _, reason := range exportSkipReasons {
+		reasons[reason] = true
+	}
+
+	var filtered []storage.SkippedTarget
+	for _, target := range skipped {
+		if len(reasons) == 0 || reasons[target.Reason] {
+			filtered = append(filtered, target)
+		}
+	}
+
+	log.WithFields(log.Fields{"total": len(skipped), "matched": len(filtered)}).Debug("Filtered skipped targets to export")
+
+	switch exportFormat {
+
+	case "urls":
+		for _, target := range filtered {
+			fmt.Println(target.URL)
+		}
+
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(filtered); err != nil {
+			log.WithField("err", err).Fatal("Failed to encode skipped targets")
+		}
+
+	default:
+		log.WithField("format", exportFormat).Fatal("Invalid export format for skipped targets. Use urls or json")
+	}
+}
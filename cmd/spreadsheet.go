@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	gwtmpl "github.com/RiskSense-Ops/gowitness/template"
+)
+
+// Spreadsheet applications the csv export can write formulas for
+const (
+	spreadsheetExcel  string = "excel"
+	spreadsheetSheets string = "sheets"
+)
+
+// spreadsheetLink returns the link a spreadsheet opens a screenshot
+// with: its URL under --screenshot-base-url, or a file:// URL of where
+// it is on disk. An empty string is returned when it is missing.
+func spreadsheetLink(screenshotFile string) string {
+
+	if screenshotFile == "" {
+		return ""
+	}
+
+	if exportScreenshotBaseURL != "" {
+		return remoteScreenshot(exportScreenshotBaseURL, screenshotFile)
+	}
+
+	local := resolveScreenshot(screenshotFile)
+	if local == gwtmpl.PlaceHolderImage {
+		return ""
+	}
+
+	if absolute, err := filepath.Abs(local); err == nil {
+		local = absolute
+	}
+
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(local)}).String()
+}
+
+// spreadsheetFormula returns the formula of the screenshot cell of an
+// entry. Sheets can only show images it can fetch, so it shows a
+// thumbnail of screenshots served over http(s) and links to others.
+// Excel only shows images inline in recent versions, so it links.
+func spreadsheetFormula(spreadsheet string, screenshotFile string) string {
+
+	link := spreadsheetLink(screenshotFile)
+	if link == "" {
+		return ""
+	}
+
+	quoted := `"` + strings.Replace(link, `"`, `""`, -1) + `"`
+	if spreadsheet == spreadsheetSheets && (strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://")) {
+		return "=IMAGE(" + quoted + ")"
+	}
+
+	label := `"` + strings.Replace(filepath.Base(screenshotFile), `"`, `""`, -1) + `"`
+	return "=HYPERLINK(" + quoted + "," + label + ")"
+}
+
+// spreadsheetText keeps a cell taken from a page, such as its title,
+// from being evaluated as a formula when the file is opened
+func spreadsheetText(value string) string {
+
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+
+	return value
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// maxSummaryItems is the most URLs listed under each finding of the
+// summary email, which links to the report for the rest
+const maxSummaryItems = 20
+
+// scanSummary is what a scan captured, for the summary email
+type scanSummary struct {
+	Captured int
+	Failed   int
+	Statuses map[string]int
+	Findings []summaryFinding
+}
+
+// summaryFinding is a notable kind of entry, and the URLs of the
+// entries of that kind
+type summaryFinding struct {
+	Name string
+	URLs []string
+}
+
+// entryHosts returns the hostnames of entries
+func entryHosts(entries []storage.HTTResponse) map[string]bool {
+
+	hosts := make(map[string]bool)
+	for _, entry := range entries {
+		if u, err := url.Parse(entry.URL); err == nil {
+			hosts[strings.ToLower(u.Hostname())] = true
+		}
+	}
+
+	return hosts
+}
+
+// summarizeScan summarizes the entries captured since, noting the
+// hosts that were not among knownHosts before the scan
+func summarizeScan(entries []storage.HTTResponse, since time.Time, knownHosts map[string]bool) scanSummary {
+
+	summary := scanSummary{Statuses: make(map[string]int)}
+	newHosts := summaryFinding{Name: "New hosts"}
+	logins := summaryFinding{Name: "Login pages"}
+	listings := summaryFinding{Name: "Directory listings"}
+	methods := summaryFinding{Name: "Dangerous methods allowed"}
+	seen := make(map[string]bool)
+
+	for _, entry := range entries {
+
+		if entry.CapturedAt.Before(since) {
+			continue
+		}
+
+		summary.Captured++
+		if entry.ErrorKind != "" && entry.ErrorKind != storage.ErrorKindHTTP {
+			summary.Failed++
+		} else {
+			summary.Statuses[fmt.Sprintf("%dxx", entry.ResponseCode/100)]++
+		}
+
+		if u, err := url.Parse(entry.URL); err == nil {
+			host := strings.ToLower(u.Hostname())
+			if !knownHosts[host] && !seen[host] {
+				seen[host] = true
+				newHosts.URLs = append(newHosts.URLs, host)
+			}
+		}
+
+		if entry.LoginRedirect {
+			logins.URLs = append(logins.URLs, entry.URL)
+		}
+		if entry.DirectoryListing {
+			listings.URLs = append(listings.URLs, entry.URL)
+		}
+		if entry.AllowedMethods != nil && len(entry.AllowedMethods.Dangerous) > 0 {
+			methods.URLs = append(methods.URLs, entry.URL+" ("+strings.Join(entry.AllowedMethods.Dangerous, ", ")+")")
+		}
+	}
+
+	// every host is new to a first scan, which is not notable
+	if len(knownHosts) == 0 {
+		newHosts.URLs = nil
+	}
+
+	for _, finding := range []summaryFinding{newHosts, logins, listings, methods} {
+		if len(finding.URLs) > 0 {
+			summary.Findings = append(summary.Findings, finding)
+		}
+	}
+
+	return summary
+}
+
+// subject returns the subject line of the summary email
+func (summary scanSummary) subject() string {
+
+	subject := fmt.Sprintf("gowitness: %d captured, %d failed", summary.Captured, summary.Failed)
+	if len(summary.Findings) > 0 {
+		subject += fmt.Sprintf(", %d finding(s)", len(summary.Findings))
+	}
+
+	return subject
+}
+
+// text returns the body of the summary email, linking to reportURL
+// when it is set
+func (summary scanSummary) text(runTime time.Duration, reportURL string) string {
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "The scan finished in %s.\n\n", runTime.Round(time.Second))
+	fmt.Fprintf(&text, "Captured: %d\nFailed:   %d\n", summary.Captured, summary.Failed)
+
+	var statuses []string
+	for status := range summary.Statuses {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	for _, status := range statuses {
+		fmt.Fprintf(&text, "%s:      %d\n", status, summary.Statuses[status])
+	}
+
+	for _, finding := range summary.Findings {
+
+		fmt.Fprintf(&text, "\n%s (%d):\n", finding.Name, len(finding.URLs))
+		for i, u := range finding.URLs {
+			if i == maxSummaryItems {
+				fmt.Fprintf(&text, "  ... and %d more\n", len(finding.URLs)-maxSummaryItems)
+				break
+			}
+			fmt.Fprintf(&text, "  %s\n", u)
+		}
+	}
+
+	if reportURL != "" {
+		fmt.Fprintf(&text, "\nReport: %s\n", reportURL)
+	}
+
+	return text.String()
+}
+
+// sendSummaryEmail emails the summary of what was captured since the
+// scan started, unless nothing was
+func sendSummaryEmail() {
+
+	entries, err := db.GetHTTPData()
+	if err != nil {
+		log.WithField("err", err).Error("Failed to read entries for the summary email")
+		return
+	}
+
+	summary := summarizeScan(entries, startTime, summaryKnownHosts)
+	if summary.Captured == 0 {
+		log.Debug("Nothing was captured, not sending a summary email")
+		return
+	}
+
+	if err := mailer.Send(summary.subject(), summary.text(time.Since(startTime), summaryReportURL)); err != nil {
+		log.WithFields(log.Fields{"smtp-server": smtpServer, "err": err}).Error("Failed to send the summary email")
+		return
+	}
+
+	log.WithField("to", emailTo).Info("Sent the summary email")
+}
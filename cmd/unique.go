@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// uniqueTitleServer is the --unique-by keeping one entry per page
+// title and Server header
+const uniqueTitleServer = "title-server"
+
+// dedupeFields are the --dedupe-by fields entries can be told apart
+// by, and how to read them
+var dedupeFields = map[string]func(entry *storage.HTTResponse) string{
+	"title": func(entry *storage.HTTResponse) string { return reportTitle(entry) },
+	"server": func(entry *storage.HTTResponse) string {
+
+		server := ""
+		for _, header := range entry.Headers {
+			if strings.ToLower(header.Key) == "server" {
+				server = header.Value
+			}
+		}
+
+		return server
+	},
+	"favicon": func(entry *storage.HTTResponse) string {
+
+		if entry.Favicon == nil {
+			return ""
+		}
+
+		return strconv.Itoa(int(entry.Favicon.Hash))
+	},
+	"status": func(entry *storage.HTTResponse) string { return strconv.Itoa(entry.ResponseCode) },
+}
+
+// validDedupeFields checks that every --dedupe-by field is known
+func validDedupeFields(fields []string) bool {
+
+	for _, field := range fields {
+		if _, ok := dedupeFields[field]; !ok {
+			return false
+		}
+	}
+
+	return len(fields) > 0
+}
+
+// dedupeDescription names the fields entries were deduplicated by,
+// such as "title, favicon and server"
+func dedupeDescription(fields []string) string {
+
+	if len(fields) == 1 {
+		return fields[0]
+	}
+
+	return strings.Join(fields[:len(fields)-1], ", ") + " and " + fields[len(fields)-1]
+}
+
+// uniqueByFields keeps the first entry of every distinct combination of
+// the values of fields, which collapses estates of identical appliances
+// to one card each. The number of entries left out is returned keyed by
+// the URL of the entry representing them.
+func uniqueByFields(entries []storage.HTTResponse, fields []string) ([]storage.HTTResponse, map[string]int) {
+
+	representative := make(map[string]int)
+	similar := make(map[string]int)
+	var unique []storage.HTTResponse
+	for _, entry := range entries {
+
+		var values []string
+		for _, field := range fields {
+			values = append(values, strings.ToLower(strings.TrimSpace(dedupeFields[field](&entry))))
+		}
+
+		key := strings.Join(values, "\x00")
+		if i, seen := representative[key]; seen {
+			similar[unique[i].URL]++
+			continue
+		}
+
+		representative[key] = len(unique)
+		unique = append(unique, entry)
+	}
+
+	log.WithFields(log.Fields{"kept": len(unique), "left-out": len(entries) - len(unique), "by": fields}).
+		Info("Kept one entry per " + dedupeDescription(fields))
+
+	return unique, similar
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/RiskSense-Ops/gowitness/utils"
+	"github.com/spf13/cobra"
+)
+
+// visualDiffCmd represents the visual-diff command
+var visualDiffCmd = &cobra.Command{
+	Use:   "visual-diff",
+	Short: "Generate an image showing what changed between the last two captures of a URL",
+	Long: `
+Generate an image of the two most recent screenshots of a URL side by
+side, followed by a copy of the latest screenshot with the pixels that
+changed highlighted in red.
+
+Previous captures are only kept when scanning with the --history flag.
+
+For example:
+
+$ gowitness visual-diff --url https://example.com
+$ gowitness visual-diff --url https://example.com --db monitor.db --output changes.png`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		history, err := db.GetHTTPHistory()
+		if err != nil {
+			log.WithField("err", err).Fatal("Failed to read capture history")
+		}
+
+		// history is oldest first, so the last two captures are the
+		// ones we are after
+		var captures []storage.HTTResponse
+		for _, entry := range history {
+			if entry.URL == visualDiffURL && entry.ScreenshotFile != "" {
+				captures = append(captures, entry)
+			}
+		}
+
+		if len(captures) < 2 {
+			log.WithFields(log.Fields{"url": visualDiffURL, "captures": len(captures)}).
+				Fatal("At least two captures of the URL are needed. Was it scanned with --history?")
+		}
+
+		before := captures[len(captures)-2]
+		after := captures[len(captures)-1]
+
+		log.WithFields(log.Fields{
+			"url": visualDiffURL, "before": before.CapturedAt, "after": after.CapturedAt,
+		}).Info("Comparing captures")
+
+		diff, changed, err := utils.VisualDiff(resolveScreenshot(before.ScreenshotFile), resolveScreenshot(after.ScreenshotFile))
+		if err != nil {
+			log.WithFields(log.Fields{"url": visualDiffURL, "err": err}).Fatal("Failed to compare screenshots")
+		}
+
+		if err := utils.WritePNG(visualDiffOutput, diff); err != nil {
+			log.WithFields(log.Fields{"output": visualDiffOutput, "err": err}).Fatal("Failed to write visual diff")
+		}
+
+		log.WithFields(log.Fields{"diff-file": visualDiffOutput, "changed-pixels": changed}).Info("Visual diff generated")
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(visualDiffCmd)
+
+	visualDiffCmd.Flags().StringVarP(&visualDiffURL, "url", "u", "", "The URL to compare the captures of")
+	visualDiffCmd.Flags().StringVarP(&visualDiffOutput, "output", "o", "visual-diff.png", "The file to write the visual diff to")
+	visualDiffCmd.MarkFlagRequired("url")
+}
@@ -0,0 +1,361 @@
+package firefox
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+	log "github.com/sirupsen/logrus"
+)
+
+// Firefox takes screenshots using Firefox, driven by geckodriver
+// over the WebDriver protocol. Screenshot settings such as the
+// resolution, user agent and timeout are shared with Chrome.
+type Firefox struct {
+	Chrome          *chrm.Chrome
+	GeckodriverPath string
+	FirefoxPath     string
+}
+
+// Name returns the name of the engine
+func (firefox *Firefox) Name() string {
+
+	return "firefox"
+}
+
+// Setup checks that geckodriver is available
+func (firefox *Firefox) Setup() {
+
+	if firefox.GeckodriverPath == "" {
+		firefox.GeckodriverPath = "geckodriver"
+	}
+
+	path, err := exec.LookPath(firefox.GeckodriverPath)
+	if err != nil {
+		log.WithFields(log.Fields{"geckodriver-path": firefox.GeckodriverPath, "error": err}).
+			Fatal("Unable to locate geckodriver. Either install it or specify a valid location with the --geckodriver-path flag")
+	}
+
+	log.WithField("geckodriver-path", path).Debug("geckodriver path")
+	firefox.GeckodriverPath = path
+}
+
+// ScreenshotURL takes a screenshot of a URL
+func (firefox *Firefox) ScreenshotURL(targetURL *url.URL, destination string) (*chrm.ScreenshotResult, error) {
+
+	log.WithFields(log.Fields{"url": targetURL, "full-destination": destination}).
+		Debug("Full path to screenshot save using Firefox")
+
+	result := &chrm.ScreenshotResult{}
+
+	// wait for memory before the timeout starts counting
+	admitted := chrm.WaitForMemory(firefox.Chrome.MaxMemory, targetURL.String())
+	defer admitted()
+
+	// get a context to run the command in
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(firefox.Chrome.ChromeTimeout)*time.Second)
+	defer cancel()
+
+	port, err := freePort()
+	if err != nil {
+		log.WithField("error", err).Error("Failed to find a free port for geckodriver")
+		return result, err
+	}
+
+	cmd := exec.CommandContext(ctx, firefox.GeckodriverPath, "--port", strconv.Itoa(port))
+	if err := cmd.Start(); err != nil {
+		log.WithField("error", err).Error("Failed to start geckodriver")
+		return result, err
+	}
+
+	// Firefox is started by geckodriver, so is counted with it
+	untrack := chrm.TrackProcess(cmd.Process.Pid)
+	admitted()
+
+	// geckodriver only exits once we are done with it
+	defer func() {
+		untrack()
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	log.WithFields(log.Fields{"url": targetURL, "destination": destination}).Info("Taking screenshot")
+
+	startTime := time.Now()
+	driver := webdriver{base: "http://127.0.0.1:" + strconv.Itoa(port)}
+	if err := firefox.capture(ctx, &driver, targetURL, destination, result); err != nil {
+
+		if ctx.Err() == context.DeadlineExceeded {
+			log.WithFields(log.Fields{"url": targetURL, "destination": destination, "err": err}).
+				Error("Timeout reached while waiting for screenshot to finish")
+			result.TimedOut = true
+			return result, err
+		}
+
+		log.WithFields(log.Fields{"url": targetURL, "destination": destination, "err": err}).
+			Error("Screenshot failed")
+
+		return result, err
+	}
+
+	log.WithFields(log.Fields{
+		"url": targetURL, "destination": destination, "duration": time.Since(startTime),
+	}).Info("Screenshot taken")
+
+	return result, nil
+}
+
+// capture starts a WebDriver session, navigates to the URL and saves
+// a screenshot to destination.
+func (firefox *Firefox) capture(ctx context.Context, driver *webdriver, targetURL *url.URL,
+	destination string, result *chrm.ScreenshotResult) error {
+
+	if err := driver.waitReady(ctx); err != nil {
+		return err
+	}
+
+	prefs := map[string]interface{}{"general.useragent.override": firefox.Chrome.UserAgent}
+	if firefox.Chrome.ReducedMotion {
+		prefs["ui.prefersReducedMotion"] = 1
+	}
+	if firefox.Chrome.Background != "" {
+		prefs["browser.display.background_color"] = firefox.Chrome.Background[:7]
+	}
+
+	options := map[string]interface{}{
+		"args":  []string{"-headless"},
+		"prefs": prefs,
+	}
+	if firefox.FirefoxPath != "" {
+		options["binary"] = firefox.FirefoxPath
+	}
+
+	var session struct {
+		SessionID string `json:"sessionId"`
+	}
+	capabilities := map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"alwaysMatch": map[string]interface{}{
+				"acceptInsecureCerts": true,
+				"moz:firefoxOptions":  options,
+			},
+		},
+	}
+	if err := driver.do(ctx, "POST", "/session", capabilities, &session); err != nil {
+		return err
+	}
+	driver.session = "/session/" + session.SessionID
+	defer driver.do(context.Background(), "DELETE", driver.session, nil, nil)
+
+	resolution := strings.Split(firefox.Chrome.Resolution, ",")
+	width, _ := strconv.Atoi(resolution[0])
+	height, _ := strconv.Atoi(resolution[1])
+	if err := driver.do(ctx, "POST", driver.session+"/window/rect", map[string]int{"width": width, "height": height}, nil); err != nil {
+		return err
+	}
+
+	if err := driver.do(ctx, "POST", driver.session+"/url", map[string]string{"url": targetURL.String()}, nil); err != nil {
+		return err
+	}
+
+	// WebDriver can not run scripts before the page does, so the
+	// animations are only stopped once it has loaded
+	if firefox.Chrome.ReducedMotion {
+
+		script := map[string]interface{}{"script": chrm.ReducedMotionScript, "args": []string{}}
+		if err := driver.do(ctx, "POST", driver.session+"/execute/sync", script, nil); err != nil {
+			log.WithFields(log.Fields{"url": targetURL, "err": err}).Warn("Failed to disable animations")
+		}
+	}
+
+	if firefox.Chrome.DismissDialogs {
+
+		before := chrm.BeforeDismissFile(destination)
+		if firefox.Chrome.CaptureBeforeDismiss {
+			if err := driver.writeScreenshot(ctx, before); err != nil {
+				log.WithFields(log.Fields{"url": targetURL, "err": err}).Warn("Failed to capture the page before dismissing dialogs")
+			}
+		}
+
+		selectors := append(append([]string{}, chrm.DialogSelectors...), firefox.Chrome.DismissSelectors...)
+		script := map[string]interface{}{"script": "return " + chrm.DismissDialogsScript(selectors), "args": []string{}}
+		if err := driver.do(ctx, "POST", driver.session+"/execute/sync", script, &result.DialogDismissed); err != nil {
+			log.WithFields(log.Fields{"url": targetURL, "err": err}).Warn("Failed to dismiss dialogs")
+		}
+
+		// give the dialog a moment to animate away
+		if result.DialogDismissed {
+			time.Sleep(500 * time.Millisecond)
+		}
+
+		if firefox.Chrome.CaptureBeforeDismiss {
+			result.BeforeDismissFile = chrm.KeepBeforeDismiss(before, result.DialogDismissed)
+		}
+	}
+
+	evaluate := func(script string, out interface{}) error {
+		return driver.do(ctx, "POST", driver.session+"/execute/sync", map[string]interface{}{"script": "return " + script, "args": []string{}}, out)
+	}
+	if err := firefox.Chrome.ClickAndWait(ctx, targetURL.String(), evaluate, result); err != nil {
+		return err
+	}
+
+	var metrics struct {
+		DOMNodes         int      `json:"nodes"`
+		TransferredBytes int64    `json:"transferred"`
+		MixedContent     []string `json:"mixed"`
+	}
+	script := map[string]interface{}{"script": "return " + transferredScript, "args": []string{}}
+	if err := driver.do(ctx, "POST", driver.session+"/execute/sync", script, &metrics); err != nil {
+		log.WithFields(log.Fields{"url": targetURL, "err": err}).Warn("Failed to read the page metrics")
+	}
+	result.DOMNodes = metrics.DOMNodes
+	result.TransferredBytes = metrics.TransferredBytes
+	result.MixedContent = metrics.MixedContent
+
+	script = map[string]interface{}{"script": "return " + chrm.PageLinksScript, "args": []string{}}
+	if err := driver.do(ctx, "POST", driver.session+"/execute/sync", script, &result.Links); err != nil {
+		log.WithFields(log.Fields{"url": targetURL, "err": err}).Warn("Failed to read the page links")
+	}
+
+	if firefox.Chrome.SaveDOMText {
+
+		script := map[string]interface{}{"script": "return " + chrm.DOMTextScript, "args": []string{}}
+		if err := driver.do(ctx, "POST", driver.session+"/execute/sync", script, &result.DOMText); err != nil {
+			log.WithFields(log.Fields{"url": targetURL, "err": err}).Warn("Failed to read the page text")
+		}
+	}
+
+	return driver.writeScreenshot(ctx, destination)
+}
+
+// writeScreenshot captures the page as a PNG, written to destination
+func (driver *webdriver) writeScreenshot(ctx context.Context, destination string) error {
+
+	var screenshot string
+	if err := driver.do(ctx, "GET", driver.session+"/screenshot", nil, &screenshot); err != nil {
+		return err
+	}
+
+	image, err := base64.StdEncoding.DecodeString(screenshot)
+	if err != nil {
+		return errors.Wrap(err, "decoding screenshot")
+	}
+
+	return ioutil.WriteFile(destination, image, 0644)
+}
+
+// transferredScript evaluates to the number of DOM nodes and the bytes
+// transferred to load the page. WebDriver has no network events, so
+// the resource timings are used. Cross origin resources only report
+// their size when they send a Timing-Allow-Origin header. The insecure
+// resources of https pages are listed too, though Firefox blocks (and
+// so never lists) active mixed content such as scripts.
+const transferredScript = `{
+	nodes: ` + chrm.DOMNodesScript + `,
+	transferred: performance.getEntriesByType("navigation").concat(performance.getEntriesByType("resource"))
+		.reduce(function(total, entry) { return total + (entry.transferSize || 0); }, 0),
+	mixed: location.protocol !== "https:" ? [] : performance.getEntriesByType("resource")
+		.map(function(entry) { return entry.name; })
+		.filter(function(name, i, names) { return /^(http|ws):/.test(name) && names.indexOf(name) === i; })
+		.slice(0, 50)
+}`
+
+// webdriver is a minimal WebDriver protocol client
+type webdriver struct {
+	base    string
+	session string
+}
+
+// waitReady waits for the WebDriver server to accept sessions
+func (driver *webdriver) waitReady(ctx context.Context) error {
+
+	for {
+
+		var status struct {
+			Ready bool `json:"ready"`
+		}
+		if err := driver.do(ctx, "GET", "/status", nil, &status); err == nil && status.Ready {
+			return nil
+		}
+
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "waiting for geckodriver")
+		}
+	}
+}
+
+// do runs a WebDriver command, unmarshalling the returned value
+// into value if it is not nil.
+func (driver *webdriver) do(ctx context.Context, method string, path string, body interface{}, value interface{}) error {
+
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, driver.base+path, &payload)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var response struct {
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return errors.Wrap(err, method+" "+path)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+
+		var failure struct {
+			Error   string `json:"error"`
+			Message string `json:"message"`
+		}
+		json.Unmarshal(response.Value, &failure)
+
+		return errors.Errorf("%s %s: %s: %s", method, path, failure.Error, failure.Message)
+	}
+
+	if value != nil && response.Value != nil {
+		return json.Unmarshal(response.Value, value)
+	}
+
+	return nil
+}
+
+// freePort finds a free TCP port to run geckodriver on
+func freePort() (int, error) {
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
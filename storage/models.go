@@ -1,15 +1,283 @@
 package storage
 
+import "time"
+
+// Error kinds used to classify why a URL could not be processed
+const (
+	ErrorKindDNS      string = "dns"
+	ErrorKindConnect  string = "connect"
+	ErrorKindRefused  string = "refused"
+	ErrorKindTLS      string = "tls"
+	ErrorKindTimeout  string = "timeout"
+	ErrorKindNotHTTP  string = "not-http"
+	ErrorKindHTTP     string = "http-error"
+	ErrorKindRedirect string = "redirect-loop"
+	ErrorKindUnknown  string = "unknown"
+)
+
+// ErrorKinds is the order error kinds are reported in
+var ErrorKinds = []string{
+	ErrorKindDNS, ErrorKindConnect, ErrorKindRefused, ErrorKindTLS, ErrorKindTimeout, ErrorKindNotHTTP, ErrorKindHTTP,
+	ErrorKindRedirect, ErrorKindUnknown,
+}
+
+// Reasons an entry was stored as text instead of a screenshot
+const (
+	TextOnlyTimeout string = "timeout"
+	TextOnlySize    string = "size"
+)
+
+// Certificate validity results
+const (
+	CertificateValid            string = "valid"
+	CertificateExpired          string = "expired"
+	CertificateSelfSigned       string = "self-signed"
+	CertificateHostnameMismatch string = "hostname-mismatch"
+	CertificateUntrusted        string = "untrusted"
+)
+
 // HTTResponse contains an HTTP response
 type HTTResponse struct {
 	URL                string         `json:"url"`
 	FinalURL           string         `json:"final_url"`
+	Path               string         `json:"path"`
+	Repeat             int            `json:"repeat"`
+	AppendedQuery      string         `json:"appended_query,omitempty"`
 	ScreenshotFile     string         `json:"screenshot_file"`
+	HeroFile           string         `json:"hero_file"`
+	BlurredFile        string         `json:"blurred_file,omitempty"`
+	CapturedAt         time.Time      `json:"captured_at"`
+	ContentHash        string         `json:"content_hash"`
+	CheckedAt          time.Time      `json:"checked_at"`
+	UnchangedCount     int            `json:"unchanged_count"`
 	ResponseCode       int            `json:"response_code"`
 	ResponseCodeString string         `json:"response_code_string"`
+	Protocol           string         `json:"protocol,omitempty"`
+	MetadataOnly       bool           `json:"metadata_only,omitempty"`
+	HTTP10             bool           `json:"http10,omitempty"`
 	Headers            []HTTPHeader   `json:"headers"`
+	HeadersVerbatim    bool           `json:"headers_verbatim"`
+	Trailers           []HTTPHeader   `json:"trailers"`
+	Request            *HTTPRequest   `json:"request,omitempty"`
 	SSL                SSLCertificate `json:"ssl_certificate"`
-        PageTitle          string         `json:"page_title"`
+	PageTitle          string         `json:"page_title"`
+	DisplayTitle       string         `json:"display_title,omitempty"`
+	TitleSources       *TitleSources  `json:"title_sources,omitempty"`
+	Lang               string         `json:"lang"`
+	Charset            string         `json:"charset"`
+	CharsetMismatch    *CharsetIssue  `json:"charset_mismatch,omitempty"`
+	SniffMismatch      *SniffIssue    `json:"sniff_mismatch,omitempty"`
+	Downgraded         bool           `json:"downgraded"`
+	ProbedScheme       string         `json:"probed_scheme,omitempty"`
+	Accept             string         `json:"accept,omitempty"`
+	PinnedAddress      string         `json:"pinned_address"`
+	Addresses          []string       `json:"addresses,omitempty"`
+	AuthScheme         string         `json:"auth_scheme"`
+	AuthRequired       []AuthMethod   `json:"auth_required,omitempty"`
+	AllowedMethods     *HTTPMethods   `json:"allowed_methods,omitempty"`
+	Technologies       []string       `json:"technologies"`
+	DirectoryListing   bool           `json:"directory_listing,omitempty"`
+	Favicon            *Favicon       `json:"favicon,omitempty"`
+	DialogDismissed    bool           `json:"dialog_dismissed"`
+	BeforeDismissFile  string         `json:"before_dismiss_file"`
+	CaptureLog         string         `json:"capture_log,omitempty"`
+	TileFiles          []string       `json:"tile_files,omitempty"`
+	Clicked            []string       `json:"clicked"`
+	WaitTimedOut       bool           `json:"wait_timed_out"`
+	ClippedHeight      int            `json:"clipped_height"`
+	ScrollIterations   int            `json:"scroll_iterations"`
+	ReducedMotion      bool           `json:"reduced_motion"`
+	Background         string         `json:"background"`
+	Throttle           string         `json:"throttle"`
+	Touch              bool           `json:"touch,omitempty"`
+	Mobile             bool           `json:"mobile,omitempty"`
+	ScaleFactor        float64        `json:"scale_factor,omitempty"`
+	Rendering          string         `json:"rendering,omitempty"`
+	ChromeCrashes      int            `json:"chrome_crashes,omitempty"`
+	DOMNodes           int            `json:"dom_nodes"`
+	TransferredBytes   int64          `json:"transferred_bytes"`
+	ImageWidth         int            `json:"image_width"`
+	ImageHeight        int            `json:"image_height"`
+	Engine             string         `json:"engine"`
+	RedirectChain      []RedirectHop  `json:"redirect_chain"`
+	LoginRedirect      bool           `json:"login_redirect"`
+	LoginRedirectFrom  string         `json:"login_redirect_from"`
+	WebSockets         []string       `json:"websockets"`
+	PushedResources    []string       `json:"pushed_resources,omitempty"`
+	MixedContent       []string       `json:"mixed_content"`
+	JSExceptions       []JSException  `json:"js_exceptions,omitempty"`
+	Canonical          string         `json:"canonical"`
+	Alternates         []Alternate    `json:"alternates"`
+	LinkedHosts        []string       `json:"linked_hosts"`
+	OutboundLinks      []string       `json:"outbound_links,omitempty"`
+	CrawlDepth         int            `json:"crawl_depth,omitempty"`
+	ContentType        string         `json:"content_type"`
+	StructuredBody     string         `json:"structured_body"`
+	Body               string         `json:"body,omitempty"`
+	DOMText            string         `json:"dom_text,omitempty"`
+	TextOnly           string         `json:"text_only,omitempty"`
+	AXTree             *AXTree        `json:"ax_tree,omitempty"`
+	Challenge          *Challenge     `json:"challenge,omitempty"`
+	ErrorKind          string         `json:"error_kind"`
+	Error              string         `json:"error"`
+	Banner             *Banner        `json:"banner,omitempty"`
+
+	// Source is what a search engine export reported
+	// about the URL, if it was read from one
+	Source *SourceInfo `json:"source,omitempty"`
+
+	// Review is the triage state of the entry, which is
+	// stored separately. It is only set when reading entries.
+	Review *Review `json:"review,omitempty"`
+
+	// AutoTags are the tags of the rules the entry matched when
+	// the report was generated. They are not stored.
+	AutoTags []string `json:"auto_tags,omitempty"`
+}
+
+// RedirectHop is a single request in a redirect chain, ending
+// with the final URL. MetaRefresh marks a page that redirected
+// with a meta refresh tag rather than a 3xx response.
+type RedirectHop struct {
+	URL         string        `json:"url"`
+	StatusCode  int           `json:"status_code"`
+	Duration    time.Duration `json:"duration"`
+	MetaRefresh bool          `json:"meta_refresh,omitempty"`
+}
+
+// JSException is an uncaught JavaScript exception thrown by
+// a page, at a 1-based line and column of a script
+type JSException struct {
+	Message string `json:"message"`
+	URL     string `json:"url"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+}
+
+// Challenge is a JavaScript challenge page, such as the one Cloudflare
+// shows while checking the browser, that a page showed first. Solved
+// is set when it resolved into the real page within the wait.
+type Challenge struct {
+	Provider string        `json:"provider"`
+	Solved   bool          `json:"solved"`
+	Waited   time.Duration `json:"waited"`
+	Reloads  int           `json:"reloads"`
+}
+
+// AXTree is the accessibility tree of a page, with the number of
+// nodes it has and of the controls and images that have no name
+type AXTree struct {
+	Nodes            []AXNode `json:"nodes"`
+	Total            int      `json:"total"`
+	UnlabeledButtons int      `json:"unlabeled_buttons"`
+	UnlabeledImages  int      `json:"unlabeled_images"`
+	UnlabeledLinks   int      `json:"unlabeled_links"`
+	UnlabeledFields  int      `json:"unlabeled_fields"`
+}
+
+// AXNode is a node of an accessibility tree, at a depth below its root
+type AXNode struct {
+	Role  string `json:"role"`
+	Name  string `json:"name,omitempty"`
+	Depth int    `json:"depth"`
+}
+
+// Unlabeled is the number of controls and images without a name
+func (tree *AXTree) Unlabeled() int {
+
+	return tree.UnlabeledButtons + tree.UnlabeledImages + tree.UnlabeledLinks + tree.UnlabeledFields
+}
+
+// Alternate is an hreflang alternate of a page, linking to the
+// version of the page in another language or region
+type Alternate struct {
+	Lang string `json:"hreflang"`
+	URL  string `json:"url"`
+}
+
+// SourceInfo is what Shodan or Censys reported about a service
+type SourceInfo struct {
+	Provider  string   `json:"provider"`
+	Product   string   `json:"product"`
+	Org       string   `json:"org"`
+	Hostnames []string `json:"hostnames"`
+}
+
+// Banner is what a service that did not answer HTTP sent when it was
+// connected to, or the certificate it presented if it spoke TLS
+type Banner struct {
+	Address     string   `json:"address"`
+	Text        string   `json:"text,omitempty"`
+	TLSVersion  string   `json:"tls_version,omitempty"`
+	TLSSubject  string   `json:"tls_subject,omitempty"`
+	TLSIssuer   string   `json:"tls_issuer,omitempty"`
+	TLSDNSNames []string `json:"tls_dns_names,omitempty"`
+}
+
+// AuthMethod is an authentication scheme a server asked for in its
+// WWW-Authenticate header, along with the realm it named, if any
+type AuthMethod struct {
+	Scheme string `json:"scheme"`
+	Realm  string `json:"realm,omitempty"`
+}
+
+// Where the methods a server allows were read from
+const (
+	MethodsFromOptions  string = "options"
+	MethodsFromRejected string = "405"
+)
+
+// HTTPMethods are the methods a server listed in an Allow header,
+// either answering an OPTIONS request or rejecting the capture with a
+// 405. Dangerous are those among them that deserve a closer look.
+type HTTPMethods struct {
+	Methods   []string `json:"methods"`
+	Dangerous []string `json:"dangerous,omitempty"`
+	Source    string   `json:"source"`
+}
+
+// TitleSources are the parts of a page that can stand in for its
+// title, for the retitle command. Heading is the first h1.
+type TitleSources struct {
+	Description string `json:"description,omitempty"`
+	OGTitle     string `json:"og_title,omitempty"`
+	Heading     string `json:"heading,omitempty"`
+}
+
+// CharsetIssue records the character sets a page declared in its
+// Content-Type header and meta element when they disagree with each
+// other or with the encoding detected from the body
+type CharsetIssue struct {
+	Header   string `json:"header,omitempty"`
+	Meta     string `json:"meta,omitempty"`
+	Detected string `json:"detected,omitempty"`
+}
+
+// SniffIssue records the Content-Type a response declared when it
+// disagrees with what its body sniffs as. NoSniff is set when the
+// response asked browsers not to sniff it.
+type SniffIssue struct {
+	Declared string `json:"declared"`
+	Sniffed  string `json:"sniffed"`
+	NoSniff  bool   `json:"nosniff"`
+}
+
+// Favicon is the icon of a page. Data is a data URI of the icon, empty
+// for icons too big to keep.
+// DefaultApp names the application whose stock icon it is, which
+// suggests a default install.
+type Favicon struct {
+	URL        string `json:"url"`
+	Hash       int32  `json:"hash"`
+	Data       string `json:"data,omitempty"`
+	DefaultApp string `json:"default_app,omitempty"`
+}
+
+// HTTPRequest contains the request that was sent for a URL
+type HTTPRequest struct {
+	Method  string       `json:"method"`
+	URL     string       `json:"url"`
+	Headers []HTTPHeader `json:"headers"`
 }
 
 // HTTPHeader contains an HTTP header key value pair
@@ -22,12 +290,28 @@ type HTTPHeader struct {
 type SSLCertificate struct {
 	PeerCertificates []SSLCertificateAttributes `json:"peer_certificates"`
 	CipherSuite      uint16                     `json:"cipher_suite"`
+	Validity         string                     `json:"validity"`
+	Versions         []TLSVersion               `json:"versions,omitempty"`
+	ChainIncomplete  bool                       `json:"chain_incomplete,omitempty"`
+}
+
+// TLSVersion records whether a server accepts a TLS version,
+// and the cipher suite it negotiated if it does
+type TLSVersion struct {
+	Version     string `json:"version"`
+	Accepted    bool   `json:"accepted"`
+	CipherSuite string `json:"cipher_suite"`
+	Weak        bool   `json:"weak"`
 }
 
-// SSLCertificateAttributes contains the attributes of a certificate
+// SSLCertificateAttributes contains the attributes of a certificate,
+// along with the certificate itself PEM encoded
 type SSLCertificateAttributes struct {
 	SubjectCommonName  string   `json:"subject_common_name"`
 	IssuerCommonName   string   `json:"issuer_common_name"`
+	Subject            string   `json:"subject,omitempty"`
+	Issuer             string   `json:"issuer,omitempty"`
 	SignatureAlgorithm string   `json:"signature_algorith"`
 	DNSNames           []string `json:"dns_names"`
+	PEM                string   `json:"pem,omitempty"`
 }
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/tidwall/buntdb"
+)
+
+// reviewPrefix prefixes the keys of the review state of entries. It is
+// kept apart from the entries so that it survives a rescan.
+const reviewPrefix string = "review:"
+
+// Review is the triage state of an entry
+type Review struct {
+	Reviewed  bool      `json:"reviewed"`
+	Tags      []string  `json:"tags"`
+	Note      string    `json:"note"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsReviewKey checks if a key holds the review state of an entry
+// rather than the entry itself
+func IsReviewKey(key string) bool {
+
+	return strings.HasPrefix(key, reviewPrefix)
+}
+
+// SetReview stores the review state of the entry with key. Keys of
+// anything other than an entry are not found.
+func (storage *Storage) SetReview(key string, review *Review) error {
+
+	if IsHistoryKey(key) || IsReviewKey(key) || IsSkippedKey(key) {
+		return buntdb.ErrNotFound
+	}
+
+	review.UpdatedAt = time.Now().UTC()
+	encoded, err := json.Marshal(review)
+	if err != nil {
+		return err
+	}
+
+	return storage.Db.Update(func(tx *buntdb.Tx) error {
+
+		if _, err := tx.Get(key); err != nil {
+			return err
+		}
+
+		_, _, err := tx.Set(reviewPrefix+key, string(encoded), nil)
+		return err
+	})
+}
+
+// reviewOf reads the review state of the entry with key, if it has one
+func reviewOf(tx *buntdb.Tx, key string) *Review {
+
+	value, err := tx.Get(reviewPrefix + key)
+	if err != nil {
+		return nil
+	}
+
+	review := &Review{}
+	if err := json.Unmarshal([]byte(value), review); err != nil {
+		return nil
+	}
+
+	return review
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tidwall/buntdb"
+)
+
+// skippedPrefix prefixes the keys of targets that were not captured,
+// so that they can be audited and tried again
+const skippedPrefix string = "skipped:"
+
+// Reasons a target is skipped instead of captured
+const (
+	SkipInvalidURL string = "invalid-url"
+	SkipDuplicate  string = "duplicate"
+	SkipOnePerHost string = "one-per-host"
+	SkipDiskLimit  string = "disk-limit"
+	SkipMatchBody  string = "match-body"
+	SkipUnchanged  string = "unchanged"
+)
+
+// SkippedTarget is a target that was not captured, and why
+type SkippedTarget struct {
+	URL       string    `json:"url"`
+	Reason    string    `json:"reason"`
+	SkippedAt time.Time `json:"skipped_at"`
+}
+
+// IsSkippedKey checks if a key holds a skipped target rather than
+// an entry
+func IsSkippedKey(key string) bool {
+
+	return strings.HasPrefix(key, skippedPrefix)
+}
+
+// skippedKey returns the key a skipped target is stored under. Only
+// the latest reason a URL was skipped for is kept.
+func skippedKey(url string) string {
+
+	key := sha1.Sum([]byte(url))
+	return skippedPrefix + hex.EncodeToString(key[:])
+}
+
+// SetSkipped records that the target url was not captured for reason
+func (storage *Storage) SetSkipped(url string, reason string) error {
+
+	encoded, err := json.Marshal(&SkippedTarget{URL: url, Reason: reason, SkippedAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+
+	return storage.Db.Update(func(tx *buntdb.Tx) error {
+
+		_, _, err := tx.Set(skippedKey(url), string(encoded), nil)
+		return err
+	})
+}
+
+// GetSkipped returns the targets that were skipped and have not been
+// captured since, in the order they were skipped
+func (storage *Storage) GetSkipped() ([]SkippedTarget, error) {
+
+	var skipped []SkippedTarget
+	err := storage.Db.View(func(tx *buntdb.Tx) error {
+
+		return tx.AscendKeys(skippedPrefix+"*", func(key, value string) bool {
+
+			target := SkippedTarget{}
+			if err := json.Unmarshal([]byte(value), &target); err == nil {
+				skipped = append(skipped, target)
+			}
+
+			return true
+		})
+	})
+
+	sort.SliceStable(skipped, func(i, j int) bool {
+		return skipped[i].SkippedAt.Before(skipped[j].SkippedAt)
+	})
+
+	return skipped, err
+}
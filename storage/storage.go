@@ -4,15 +4,37 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/tidwall/buntdb"
 )
 
+// historyPrefix prefixes the keys of previous captures of a URL
+const historyPrefix string = "history:"
+
 // Storage handles the pointer to a buntdb instance
 type Storage struct {
 	Db *buntdb.DB
+
+	// History keeps every capture of a URL, in addition
+	// to the latest one
+	History bool
+
+	// Publish is called with the JSON of every entry
+	// stored, when not nil
+	Publish func(data []byte)
+}
+
+// IsHistoryKey checks if a key belongs to a previous capture
+// rather than the latest one
+func IsHistoryKey(key string) bool {
+
+	return strings.HasPrefix(key, historyPrefix)
 }
 
 // Open creates a new connection to a buntdb database
@@ -33,6 +55,20 @@ func (storage *Storage) Open(path string) error {
 	return nil
 }
 
+// Key returns the key an entry is stored under
+func (data *HTTResponse) Key() string {
+
+	key := sha1.New()
+	key.Write([]byte(data.URL))
+
+	// repeated captures of a URL are kept apart
+	if data.Repeat > 0 {
+		key.Write([]byte("#repeat-" + strconv.Itoa(data.Repeat)))
+	}
+
+	return hex.EncodeToString(key.Sum(nil))
+}
+
 // SetHTTPData stores HTTP information about a URL
 func (storage *Storage) SetHTTPData(data *HTTResponse) {
 
@@ -43,15 +79,26 @@ func (storage *Storage) SetHTTPData(data *HTTResponse) {
 	}
 
 	// generate a key to use
-	key := sha1.New()
-	key.Write([]byte(data.URL))
-	keyBytes := key.Sum(nil)
-	keyString := hex.EncodeToString(keyBytes)
+	keyString := data.Key()
 	log.WithFields(log.Fields{"url": data.URL, "key": keyString}).Debug("Calculated key for storage")
 
 	// add the document
 	err = storage.Db.Update(func(tx *buntdb.Tx) error {
-		_, _, err := tx.Set(keyString, string(jsonData), nil)
+		if _, _, err := tx.Set(keyString, string(jsonData), nil); err != nil {
+			return err
+		}
+
+		// a target captured after all is no longer skipped
+		if _, err := tx.Delete(skippedKey(data.URL)); err != nil && err != buntdb.ErrNotFound {
+			return err
+		}
+
+		if !storage.History {
+			return nil
+		}
+
+		historyKey := historyPrefix + keyString + ":" + strconv.FormatInt(data.CapturedAt.UnixNano(), 10)
+		_, _, err := tx.Set(historyKey, string(jsonData), nil)
 
 		return err
 	})
@@ -59,6 +106,157 @@ func (storage *Storage) SetHTTPData(data *HTTResponse) {
 	if err != nil {
 		log.WithField("err", err).Fatal("Error saving HTTP response data")
 	}
+
+	if storage.Publish != nil {
+		storage.Publish(jsonData)
+	}
+}
+
+// GetHTTPEntry returns the stored entry with key, or nil if there
+// is none
+func (storage *Storage) GetHTTPEntry(key string) (*HTTResponse, error) {
+
+	var data *HTTResponse
+	err := storage.Db.View(func(tx *buntdb.Tx) error {
+
+		value, err := tx.Get(key)
+		if err == buntdb.ErrNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		data = &HTTResponse{}
+		return json.Unmarshal([]byte(value), data)
+	})
+
+	return data, err
+}
+
+// MarkUnchanged records that the entry with key was checked again at
+// checkedAt, and had not changed
+func (storage *Storage) MarkUnchanged(key string, checkedAt time.Time) error {
+
+	return storage.Db.Update(func(tx *buntdb.Tx) error {
+
+		value, err := tx.Get(key)
+		if err != nil {
+			return err
+		}
+
+		data := HTTResponse{}
+		if err := json.Unmarshal([]byte(value), &data); err != nil {
+			return err
+		}
+
+		data.CheckedAt = checkedAt
+		data.UnchangedCount++
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = tx.Set(key, string(jsonData), nil)
+		return err
+	})
+}
+
+// SetDisplayTitle sets the title the entry with key is shown with in
+// place of its page title. An empty title shows the page title again.
+func (storage *Storage) SetDisplayTitle(key string, title string) error {
+
+	return storage.Db.Update(func(tx *buntdb.Tx) error {
+
+		value, err := tx.Get(key)
+		if err != nil {
+			return err
+		}
+
+		data := HTTResponse{}
+		if err := json.Unmarshal([]byte(value), &data); err != nil {
+			return err
+		}
+
+		data.DisplayTitle = title
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = tx.Set(key, string(jsonData), nil)
+		return err
+	})
+}
+
+// GetHTTPData returns all of the stored HTTP responses
+func (storage *Storage) GetHTTPData() ([]HTTResponse, error) {
+
+	var responses []HTTResponse
+	err := storage.Db.View(func(tx *buntdb.Tx) error {
+
+		return tx.Ascend("", func(key, value string) bool {
+
+			if IsHistoryKey(key) || IsReviewKey(key) || IsSkippedKey(key) {
+				return true
+			}
+
+			data := HTTResponse{}
+			if err := json.Unmarshal([]byte(value), &data); err != nil {
+				log.WithFields(log.Fields{"key": key, "err": err}).Error("Failed to unmarshal HTTP response data")
+				return true
+			}
+			data.Review = reviewOf(tx, key)
+
+			responses = append(responses, data)
+			return true
+		})
+	})
+
+	return responses, err
+}
+
+// GetHTTPHistory returns every stored capture, oldest first. Only
+// the latest capture of each URL is available if history was not
+// kept while scanning.
+func (storage *Storage) GetHTTPHistory() ([]HTTResponse, error) {
+
+	var history []HTTResponse
+	var latest []HTTResponse
+	err := storage.Db.View(func(tx *buntdb.Tx) error {
+
+		return tx.Ascend("", func(key, value string) bool {
+
+			if IsReviewKey(key) || IsSkippedKey(key) {
+				return true
+			}
+
+			data := HTTResponse{}
+			if err := json.Unmarshal([]byte(value), &data); err != nil {
+				log.WithFields(log.Fields{"key": key, "err": err}).Error("Failed to unmarshal HTTP response data")
+				return true
+			}
+
+			if IsHistoryKey(key) {
+				history = append(history, data)
+			} else {
+				latest = append(latest, data)
+			}
+
+			return true
+		})
+	})
+
+	if len(history) == 0 {
+		history = latest
+	}
+
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].CapturedAt.Before(history[j].CapturedAt)
+	})
+
+	return history, err
 }
 
 // Close closes the connection to a buntdb connection
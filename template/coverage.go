@@ -0,0 +1,93 @@
+package template
+
+// CoverageContent is the template of the coverage report, showing a
+// grid of the addresses of each scanned range
+var CoverageContent = `
+<!doctype html>
+<html lang="en">
+
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1, shrink-to-fit=no">
+  <meta name="author" content="Leon Jacobs @leonjza">
+
+  <title>gowitness - Coverage</title>
+
+  <!-- Bootstrap core CSS -->
+  <link href="https://maxcdn.bootstrapcdn.com/bootstrap/4.0.0-beta.2/css/bootstrap.min.css" rel="stylesheet">
+
+  <style>
+    .album {
+      padding-top: 3rem;
+      padding-bottom: 3rem;
+      background-color: #f7f7f7;
+    }
+
+    .coverage-grid {
+      display: grid;
+      grid-template-columns: repeat(16, 1.25rem);
+      gap: 2px;
+      margin-bottom: 2rem;
+    }
+
+    .coverage-cell {
+      display: block;
+      width: 1.25rem;
+      height: 1.25rem;
+      border-radius: 2px;
+    }
+
+    .coverage-service {
+      background-color: #28a745;
+    }
+
+    .coverage-failed {
+      background-color: #ffc107;
+    }
+
+    .coverage-none {
+      background-color: #dee2e6;
+    }
+  </style>
+</head>
+
+<body>
+
+  <header>
+    <div class="navbar navbar-dark bg-dark">
+      <div class="container d-flex justify-content-between">
+        <a href="page-0.html" class="navbar-brand">gowitness report</a>
+      </div>
+    </div>
+  </header>
+
+  <main role="main">
+
+    <div class="container">
+      <h3 class="jumbotron-heading">Coverage of {{ len .Ranges }} range(s)</h3>
+      <p class="text-muted">
+        <span class="coverage-cell coverage-service d-inline-block align-middle"></span> web service found &middot;
+        <span class="coverage-cell coverage-failed d-inline-block align-middle"></span> captured without a response &middot;
+        <span class="coverage-cell coverage-none d-inline-block align-middle"></span> nothing captured
+      </p>
+    </div>
+
+    <div class="album text-muted">
+      <div class="container">
+        {{ range $range := .Ranges }}
+        <h4>{{ $range.CIDR }} <small class="text-muted">{{ $range.Services }} of {{ $range.Addresses }} address(es) with a web service{{ if $range.Failed }}, {{ $range.Failed }} without a response{{ end }}</small></h4>
+        <div class="coverage-grid">
+          {{ range $cell := $range.Cells }}
+          {{ if $cell.Link }}<a href="{{ $cell.Link }}" target="_blank" rel="noopener noreferrer" class="coverage-cell coverage-{{ $cell.State }}" title="{{ $cell.IP }}{{ range $cell.URLs }}&#10;{{ html . }}{{ end }}"></a>{{ else }}<span class="coverage-cell coverage-{{ $cell.State }}" title="{{ $cell.IP }}{{ range $cell.URLs }}&#10;{{ html . }}{{ end }}"></span>{{ end }}
+          {{ end }}
+        </div>
+        {{ end }}
+      </div>
+    </div>
+
+  </main>
+
+</body>
+
+</html>
+`
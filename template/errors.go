@@ -0,0 +1,86 @@
+package template
+
+// ErrorsContent is the template used for the gowitness errors report
+var ErrorsContent = `
+<!doctype html>
+<html lang="en">
+
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1, shrink-to-fit=no">
+  <meta name="author" content="Leon Jacobs @leonjza">
+
+  <title>gowitness - Errors</title>
+
+  <!-- Bootstrap core CSS -->
+  <link href="https://maxcdn.bootstrapcdn.com/bootstrap/4.0.0-beta.2/css/bootstrap.min.css" rel="stylesheet">
+
+  <style>
+    .album {
+      padding-top: 3rem;
+      padding-bottom: 3rem;
+      background-color: #f7f7f7;
+    }
+
+    summary {
+      font-size: 1.25rem;
+      cursor: pointer;
+    }
+  </style>
+</head>
+
+<body>
+
+  <header>
+    <div class="navbar navbar-dark bg-dark">
+      <div class="container d-flex justify-content-between">
+        <a href="page-0.html" class="navbar-brand">gowitness report</a>
+      </div>
+    </div>
+  </header>
+
+  <main role="main">
+
+    <div class="container">
+      <h3 class="jumbotron-heading">{{ .Total }} URL(s) could not be captured</h3>
+      <ul class="list-inline">
+        {{ range $group := .Groups }}
+        <li class="list-inline-item"><a href="#{{ $group.Kind }}">{{ $group.Kind }}</a> <span class="badge badge-danger">{{ len $group.Entries }}</span></li>
+        {{ end }}
+      </ul>
+    </div>
+
+    <div class="album text-muted">
+      <div class="container">
+
+        {{ range $group := .Groups }}
+        <details id="{{ $group.Kind }}">
+          <summary>{{ $group.Kind }} <span class="badge badge-danger">{{ len $group.Entries }}</span></summary>
+          <table class="table table-sm">
+            <thead>
+              <tr>
+                <th scope="col">URL</th>
+                <th scope="col">Error</th>
+              </tr>
+            </thead>
+            <tbody>
+              {{ range $entry := $group.Entries }}
+              <tr>
+                <td><a href="{{ $entry.URL }}" target="_blank" rel="noopener noreferrer">{{ $entry.URL }}</a></td>
+                <td>{{ html $entry.Error }}{{ if $entry.RedirectChain }}<div class="small text-muted">{{ range $hop := $entry.RedirectChain }}{{ $hop.StatusCode }} {{ html $hop.URL }}<br>{{ end }}</div>{{ end }}{{ with $entry.Banner }}<div class="small text-muted">{{ .Address }}{{ if .TLSVersion }} speaks {{ .TLSVersion }}, certificate {{ html .TLSSubject }} issued by {{ html .TLSIssuer }}{{ range .TLSDNSNames }} {{ html . }}{{ end }}{{ end }}</div>{{ if .Text }}<pre class="small mb-0">{{ html .Text }}</pre>{{ end }}{{ end }}</td>
+              </tr>
+              {{ end }}
+            </tbody>
+          </table>
+        </details>
+        {{ end }}
+
+      </div>
+    </div>
+
+  </main>
+
+</body>
+
+</html>
+`
@@ -0,0 +1,111 @@
+package template
+
+// EvidenceContent is the template of the printable evidence report,
+// showing a single capture per printed page
+var EvidenceContent = `
+<!doctype html>
+<html lang="en">
+
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1, shrink-to-fit=no">
+  <meta name="author" content="Leon Jacobs @leonjza">
+
+  <title>gowitness - Evidence</title>
+
+  <style>
+    @page {
+      size: A4;
+      margin: 12mm;
+    }
+
+    body {
+      font-family: -apple-system, "Segoe UI", Roboto, "Helvetica Neue", Arial, "Noto Sans", sans-serif;
+      font-size: 10pt;
+      color: #212529;
+      margin: 0;
+    }
+
+    .evidence {
+      break-after: page;
+      page-break-after: always;
+      padding: 1rem 0;
+    }
+
+    .evidence:last-child {
+      break-after: auto;
+      page-break-after: auto;
+    }
+
+    .evidence h2 {
+      font-size: 12pt;
+      margin: 0 0 .5rem;
+      overflow-wrap: anywhere;
+    }
+
+    .evidence img {
+      display: block;
+      max-width: 100%;
+      max-height: 185mm;
+      margin: 0 auto .75rem;
+      border: 1px solid #ccc;
+      object-fit: contain;
+      object-position: top;
+    }
+
+    .evidence pre {
+      white-space: pre-wrap;
+      font-size: 8pt;
+    }
+
+    .caption {
+      width: 100%;
+      border-collapse: collapse;
+      break-inside: avoid;
+      page-break-inside: avoid;
+    }
+
+    .caption th,
+    .caption td {
+      text-align: left;
+      vertical-align: top;
+      padding: .15rem .5rem .15rem 0;
+      border-top: 1px solid #dee2e6;
+      overflow-wrap: anywhere;
+    }
+
+    .caption th {
+      width: 25%;
+      white-space: nowrap;
+    }
+  </style>
+</head>
+
+<body>
+  {{ range $i, $entry := .Entries }}
+  <section class="evidence">
+    <h2>{{ $entry.Number }}. {{ html $entry.URL }}</h2>
+    {{ if $entry.StructuredBody }}
+    <pre>{{ html $entry.StructuredBody }}</pre>
+    {{ else if $entry.TextOnly }}
+    <pre>{{ html $entry.DOMText }}</pre>
+    {{ else }}
+    <img src="{{ $entry.ScreenshotFile }}" alt="{{ html $entry.URL }}">
+    {{ end }}
+    <table class="caption">
+      <tr><th>URL</th><td>{{ html $entry.URL }}</td></tr>
+      {{ if ne $entry.FinalURL $entry.URL }}<tr><th>Final URL</th><td>{{ html $entry.FinalURL }}</td></tr>{{ end }}
+      <tr><th>IP address</th><td>{{ if $entry.PinnedAddress }}{{ $entry.PinnedAddress }} (pinned){{ else }}{{ range $j, $address := $entry.Addresses }}{{ if $j }}, {{ end }}{{ $address }}{{ else }}unknown{{ end }}{{ end }}</td></tr>
+      <tr><th>Status</th><td>{{ html $entry.ResponseCodeString }}</td></tr>
+      <tr><th>Title</th><td dir="auto">{{ html $entry.PageTitle }}</td></tr>
+      {{ if $entry.DisplayTitle }}<tr><th>Display title</th><td dir="auto">{{ html $entry.DisplayTitle }}</td></tr>{{ end }}
+      <tr><th>Captured</th><td>{{ $entry.CapturedAt.UTC.Format "2006-01-02 15:04:05 MST" }}</td></tr>
+      <tr><th>Server</th><td>{{ if $entry.Server }}{{ html $entry.Server }}{{ else }}not disclosed{{ end }}</td></tr>
+      {{ if $entry.TLS }}<tr><th>TLS</th><td>{{ html $entry.TLS }}</td></tr>{{ end }}
+    </table>
+  </section>
+  {{ end }}
+</body>
+
+</html>
+`
@@ -0,0 +1,115 @@
+package template
+
+// FilmstripContent is the template used for the gowitness filmstrip report
+var FilmstripContent = `
+<!doctype html>
+<html lang="en">
+
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1, shrink-to-fit=no">
+  <meta name="author" content="Leon Jacobs @leonjza">
+
+  <title>gowitness - Filmstrip</title>
+
+  <!-- Bootstrap core CSS -->
+  <link href="https://maxcdn.bootstrapcdn.com/bootstrap/4.0.0-beta.2/css/bootstrap.min.css" rel="stylesheet">
+
+  <style>
+    .album {
+      padding-top: 3rem;
+      padding-bottom: 3rem;
+      background-color: #f7f7f7;
+    }
+
+    .filmstrip {
+      display: flex;
+      overflow-x: auto;
+      padding-bottom: 1rem;
+    }
+
+    .frame {
+      flex: 0 0 auto;
+      width: 240px;
+      margin-right: 1rem;
+      text-align: center;
+    }
+
+    .frame img {
+      width: 100%;
+      border: 1px solid #ccc;
+    }
+
+    .frame-detail {
+      display: none;
+    }
+
+    /* titles may be in any script or direction */
+    .page-title {
+      unicode-bidi: plaintext;
+      overflow-wrap: anywhere;
+      font-family: -apple-system, "Segoe UI", Roboto, "Helvetica Neue", Arial, "Noto Sans", "Noto Sans Arabic", "Noto Sans Hebrew", "Noto Sans CJK SC", "PingFang SC", "Hiragino Sans", "Microsoft YaHei", "Malgun Gothic", sans-serif;
+    }
+
+    .frame-detail:target {
+      display: block;
+    }
+  </style>
+</head>
+
+<body>
+
+  <header>
+    <div class="navbar navbar-dark bg-dark">
+      <div class="container d-flex justify-content-between">
+        <a href="page-0.html" class="navbar-brand">gowitness report</a>
+      </div>
+    </div>
+  </header>
+
+  <main role="main">
+
+    <div class="container">
+      <h3 class="jumbotron-heading">Captures of {{ len .Filmstrips }} host(s) over time</h3>
+    </div>
+
+    <div class="album text-muted">
+      <div class="container">
+
+        {{ range $i, $filmstrip := .Filmstrips }}
+        <section>
+          <h5>{{ html $filmstrip.Host }} <span class="badge badge-secondary">{{ len $filmstrip.Frames }}</span></h5>
+          <div class="filmstrip">
+            {{ range $j, $frame := $filmstrip.Frames }}
+            <a class="frame" href="#frame-{{ $i }}-{{ $j }}">
+              <img src="{{ $frame.ScreenshotFile }}" alt="{{ html $frame.URL }}">
+              <small>{{ $frame.CapturedAt.Format "2006-01-02 15:04" }}</small>
+            </a>
+            {{ end }}
+          </div>
+
+          {{ range $j, $frame := $filmstrip.Frames }}
+          <div class="frame-detail card card-body" id="frame-{{ $i }}-{{ $j }}">
+            <h6><a href="{{ html $frame.FinalURL }}" target="_blank" rel="noopener noreferrer">{{ html $frame.URL }}</a> <small>{{ html $frame.ResponseCodeString }}</small></h6>
+            <p>
+              Captured at {{ $frame.CapturedAt.Format "2006-01-02 15:04:05 MST" }}<br>
+              <span class="page-title" dir="auto"{{ if $frame.Lang }} lang="{{ html $frame.Lang }}"{{ end }}>{{ html $frame.PageTitle }}</span>
+            </p>
+            <div>
+              {{ range $technology := $frame.Technologies }}<span class="badge badge-secondary">{{ $technology }}</span> {{ end }}
+            </div>
+            <a href="{{ $frame.ScreenshotFile }}" target="_blank"><img class="img-fluid" src="{{ $frame.ScreenshotFile }}" alt="{{ html $frame.URL }}"></a>
+          </div>
+          {{ end }}
+        </section>
+        {{ end }}
+
+      </div>
+    </div>
+
+  </main>
+
+</body>
+
+</html>
+`
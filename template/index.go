@@ -0,0 +1,43 @@
+package template
+
+// IndexContent is the template used for the gowitness report index. It
+// is rewritten as pages are generated, refreshing itself until done.
+var IndexContent = `
+<!doctype html>
+<html lang="en">
+
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1, shrink-to-fit=no">
+  <meta name="author" content="Leon Jacobs @leonjza">
+  {{ if lt .Written .PageCount }}<meta http-equiv="refresh" content="5">{{ end }}
+
+  <title>gowitness - Report</title>
+
+  <!-- Bootstrap core CSS -->
+  <link href="https://maxcdn.bootstrapcdn.com/bootstrap/4.0.0-beta.2/css/bootstrap.min.css" rel="stylesheet">
+</head>
+
+<body>
+  <div class="navbar navbar-dark bg-dark">
+    <div class="container d-flex justify-content-between">
+      <a href="#" class="navbar-brand">gowitness report</a>
+    </div>
+  </div>
+  <main role="main">
+    <div class="container py-3">
+      <h3>
+        {{ .EntryCount }} screenshot(s) on {{ .PageCount }} page(s)
+        {{ if lt .Written .PageCount }}<small class="text-muted">{{ .Written }} page(s) generated so far</small>{{ end }}
+      </h3>
+      <ul>
+        {{ range $page := .Pages }}
+        <li><a href="{{ $page.File }}">Page {{ $page.Number }}</a></li>
+        {{ end }}
+      </ul>
+    </div>
+  </main>
+</body>
+
+</html>
+`
@@ -0,0 +1,260 @@
+package template
+
+// ServerContent is the template used for the gowitness server index
+var ServerContent = `
+<!doctype html>
+<html lang="en">
+
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1, shrink-to-fit=no">
+  <meta name="author" content="Leon Jacobs @leonjza">
+
+  <title>gowitness</title>
+
+  <!-- Bootstrap core CSS -->
+  <link href="https://maxcdn.bootstrapcdn.com/bootstrap/4.0.0-beta.2/css/bootstrap.min.css" rel="stylesheet">
+
+  <style>
+    .album {
+      padding-top: 3rem;
+      padding-bottom: 3rem;
+      background-color: #f7f7f7;
+    }
+
+    .thumbnail {
+      width: 240px;
+      border: 1px solid #ccc;
+    }
+
+    tr.triage-selected {
+      outline: 2px solid #007bff;
+      background-color: #fff;
+    }
+
+    tr.triage-reviewed {
+      opacity: .5;
+    }
+
+    kbd {
+      font-size: 75%;
+    }
+
+    /* titles may be in any script or direction */
+    .page-title {
+      unicode-bidi: plaintext;
+      overflow-wrap: anywhere;
+      font-family: -apple-system, "Segoe UI", Roboto, "Helvetica Neue", Arial, "Noto Sans", "Noto Sans Arabic", "Noto Sans Hebrew", "Noto Sans CJK SC", "PingFang SC", "Hiragino Sans", "Microsoft YaHei", "Malgun Gothic", sans-serif;
+    }
+  </style>
+</head>
+
+<body>
+
+  <header>
+    <div class="navbar navbar-dark bg-dark">
+      <div class="container d-flex justify-content-between">
+        <a href="/" class="navbar-brand">gowitness</a>
+      </div>
+    </div>
+  </header>
+
+  <main role="main">
+
+    <div class="container">
+      <form class="form-inline my-3" method="get" action="/">
+        <input class="form-control mr-2" type="text" name="status" placeholder="Status, eg: 200,403" value="{{ html .Status }}">
+        <input class="form-control mr-2" type="text" name="technology" placeholder="Technology" value="{{ html .Technology }}">
+        <input class="form-control mr-2" type="text" name="lang" placeholder="Language" value="{{ html .Lang }}">
+        <button class="btn btn-primary mr-2" type="submit">Filter</button>
+        <a class="btn btn-light" href="/">Clear</a>
+      </form>
+
+      <h3 class="jumbotron-heading">{{ len .Entries }} matching entries</h3>
+      <p>
+        Export this view:
+        <a href="/export?format=json&amp;{{ html .Query }}">JSON</a> &#8226;
+        <a href="/export?format=csv&amp;{{ html .Query }}">CSV</a> &#8226;
+        <a href="/export?format=zip&amp;{{ html .Query }}">zip</a> &#8226;
+        <a href="/export?format=zip&amp;screenshots=true&amp;{{ html .Query }}">zip with screenshots</a> &#8226;
+        <a href="/api/screenshots.zip?{{ html .Query }}">screenshots only</a>
+      </p>
+      <p class="small">
+        Triage: <kbd>j</kbd>/<kbd>k</kbd> next/previous &#8226; <kbd>t</kbd> tag &#8226; <kbd>n</kbd> note &#8226;
+        <kbd>x</kbd> toggle reviewed &#8226; <kbd>/</kbd> search &#8226; <kbd>Esc</kbd> leave search
+      </p>
+      <input id="triage-search" class="form-control form-control-sm mb-2" type="search" placeholder="Search this view">
+    </div>
+
+    <div class="album text-muted">
+      <div class="container">
+        <table class="table table-sm">
+          <thead>
+            <tr>
+              <th scope="col">Screenshot</th>
+              <th scope="col">URL</th>
+              <th scope="col">Status</th>
+              <th scope="col">Title</th>
+            </tr>
+          </thead>
+          <tbody>
+            {{ range $entry := .Entries }}
+            <tr class="triage-entry{{ if $entry.Review }}{{ if $entry.Review.Reviewed }} triage-reviewed{{ end }}{{ end }}" data-key="{{ html $entry.Key }}">
+              <td>{{ if $entry.Screenshot }}<a href="{{ $entry.Screenshot }}" target="_blank"><img class="thumbnail" src="{{ $entry.Screenshot }}" alt="{{ html $entry.URL }}"></a>{{ end }}</td>
+              <td>
+                <a href="{{ html $entry.FinalURL }}" target="_blank" rel="noopener noreferrer">{{ html $entry.URL }}</a>
+                <div>
+                  {{ range $technology := $entry.Technologies }}<span class="badge badge-secondary">{{ $technology }}</span> {{ end }}
+                </div>
+                <div class="triage-tags">{{ if $entry.Review }}{{ range $tag := $entry.Review.Tags }}<span class="badge badge-primary">{{ html $tag }}</span> {{ end }}{{ end }}</div>
+                <div class="triage-note small">{{ if $entry.Review }}{{ html $entry.Review.Note }}{{ end }}</div>
+              </td>
+              <td>{{ if $entry.ResponseCodeString }}{{ html $entry.ResponseCodeString }}{{ else }}{{ html $entry.Error }}{{ end }}</td>
+              <td class="page-title" dir="auto"{{ if $entry.Lang }} lang="{{ html $entry.Lang }}"{{ end }}>{{ html $entry.PageTitle }}</td>
+            </tr>
+            {{ end }}
+          </tbody>
+        </table>
+      </div>
+    </div>
+
+  </main>
+
+  <script>
+    (function() {
+
+      var rows = Array.prototype.slice.call(document.querySelectorAll("tr.triage-entry"));
+      var search = document.getElementById("triage-search");
+      var selected = -1;
+
+      function visible() {
+        return rows.filter(function(row) { return row.style.display !== "none"; });
+      }
+
+      function select(row) {
+        rows.forEach(function(other) { other.classList.remove("triage-selected"); });
+        if (!row) {
+          selected = -1;
+          return;
+        }
+        row.classList.add("triage-selected");
+        row.scrollIntoView({block: "center"});
+        selected = rows.indexOf(row);
+      }
+
+      function move(step) {
+        var shown = visible();
+        if (shown.length === 0) {
+          return;
+        }
+        var position = shown.indexOf(rows[selected]);
+        position = position < 0 ? 0 : Math.min(Math.max(position + step, 0), shown.length - 1);
+        select(shown[position]);
+      }
+
+      function review(row, callback) {
+        var request = new XMLHttpRequest();
+        request.open("GET", "/review/" + row.dataset.key);
+        request.onload = function() {
+          if (request.status === 200) {
+            callback(JSON.parse(request.responseText));
+          }
+        };
+        request.send();
+      }
+
+      function save(row, state) {
+        var request = new XMLHttpRequest();
+        request.open("POST", "/review/" + row.dataset.key);
+        request.setRequestHeader("Content-Type", "application/json");
+        request.onload = function() {
+          if (request.status !== 200) {
+            alert("Failed to save the review: " + request.responseText);
+            return;
+          }
+          render(row, JSON.parse(request.responseText));
+        };
+        request.send(JSON.stringify(state));
+      }
+
+      function render(row, state) {
+        row.classList.toggle("triage-reviewed", state.reviewed);
+        var tags = row.querySelector(".triage-tags");
+        tags.textContent = "";
+        (state.tags || []).forEach(function(tag) {
+          var badge = document.createElement("span");
+          badge.className = "badge badge-primary mr-1";
+          badge.textContent = tag;
+          tags.appendChild(badge);
+        });
+        row.querySelector(".triage-note").textContent = state.note || "";
+      }
+
+      search.addEventListener("input", function() {
+        var terms = search.value.toLowerCase();
+        rows.forEach(function(row) {
+          row.style.display = row.textContent.toLowerCase().indexOf(terms) === -1 ? "none" : "";
+        });
+        if (selected >= 0 && rows[selected].style.display === "none") {
+          select(null);
+        }
+      });
+
+      document.addEventListener("keydown", function(event) {
+
+        if (event.target === search) {
+          if (event.key === "Escape" || event.key === "Enter") {
+            search.blur();
+          }
+          return;
+        }
+
+        if (event.target.tagName === "INPUT" || event.ctrlKey || event.metaKey || event.altKey) {
+          return;
+        }
+
+        var row = rows[selected];
+        switch (event.key) {
+          case "j": move(1); break;
+          case "k": move(-1); break;
+          case "/": event.preventDefault(); search.focus(); break;
+          case "t":
+            if (!row) { return; }
+            review(row, function(state) {
+              var tag = prompt("Tag (prefix with - to remove)");
+              if (!tag) { return; }
+              state.tags = state.tags || [];
+              if (tag.charAt(0) === "-") {
+                state.tags = state.tags.filter(function(existing) { return existing !== tag.substring(1); });
+              } else {
+                state.tags.push(tag);
+              }
+              save(row, state);
+            });
+            break;
+          case "n":
+            if (!row) { return; }
+            review(row, function(state) {
+              var note = prompt("Note", state.note || "");
+              if (note === null) { return; }
+              state.note = note;
+              save(row, state);
+            });
+            break;
+          case "x":
+            if (!row) { return; }
+            review(row, function(state) {
+              state.reviewed = !state.reviewed;
+              save(row, state);
+              if (state.reviewed) { move(1); }
+            });
+            break;
+        }
+      });
+    })();
+  </script>
+
+</body>
+
+</html>
+`
@@ -0,0 +1,88 @@
+package template
+
+// SitemapContent is the template of the sitemap report, showing the
+// captured URLs as a tree of their hosts and paths
+var SitemapContent = `
+{{ define "node" }}
+<details{{ if le .Count 20 }} open{{ end }}>
+  <summary>{{ html .Name }} <span class="badge badge-secondary">{{ .Count }}</span></summary>
+  <ul class="sitemap">
+    {{ range $entry := .Entries }}
+    <li class="sitemap-entry">
+      {{ if $entry.ScreenshotFile }}<a href="{{ $entry.ScreenshotFile }}" target="_blank" rel="noopener noreferrer">{{ html $entry.URL }}</a>{{ else }}{{ html $entry.URL }}{{ end }}
+      <small>{{ $entry.ResponseCode }}</small>
+      <small class="text-muted" dir="auto">{{ html $entry.PageTitle }}</small>
+    </li>
+    {{ end }}
+    {{ range $child := .Children }}
+    <li>{{ template "node" $child }}</li>
+    {{ end }}
+  </ul>
+</details>
+{{ end }}
+<!doctype html>
+<html lang="en">
+
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1, shrink-to-fit=no">
+  <meta name="author" content="Leon Jacobs @leonjza">
+
+  <title>gowitness - Sitemap</title>
+
+  <!-- Bootstrap core CSS -->
+  <link href="https://maxcdn.bootstrapcdn.com/bootstrap/4.0.0-beta.2/css/bootstrap.min.css" rel="stylesheet">
+
+  <style>
+    .album {
+      padding-top: 3rem;
+      padding-bottom: 3rem;
+      background-color: #f7f7f7;
+    }
+
+    summary {
+      cursor: pointer;
+    }
+
+    ul.sitemap {
+      list-style: none;
+      padding-left: 1.5rem;
+      border-left: 1px dotted #ccc;
+    }
+
+    .sitemap-entry {
+      overflow-wrap: anywhere;
+    }
+  </style>
+</head>
+
+<body>
+
+  <header>
+    <div class="navbar navbar-dark bg-dark">
+      <div class="container d-flex justify-content-between">
+        <a href="page-0.html" class="navbar-brand">gowitness report</a>
+      </div>
+    </div>
+  </header>
+
+  <main role="main">
+
+    <div class="container">
+      <h3 class="jumbotron-heading">{{ len .Hosts }} host(s) captured</h3>
+    </div>
+
+    <div class="album text-muted">
+      <div class="container">
+        {{ range $host := .Hosts }}
+        {{ template "node" $host }}
+        {{ end }}
+      </div>
+    </div>
+
+  </main>
+
+</body>
+
+</html>
+`
@@ -12,7 +12,7 @@ var HTMLContent = `
   <meta name="author" content="Leon Jacobs @leonjza">
   <link rel="icon" href="favicon.ico">
 
-  <title>gowitness - Page {{ .PageNumber }}</title>
+  <title>gowitness - Page {{ .PagePosition }}</title>
 
   <!-- Bootstrap core CSS -->
   <link href="https://maxcdn.bootstrapcdn.com/bootstrap/4.0.0-beta.2/css/bootstrap.min.css" rel="stylesheet">
@@ -77,6 +77,54 @@ var HTMLContent = `
       margin-bottom: .25rem;
     }
 
+    .structured-body {
+      max-height: 20rem;
+      overflow: auto;
+      font-size: 75%;
+      background-color: #fff;
+    }
+
+    .report-group {
+      margin-top: 1.5rem;
+    }
+
+    .report-group-favicon {
+      width: 32px;
+      height: 32px;
+      margin-right: .5rem;
+    }
+
+    /* blurring hides what screenshots show while sharing a screen */
+    body.blurred .lightbox-link img,
+    body.blurred #lightbox-image.unblurred {
+      filter: blur(8px);
+    }
+
+    /* tall screenshots keep their width and scroll instead */
+    .mobile-strip {
+      width: 240px;
+      max-height: 32rem;
+      margin: 0 auto .75rem;
+      overflow-y: auto;
+      border: 1px solid #ddd;
+    }
+
+    .mobile-strip img {
+      display: block;
+    }
+
+    .page-jump {
+      width: 8rem;
+      margin-left: .5rem;
+    }
+
+    /* titles may be in any script or direction */
+    .page-title {
+      unicode-bidi: plaintext;
+      overflow-wrap: anywhere;
+      font-family: -apple-system, "Segoe UI", Roboto, "Helvetica Neue", Arial, "Noto Sans", "Noto Sans Arabic", "Noto Sans Hebrew", "Noto Sans CJK SC", "PingFang SC", "Hiragino Sans", "Microsoft YaHei", "Malgun Gothic", sans-serif;
+    }
+
     .page-number {
       line-height: 1em;
       display: inline-block;
@@ -85,6 +133,56 @@ var HTMLContent = `
       margin: 2px;
     }
 
+    .current-page {
+      font-weight: bold;
+    }
+
+    .page-gap {
+      display: inline-block;
+      padding: 2px;
+      margin: 2px;
+      color: #6c757d;
+    }
+
+
+    .lightbox {
+      display: none;
+      position: fixed;
+      top: 0;
+      left: 0;
+      width: 100%;
+      height: 100%;
+      z-index: 1050;
+      background-color: rgba(0, 0, 0, .85);
+      overflow: auto;
+      text-align: center;
+    }
+
+    .lightbox.open {
+      display: block;
+    }
+
+    .lightbox img {
+      max-width: 95%;
+      margin: 2.5rem auto 1rem;
+    }
+
+    .lightbox-caption {
+      color: #fff;
+      position: fixed;
+      top: .5rem;
+      left: 0;
+      width: 100%;
+    }
+
+    .lightbox-control {
+      position: fixed;
+      top: 50%;
+      color: #fff;
+      font-size: 3rem;
+      cursor: pointer;
+      user-select: none;
+    }
   </style>
 </head>
 
@@ -123,7 +221,7 @@ var HTMLContent = `
   <main role="main">
 
       <div class="container">
-        <h3 class="jumbotron-heading">This gowitness report contains {{ .PageCount }} screenshot(s)! ({{ .ErrorsIgnored }} errors ignored)</h3>
+        <h3 class="jumbotron-heading">This gowitness report contains {{ .EntryCount }} screenshot(s)! ({{ .ErrorsIgnored }} errors ignored{{ if .ErrorsReport }}, <a href="errors.html">view errors</a>{{ end }}{{ if .FilmstripReport }}, <a href="filmstrip.html">view filmstrip</a>{{ end }}{{ if .SitemapReport }}, <a href="sitemap.html">view sitemap</a>{{ end }}{{ if .CoverageReport }}, <a href="coverage.html">view coverage</a>{{ end }})</h3>
       </div>
 
     <div class="album text-muted">
@@ -134,15 +232,99 @@ var HTMLContent = `
           document.onkeydown = checkKey;
           function checkKey(e) {
               e = e || window.event;
+              if (e.target && e.target.tagName == "INPUT") { return; }
+              if (lightboxKey(e)) { return; }
               if (e.keyCode == "37") { document.getElementById("prev-page").click();}
               else if (e.keyCode == "39") { document.getElementById("next-page").click();}
           }
+          // the lightbox steps through the full size screenshots on this page
+          var lightboxIndex = -1;
+          function lightboxLinks() { return document.querySelectorAll("a.lightbox-link"); }
+          function showLightbox(index) {
+              var links = lightboxLinks();
+              if (links.length == 0) { return; }
+              lightboxIndex = (index + links.length) % links.length;
+              var image = document.getElementById("lightbox-image");
+              image.src = links[lightboxIndex].href;
+              image.className = links[lightboxIndex].getAttribute("data-blurred") ? "" : "unblurred";
+              document.getElementById("lightbox-caption").textContent =
+                  (lightboxIndex + 1) + " of " + links.length + ": " + links[lightboxIndex].getAttribute("data-url");
+              document.getElementById("lightbox").className = "lightbox open";
+          }
+          function closeLightbox() {
+              lightboxIndex = -1;
+              document.getElementById("lightbox").className = "lightbox";
+          }
+          function openLightbox(e, link) {
+              if (e.ctrlKey || e.metaKey || e.shiftKey || e.button != 0) { return true; }
+              var links = lightboxLinks();
+              for (var i = 0; i < links.length; i++) {
+                  if (links[i] == link) { showLightbox(i); }
+              }
+              return false;
+          }
+          function lightboxKey(e) {
+              if (lightboxIndex < 0) { return false; }
+              if (e.keyCode == "37") { showLightbox(lightboxIndex - 1); }
+              else if (e.keyCode == "39") { showLightbox(lightboxIndex + 1); }
+              else if (e.keyCode == "27") { closeLightbox(); }
+              return true;
+          }
+          // blurred screenshots are linked instead of the originals,
+          // which are blurred in place when there is no blurred copy
+          function setBlurred(blurred) {
+              document.body.className = blurred ? "blurred" : "";
+              var links = lightboxLinks();
+              for (var i = 0; i < links.length; i++) {
+                  var copy = links[i].getAttribute("data-blurred");
+                  if (copy) { links[i].href = blurred ? copy : links[i].getAttribute("data-original"); }
+              }
+              document.getElementById("blur-toggle").textContent = blurred ? "Unblur screenshots" : "Blur screenshots";
+              try { sessionStorage.setItem("gowitness-blurred", blurred ? "1" : ""); } catch (e) {}
+          }
+          function toggleBlur() { setBlurred(document.body.className != "blurred"); }
+          window.addEventListener("load", function() {
+              try { if (sessionStorage.getItem("gowitness-blurred")) { setBlurred(true); } } catch (e) {}
+          });
+          function jumpToPage(e, input) {
+              if (e.keyCode != "13") { return; }
+              var page = parseInt(input.value, 10);
+              if (isNaN(page) || page < 1 || page > {{ .PageCount }}) { input.value = ""; return; }
+              window.location.href = "page-" + (page - 1) + ".html";
+          }
         </script>
 
+        <div id="lightbox" class="lightbox" onclick="if (event.target == this) { closeLightbox(); }">
+          <div id="lightbox-caption" class="lightbox-caption"></div>
+          <span class="lightbox-control" style="left: 1rem;" onclick="showLightbox(lightboxIndex - 1)">&lsaquo;</span>
+          <img id="lightbox-image" onclick="closeLightbox()">
+          <span class="lightbox-control" style="right: 1rem;" onclick="showLightbox(lightboxIndex + 1)">&rsaquo;</span>
+        </div>
+        <div class="page-navigation">
+          <span class="page-position">Page {{ .PagePosition }} of {{ .PageCount }}</span>
+          <input type="number" class="page-jump" min="1" max="{{ .PageCount }}" placeholder="Go to page" onkeydown="jumpToPage(event, this)">
+          <button type="button" id="blur-toggle" class="btn btn-sm btn-outline-secondary" onclick="toggleBlur()">Blur screenshots</button>
+        </div>
         {{ .PagePrev }}
         {{ .PageIndex }}
         {{ .PageNext }}
-        {{ range $screenshot := .ScreenShots }}
+        {{ if .Legend }}
+        <details class="report-legend container py-2">
+          <summary>Legend</summary>
+          <ul class="list-unstyled">
+            {{ range $item := .Legend }}
+            <li><span class="badge {{ $item.Class }}">{{ $item.Label }}</span> {{ $item.Description }}</li>
+            {{ end }}
+          </ul>
+        </details>
+        {{ end }}
+        {{ range $index, $screenshot := .ScreenShots }}
+        {{ with index $.Groups $index }}
+        <h4 class="report-group">
+          {{ if .Favicon }}<img src="{{ .Favicon }}" class="report-group-favicon">{{ end }}
+          {{ html .Heading }} <small class="text-muted">{{ .Count }} entries{{ if .Continued }}, continued{{ end }}</small>
+        </h4>
+        {{ end }}
 
         <div class="row">
 
@@ -151,24 +333,98 @@ var HTMLContent = `
               <div class="card">
                 <div class="row ">
                   <div class="col-md-4">
-                    <a href="{{ $screenshot.ScreenshotFile }}" target="_blank" rel="noopener noreferrer">
-                      <img src="{{ $screenshot.ScreenshotFile }}" class="w-100">
+                    {{ if $screenshot.StructuredBody }}
+                    <span class="badge badge-dark">{{ html $screenshot.ContentType }}</span>
+                    <pre class="structured-body">{{ html $screenshot.StructuredBody }}</pre>
+                    {{ else if $screenshot.TextOnly }}
+                    <span class="badge badge-dark" title="{{ if eq $screenshot.TextOnly "size" }}the page was larger than --text-fallback-size{{ else }}the capture timed out{{ end }}">text only</span>
+                    <pre class="structured-body">{{ html $screenshot.DOMText }}</pre>
+                    {{ else }}
+                    {{ if $.MobileStrip }}<div class="mobile-strip">{{ end }}
+                    <a href="{{ $screenshot.ScreenshotFile }}" target="{{ $.LinkTarget }}" rel="noopener noreferrer" class="lightbox-link"
+                      data-url="{{ $screenshot.URL }}" data-original="{{ $screenshot.ScreenshotFile }}"{{ if $screenshot.BlurredFile }} data-blurred="{{ $screenshot.BlurredFile }}"{{ end }} onclick="return openLightbox(event, this)">
+                      <img src="{{ if and $screenshot.HeroFile (not $.MobileStrip) }}{{ $screenshot.HeroFile }}{{ else }}{{ $screenshot.ScreenshotFile }}{{ end }}" class="w-100">
                     </a>
+                    {{ if $.MobileStrip }}</div>{{ end }}
+                    {{ if $screenshot.TileFiles }}
+                    <details class="tiles">
+                      <summary><small>{{ len $screenshot.TileFiles }} more tile(s) down the page</small></summary>
+                      {{ range $tile := $screenshot.TileFiles }}
+                      <a href="{{ $tile }}" target="{{ $.LinkTarget }}" rel="noopener noreferrer"><img src="{{ $tile }}" class="w-100 border-top" loading="lazy"></a>
+                      {{ end }}
+                    </details>
+                    {{ end }}
+                    {{ if $screenshot.BeforeDismissFile }}<small><a href="{{ $screenshot.BeforeDismissFile }}" target="{{ $.LinkTarget }}" rel="noopener noreferrer">before dismissal</a> &middot;</small>{{ end }}
+                    {{ if $screenshot.CaptureLog }}<small><a href="{{ $screenshot.CaptureLog }}" target="_blank" rel="noopener noreferrer">capture log</a> &middot;</small>{{ end }}
+                    {{ if $screenshot.ImageWidth }}<small class="text-muted">{{ $screenshot.ImageWidth }}&times;{{ $screenshot.ImageHeight }}</small>{{ end }}
+                    {{ if $screenshot.DOMNodes }}<small class="text-muted">&middot; {{ $screenshot.DOMNodes }} DOM nodes &middot; {{ $screenshot.TransferredBytes }} bytes transferred</small>{{ end }}
+                    {{ end }}
                   </div>
                   <div class="col-md-8 px-3">
                     <div class="card-block px-3">
                       <h4 class="card-title">
                         <a href="{{ $screenshot.FinalURL }}" target="_blank" rel="noopener noreferrer">{{ $screenshot.URL}}</a>
                         <small>{{ $screenshot.ResponseCodeString }}</small>
+                        {{ if $screenshot.Repeat }}<span class="badge badge-info">capture #{{ $screenshot.Repeat }}</span>{{ end }}
+                        {{ if $screenshot.Path }}<span class="badge badge-primary">{{ $screenshot.Path }}</span>{{ end }}
+                        {{ range $tag := $screenshot.AutoTags }}<span class="badge badge-success" title="tagged by a --tag-rules rule">{{ html $tag }}</span> {{ end }}
+                        {{ if $screenshot.AppendedQuery }}<span class="badge badge-light" title="added with --append-query">+?{{ html $screenshot.AppendedQuery }}</span>{{ end }}
+                        {{ if $screenshot.Source }}<span class="badge badge-light" title="{{ html $screenshot.Source.Org }}{{ range $screenshot.Source.Hostnames }} {{ html . }}{{ end }}">{{ html $screenshot.Source.Provider }}{{ if $screenshot.Source.Product }}: {{ html $screenshot.Source.Product }}{{ end }}</span>{{ end }}
+                        {{ if $screenshot.DirectoryListing }}<span class="badge badge-danger" title="the server lists the files of this directory">directory listing</span>{{ end }}
+                        {{ with $screenshot.Favicon }}{{ if .DefaultApp }}<span class="badge badge-warning" title="the page uses the favicon this application ships with">default {{ .DefaultApp }} favicon</span>{{ end }}{{ end }}
+                        {{ if eq $screenshot.Engine "firefox" }}<span class="badge badge-light">firefox</span>{{ end }}
+                        {{ if $screenshot.AuthScheme }}<span class="badge badge-info">{{ $screenshot.AuthScheme }} authenticated</span>{{ end }}
+                        {{ with $screenshot.AllowedMethods }}<span class="badge {{ if .Dangerous }}badge-danger{{ else }}badge-light{{ end }}" title="allowed methods{{ if eq .Source "405" }} named by the 405 response{{ else }} answering OPTIONS{{ end }}: {{ range $i, $method := .Methods }}{{ if $i }}, {{ end }}{{ html $method }}{{ end }}">allows {{ if .Dangerous }}{{ range $i, $method := .Dangerous }}{{ if $i }}, {{ end }}{{ $method }}{{ end }}{{ else }}{{ range $i, $method := .Methods }}{{ if $i }}, {{ end }}{{ html $method }}{{ end }}{{ end }}</span>{{ end }}
+                        {{ range $method := $screenshot.AuthRequired }}<span class="badge badge-info" title="WWW-Authenticate{{ if $method.Realm }} realm {{ html $method.Realm }}{{ end }}">auth required: {{ html $method.Scheme }}{{ if $method.Realm }} &ldquo;{{ html $method.Realm }}&rdquo;{{ end }}</span> {{ end }}
+                        {{ if $screenshot.LoginRedirect }}<span class="badge badge-warning" title="{{ html $screenshot.LoginRedirectFrom }} redirected to a login page">auth gated, bounced to login</span>{{ end }}
+                        {{ if $screenshot.PinnedAddress }}<span class="badge badge-light" title="connected to this address instead of resolving the host">pinned to {{ $screenshot.PinnedAddress }}</span>{{ end }}
+                        {{ if $screenshot.Downgraded }}<span class="badge badge-warning">downgraded to http</span>{{ end }}
+                        {{ if $screenshot.MetadataOnly }}<span class="badge badge-light" title="the status was not one of --screenshot-statuses">no screenshot</span>{{ end }}
+                        {{ if $screenshot.HTTP10 }}<span class="badge badge-light" title="requested over HTTP/1.0, answered with {{ $screenshot.Protocol }}">HTTP/1.0</span>{{ end }}
+                        {{ if $screenshot.ChromeCrashes }}<span class="badge badge-light" title="Chrome crashed {{ $screenshot.ChromeCrashes }} time(s) while capturing">chrome crashed</span>{{ end }}
+                        {{ if $screenshot.CrawlDepth }}<span class="badge badge-light" title="found by following links from the input URLs with --crawl-depth">crawled (depth {{ $screenshot.CrawlDepth }})</span>{{ end }}
+                        {{ if $screenshot.ProbedScheme }}<span class="badge badge-light" title="listed without a scheme, this is the one the host answered on">probed {{ $screenshot.ProbedScheme }}</span>{{ end }}
+                        {{ if $screenshot.MixedContent }}<span class="badge badge-danger">mixed content</span>{{ end }}
+                        {{ with $screenshot.CharsetMismatch }}<span class="badge badge-warning" title="{{ if .Header }}header {{ html .Header }} {{ end }}{{ if .Meta }}meta {{ html .Meta }} {{ end }}{{ if .Detected }}body looks like {{ .Detected }}{{ end }}">charset mismatch</span>{{ end }}
+                        {{ with $screenshot.SniffMismatch }}<span class="badge {{ if .NoSniff }}badge-warning{{ else }}badge-danger{{ end }}" title="declared {{ if .Declared }}{{ html .Declared }}{{ else }}no Content-Type{{ end }}, body sniffs as {{ .Sniffed }}{{ if .NoSniff }}, sniffing disabled by nosniff{{ end }}">content-type mismatch</span>{{ end }}
+                        {{ if $screenshot.JSExceptions }}<span class="badge badge-warning">{{ len $screenshot.JSExceptions }} js error(s)</span>{{ end }}
+                        {{ with $screenshot.Challenge }}{{ if .Solved }}<span class="badge badge-light" title="solved after {{ .Waited }}{{ if .Reloads }} and {{ .Reloads }} reload(s){{ end }}">{{ .Provider }} challenge passed</span>{{ else }}<span class="badge badge-warning" title="{{ if .Waited }}not solved after {{ .Waited }}{{ else }}not waited on, see --challenge-wait{{ end }}">{{ .Provider }} challenge</span>{{ end }}{{ end }}
+                        {{ with $screenshot.AXTree }}{{ if .Unlabeled }}<span class="badge badge-warning" title="{{ .UnlabeledButtons }} button(s), {{ .UnlabeledImages }} image(s), {{ .UnlabeledLinks }} link(s) and {{ .UnlabeledFields }} form field(s) have no accessible name">{{ .Unlabeled }} unlabeled (a11y)</span>{{ end }}{{ end }}
+                        {{ if $screenshot.LinkedHosts }}<span class="badge badge-info" title="{{ range $screenshot.LinkedHosts }}{{ html . }} {{ end }}">links to other hosts</span>{{ end }}
+                        {{ range $version := $screenshot.SSL.Versions }}{{ if and $version.Accepted $version.Weak }}<span class="badge badge-danger">weak protocol {{ $version.Version }}</span>{{ end }}{{ end }}
+                        {{ if and $screenshot.SSL.Validity (ne $screenshot.SSL.Validity "valid") }}<span class="badge badge-danger">certificate {{ $screenshot.SSL.Validity }}</span>{{ end }}
+                        {{ if $screenshot.SSL.ChainIncomplete }}<span class="badge badge-danger">incomplete chain</span>{{ end }}
+                        {{ if $screenshot.DialogDismissed }}<span class="badge badge-info">dialog dismissed</span>{{ end }}
+                        {{ if $screenshot.Clicked }}<span class="badge badge-info" title="{{ range $screenshot.Clicked }}{{ . }} {{ end }}">clicked through</span>{{ end }}
+                        {{ if $screenshot.WaitTimedOut }}<span class="badge badge-warning">wait timed out</span>{{ end }}
+                        {{ if $screenshot.ScrollIterations }}<span class="badge badge-light">scrolled {{ $screenshot.ScrollIterations }}x</span>{{ end }}
+                        {{ if $screenshot.Accept }}<span class="badge badge-light" title="the Accept header sent, choosing the representation captured">accept {{ html $screenshot.Accept }}</span>{{ end }}
+                        {{ if $screenshot.Throttle }}<span class="badge badge-light" title="captured under emulated network conditions">throttled {{ $screenshot.Throttle }}</span>{{ end }}
+                        {{ if $screenshot.Touch }}<span class="badge badge-light" title="captured with an emulated touch screen">touch</span>{{ end }}
+                        {{ if $screenshot.Mobile }}<span class="badge badge-light" title="captured emulating a mobile device">mobile</span>{{ end }}
+                        {{ if $screenshot.ScaleFactor }}<span class="badge badge-light" title="the device pixel ratio captured at">scale {{ $screenshot.ScaleFactor }}x</span>{{ end }}
+                        {{ if and $screenshot.Rendering (ne $screenshot.Rendering "off") }}<span class="badge badge-light" title="the --gpu rendering mode the page was captured with">gpu {{ $screenshot.Rendering }}</span>{{ end }}
+                        {{ if $screenshot.Background }}<span class="badge badge-light" title="pages were rendered against this background"><span style="display: inline-block; width: .8em; height: .8em; border: 1px solid #999; background-color: {{ $screenshot.Background }};"></span> {{ $screenshot.Background }}</span>{{ end }}
+                        {{ if $screenshot.ReducedMotion }}<span class="badge badge-light" title="animations and transitions were disabled">reduced motion</span>{{ end }}
+                        {{ if $screenshot.ClippedHeight }}<span class="badge badge-light">clipped to {{ $screenshot.ClippedHeight }}px</span>{{ end }}
                       </h4>
-                      <small>{{ $screenshot.PageTitle }}</small>
+                      {{ if $screenshot.DisplayTitle }}
+                      <small class="page-title" dir="auto"{{ if $screenshot.Lang }} lang="{{ html $screenshot.Lang }}"{{ end }} title="page title: {{ html $screenshot.PageTitle }}">{{ html $screenshot.DisplayTitle }}</small>
+                      {{ else }}
+                      <small class="page-title" dir="auto"{{ if $screenshot.Lang }} lang="{{ html $screenshot.Lang }}"{{ end }}>{{ html $screenshot.PageTitle }}</small>
+                      {{ end }}
+                      {{ with index $.Similar $screenshot.URL }}<small class="text-muted">&middot; and {{ . }} more with this {{ $.SimilarBy }}</small>{{ end }}
+                      <div>
+                        {{ if $screenshot.Lang }}<span class="badge badge-light">lang: {{ html $screenshot.Lang }}</span> {{ end }}
+                        {{ range $technology := $screenshot.Technologies }}<span class="badge badge-secondary">{{ $technology }}</span> {{ end }}
+                      </div>
                       <p class="card-text">
 
                         <!-- headers -->
                         <table class="table table-sm">
                           <thead>
                             <tr>
-                              <th scope="col">Header</th>
+                              <th scope="col">Header{{ if $screenshot.HeadersVerbatim }} <small class="text-muted">(verbatim)</small>{{ end }}</th>
                               <th scope="col">Value</th>
                             </tr>
                           </thead>
@@ -184,10 +440,155 @@ var HTMLContent = `
                               </td>
                             </tr>
                             {{ end }}
+                            {{ range $trailer := $screenshot.Trailers }}
+                            <tr>
+                              <td>{{ html $trailer.Key }} <span class="badge badge-light">trailer</span></td>
+                              <td>
+                                <span class="d-inline-block text-truncate" style="max-width: 450px;">
+                                  {{ html $trailer.Value }}
+                                </span>
+                              </td>
+                            </tr>
+                            {{ end }}
 
                           </tbody>
                         </table>
 
+                        <!-- request -->
+                        {{ if $screenshot.Request }}
+                        <details class="sent-request">
+                          <summary>Request sent</summary>
+                          <pre class="structured-body">{{ $screenshot.Request.Method }} {{ html $screenshot.Request.URL }}
+{{ range $header := $screenshot.Request.Headers }}{{ $header.Key }}: {{ html $header.Value }}
+{{ end }}</pre>
+                        </details>
+                        {{ end }}
+
+                        <!-- websockets -->
+                        {{ if $screenshot.WebSockets }}
+                        <details class="websockets">
+                          <summary>{{ len $screenshot.WebSockets }} WebSocket(s) opened</summary>
+                          <ul>
+                            {{ range $socket := $screenshot.WebSockets }}
+                            <li><span class="d-inline-block text-truncate" style="max-width: 450px;">{{ html $socket }}</span></li>
+                            {{ end }}
+                          </ul>
+                        </details>
+                        {{ end }}
+                        <!-- http/2 server push -->
+                        {{ if $screenshot.PushedResources }}
+                        <details class="pushed-resources">
+                          <summary>{{ len $screenshot.PushedResources }} resource(s) pushed over HTTP/2</summary>
+                          <ul>
+                            {{ range $resource := $screenshot.PushedResources }}
+                            <li><span class="d-inline-block text-truncate" style="max-width: 450px;">{{ html $resource }}</span></li>
+                            {{ end }}
+                          </ul>
+                        </details>
+                        {{ end }}
+                        <!-- certificate chain -->
+                        {{ if $screenshot.SSL.PeerCertificates }}
+                        <details class="certificate-chain">
+                          <summary>Certificate chain ({{ len $screenshot.SSL.PeerCertificates }})</summary>
+                          <table class="table table-sm">
+                            <tbody>
+                              {{ range $certificate := $screenshot.SSL.PeerCertificates }}
+                              <tr>
+                                <td><small>{{ if $certificate.Subject }}{{ html $certificate.Subject }}{{ else }}{{ html $certificate.SubjectCommonName }}{{ end }}</small></td>
+                                <td><small class="text-muted">issued by {{ if $certificate.Issuer }}{{ html $certificate.Issuer }}{{ else }}{{ html $certificate.IssuerCommonName }}{{ end }}</small></td>
+                              </tr>
+                              {{ end }}
+                            </tbody>
+                          </table>
+                        </details>
+                        {{ end }}
+                        <!-- tls versions -->
+                        {{ if $screenshot.SSL.Versions }}
+                        <details class="tls-versions">
+                          <summary>TLS versions</summary>
+                          <table class="table table-sm">
+                            <tbody>
+                              {{ range $version := $screenshot.SSL.Versions }}
+                              <tr>
+                                <td>{{ $version.Version }}</td>
+                                <td>{{ if $version.Accepted }}{{ $version.CipherSuite }}{{ else }}<span class="text-muted">not accepted</span>{{ end }}</td>
+                              </tr>
+                              {{ end }}
+                            </tbody>
+                          </table>
+                        </details>
+                        {{ end }}
+                        <!-- mixed content -->
+                        {{ if $screenshot.MixedContent }}
+                        <details class="mixed-content">
+                          <summary>{{ len $screenshot.MixedContent }} insecure subresource(s)</summary>
+                          <ul>
+                            {{ range $resource := $screenshot.MixedContent }}
+                            <li><span class="d-inline-block text-truncate" style="max-width: 450px;">{{ html $resource }}</span></li>
+                            {{ end }}
+                          </ul>
+                        </details>
+                        {{ end }}
+                        <!-- uncaught javascript exceptions -->
+                        {{ if $screenshot.JSExceptions }}
+                        <details class="js-exceptions">
+                          <summary>{{ len $screenshot.JSExceptions }} uncaught JavaScript exception(s)</summary>
+                          <ul>
+                            {{ range $exception := $screenshot.JSExceptions }}
+                            <li><span class="d-inline-block text-truncate" style="max-width: 450px;" title="{{ html $exception.URL }}:{{ $exception.Line }}:{{ $exception.Column }}">{{ html $exception.Message }}</span></li>
+                            {{ end }}
+                          </ul>
+                        </details>
+                        {{ end }}
+                        <!-- accessibility tree -->
+                        {{ with $screenshot.AXTree }}
+                        <details class="ax-tree">
+                          <summary>{{ .Total }} node accessibility tree{{ if .Unlabeled }}, {{ .Unlabeled }} unlabeled{{ end }}</summary>
+                          <p class="small mb-1">unlabeled: {{ .UnlabeledButtons }} button(s), {{ .UnlabeledImages }} image(s), {{ .UnlabeledLinks }} link(s), {{ .UnlabeledFields }} form field(s){{ if lt (len .Nodes) .Total }}, showing the first {{ len .Nodes }} nodes{{ end }}</p>
+                          <ul class="list-unstyled small">
+                            {{ range $node := .Nodes }}
+                            <li class="text-truncate" style="max-width: 450px; padding-left: {{ $node.Depth }}em;"><code>{{ html $node.Role }}</code>{{ if $node.Name }} {{ html $node.Name }}{{ end }}</li>
+                            {{ end }}
+                          </ul>
+                        </details>
+                        {{ end }}
+                        <!-- canonical and alternate links -->
+                        {{ if or $screenshot.Canonical $screenshot.Alternates }}
+                        <details class="page-links">
+                          <summary>canonical{{ if $screenshot.Alternates }} and {{ len $screenshot.Alternates }} alternate(s){{ end }}{{ if $screenshot.LinkedHosts }}, {{ len $screenshot.LinkedHosts }} other host(s){{ end }}</summary>
+                          <ul>
+                            {{ if $screenshot.Canonical }}<li><span class="d-inline-block text-truncate" style="max-width: 450px;">canonical: {{ html $screenshot.Canonical }}</span></li>{{ end }}
+                            {{ range $alternate := $screenshot.Alternates }}
+                            <li><span class="d-inline-block text-truncate" style="max-width: 450px;">{{ html $alternate.Lang }}: {{ html $alternate.URL }}</span></li>
+                            {{ end }}
+                          </ul>
+                        </details>
+                        {{ end }}
+                        <!-- redirects -->
+                        {{ if gt (len $screenshot.RedirectChain) 1 }}
+                        <details class="redirect-chain">
+                          <summary>{{ len $screenshot.RedirectChain }} hop redirect chain</summary>
+                          <table class="table table-sm">
+                            <thead>
+                              <tr>
+                                <th scope="col">Status</th>
+                                <th scope="col">URL</th>
+                                <th scope="col">Time</th>
+                              </tr>
+                            </thead>
+                            <tbody>
+                              {{ range $hop := $screenshot.RedirectChain }}
+                              <tr>
+                                <td>{{ $hop.StatusCode }}{{ if $hop.MetaRefresh }} <span class="badge badge-light">meta refresh</span>{{ end }}</td>
+                                <td><span class="d-inline-block text-truncate" style="max-width: 400px;">{{ html $hop.URL }}</span></td>
+                                <td>{{ $hop.Duration }}</td>
+                              </tr>
+                              {{ end }}
+                            </tbody>
+                          </table>
+                        </details>
+                        {{ end }}
+
                         <!-- ssl -->
                         <!--
                         <p class="h6">SSL DNS Names: </p>
@@ -242,4 +643,4 @@ var HTMLContent = `
 
 // PlaceHolderImage is the image used when a screenshot is missing
 var PlaceHolderImage = `
-data:image/gif;base64,iVBORw0KGgoAAAANSUhEUgAAAoAAAAHgCAYAAAA10dzkAAAAAXNSR0IArs4c6QAAAAlwSFlzAAALEwAACxMBAJqcGAAAA6ppVFh0WE1MOmNvbS5hZG9iZS54bXAAAAAAADx4OnhtcG1ldGEgeG1sbnM6eD0iYWRvYmU6bnM6bWV0YS8iIHg6eG1wdGs9IlhNUCBDb3JlIDUuNC4wIj4KICAgPHJkZjpSREYgeG1sbnM6cmRmPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5LzAyLzIyLXJkZi1zeW50YXgtbnMjIj4KICAgICAgPHJkZjpEZXNjcmlwdGlvbiByZGY6YWJvdXQ9IiIKICAgICAgICAgICAgeG1sbnM6eG1wPSJodHRwOi8vbnMuYWRvYmUuY29tL3hhcC8xLjAvIgogICAgICAgICAgICB4bWxuczp0aWZmPSJodHRwOi8vbnMuYWRvYmUuY29tL3RpZmYvMS4wLyIKICAgICAgICAgICAgeG1sbnM6ZXhpZj0iaHR0cDovL25zLmFkb2JlLmNvbS9leGlmLzEuMC8iPgogICAgICAgICA8eG1wOk1vZGlmeURhdGU+MjAxNy0xMS0xMVQxMjoxMTo3NDwveG1wOk1vZGlmeURhdGU+CiAgICAgICAgIDx4bXA6Q3JlYXRvclRvb2w+UGl4ZWxtYXRvciAzLjQuMTwveG1wOkNyZWF0b3JUb29sPgogICAgICAgICA8dGlmZjpPcmllbnRhdGlvbj4xPC90aWZmOk9yaWVudGF0aW9uPgogICAgICAgICA8dGlmZjpDb21wcmVzc2lvbj41PC90aWZmOkNvbXByZXNzaW9uPgogICAgICAgICA8dGlmZjpSZXNvbHV0aW9uVW5pdD4yPC90aWZmOlJlc29sdXRpb25Vbml0PgogICAgICAgICA8dGlmZjpZUmVzb2x1dGlvbj43MjwvdGlmZjpZUmVzb2x1dGlvbj4KICAgICAgICAgPHRpZmY6WFJlc29sdXRpb24+NzI8L3RpZmY6WFJlc29sdXRpb24+CiAgICAgICAgIDxleGlmOlBpeGVsWERpbWVuc2lvbj42NDA8L2V4aWY6UGl4ZWxYRGltZW5zaW9uPgogICAgICAgICA8ZXhpZjpDb2xvclNwYWNlPjE8L2V4aWY6Q29sb3JTcGFjZT4KICAgICAgICAgPGV4aWY6UGl4ZWxZRGltZW5zaW9uPjQ4MDwvZXhpZjpQaXhlbFlEaW1lbnNpb24+CiAgICAgIDwvcmRmOkRlc2NyaXB0aW9uPgogICA8L3JkZjpSREY+CjwveDp4bXBtZXRhPgpS3JvOAABAAElEQVR4Ae3dB5gsVZ034LqYc86YFRURXEFRxCzigphFBETWHGBXRcA14ZoTiAEQcwDTKuCa1gRmQUVUEMWcA2YwoSgfv/qs3uqqmanuudNzp899z/P07e7q01V13tNz+98n1bott9zy39etW/e482+bVRIBAgQIECBAgECxAuedd943z78dtm6rrbY6Q/BXbD0rGAECBAgQIEBgTCBB4CaCvzETTwgQIECAAAECRQsk9tuk6BIqHAECBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+h0r3SabbFJd/OIXH9vmCQECBAgQILDxCVxw4yvyxlHiC1/4wtVOO+1U3fSmN62ucY1rVJtuuml11atetbrQhS5UnXXWWdVPfvKT6qc//Wl9+/znP199+tOf3jhglHLNCGyzzTbVBS/4f/8FffnLX67+8pe/rJnzcyIrK7DZZptVl7/85Uc7/e53v1udeeaZo+elPNhYyllKfW3M5Vh385vf/LyNGaC0sl/mMpepdt1112q33XYb+892qJynn3569epXv7r6xCc+MZTV6wRWRODjH/94lc9rk+5973tXP/jBD5qn7gsTeOlLX1rd8Y53HJXqWc96VnXssceOnpfyYGMpZyn1tTGX4/9+fm/MCoWU/YEPfGD1+Mc/vrroRS86dYk233zz6tBDD62+/vWvV0996lOr733ve1PvwxsIEPj/Amnpuv71rz/iSKv7GWecMXruAQECBDa0gABwQ9fACh0/3b0HHnhgtW7dut4ezzvvvOq3v/1t9ctf/rLK43QJX+pSl+rly4ab3OQmVX7B7rnnntUf/vCHBfPYSIDA0gK3vOUtqxe84AWjTBlm8ahHPWr03AMCBAhsaAEB4IaugRU4/m1uc5vqv/7rv8aCv3/84x/ViSeeWB1zzDHVpz71qeqvf/3r2JESAG6xxRbV3nvvXd3qVrcae+3a17529ZznPKd6whOeUAeMYy96QoAAAQIECMy9gABwzqvwale7WvWSl7xkbDD93//+92q//fZbcjzf2WefXX3uc5+rbze72c3qVr8rXOEKI4073OEO1SMf+cjqyCOPHG3zgAABAssVyPCSTE5r0i9+8YvmYVH3G0s5i6q0jbQwAsA5r/j73Oc+vaVdMoZvmskcp556at3a99rXvnbsP+iHPvSh1VFHHVX98Y9/nHMlp0+AwIYWyCSzjSFtLOXcGOqy9DJaB3DOa/hf//Vfx0rws5/9rPrQhz40tm2SJwkCX/ziF49lza/1tARKBAgQIECAQFkCAsA5rs+soXb1q199rARZS2256YMf/GCV7uN2ymB2iQABAgQIEChLQBfwHNfnFa94xSpX92injD9ZbkpX72mnnVZttdVWo110A8zRCwMPsr5bFp/OOV7ykpesF3zNwtM///nPe0HmwK4mevkCF7hAdc1rXrM+Xpbg+PCHPzzR+y52sYtV173udasrXelK9Xn+6le/qjI2KefanTgz0Q4XyLQax2gf9nKXu1xtf5WrXKXu0v/9739fpWU4C++ub8qVZDLuNJ+LS1/60vXs8pj9+te/rh9n8tFKphwj9XPlK1+5Lsvvfve7FStLc55p6b7Oda5TZQxsPjtZjPo3v/nN6HPQ5Jv3+5QtfyP5XGS1gHzO85nIEjVDKX/LqfP8nTR1kM/Un//856G3rsjrs/7czXr/64Mwy7/n5rzy95XPRer3Ihe5SP3ZyJqc+buWyhUQAM5x3S70ZZv/5Ncnve997xsL0LJ0zKQpweh973vfascdd6xucYtb9ILT7Cfn/K1vfat6y1veUv3v//7v2LEWOk6Cx9e97nWjl7KW2jOe8YzR8wSa97vf/erFr/MfWFJaMYcCwG233bbKuonbbbdd/R/eaIf/fJAvtrSIvuMd76i++c1vdl+e6PksjhGLmCQlsMtEnSbd5S53qe5///vXs7q7PwySJ3X5tre9rXrTm95U10PzvqH7XD1mhx12qBcXz4ShxVICiuOOO66+JdBfn3T3u9+9uuc971mXJcF9Ny23LO39pO6z+PRtb3vb3jjaJl++BE844YR6LOxSX4aZTd8ejpHAtZ0y4z6fpXbK0jAHH3xwe9OyHy/1ucjfY/4uc+WV7ufib3/7W30VoLz/a1/72tjxU+/528p7b3jDG4691jxJGTLm7eSTT242LXr/pCc9qWr3KGSC2fHHH79o/ll/7ma1/2nKuVS9zervuQHPZ3SXXXapbze60Y2azaP7LBn2xS9+sf7cfuxjH6u33+52t6v22WefUZ63v/3tRS7mPSpg4Q9cCWSOKzj/mX/hC18Y+089LVf5D/ucc85Z1ZJd9rKXrZ7//OdXt771rSc+bi5H96pXvapK0LlYSoD38fOvGNGkdHH/27/9W/00X0pHHHFE3XLTvJ77BID5slso5T/9fffdt17ncKE1Exd6TwKml7/85RMHTbM8Riyaq2eklSpfEmlhTFCcoGmS9KUvfale7HuSIK1Zz26aHxYJ8jN5KOtJLpXaZUm+BGMJvA866KA6MF/qvc1r05SleU9aODJL/gEPeECzafA+1k9/+tOrz372swvmPeCAA6oHPehBC7622MYEPzmPlUhty+Zzkb/JWN6xdfWNxY6V1u5nP/vZo7/FtIjm7/nGN77xYm8Z257jpywL/ShtMk5zhYxZfu5yPrPc/zTlXKjeZvn33NRFfvxk6bD00EyS3v/+91fPe97zqjvd6U71EmHNe175yleO/UBvtrufD4Hx/sP5OGdn+U+B/GebyRvtlG6aXNEj1/1drZRWofwSnCb4y7llQep86eSLf9qUY+bXc3vpmqF9XOta16pbvx784AePrZk49L6HPOQh1eGHHz42Q3qx96zGMdrHTutYguBJg7+8N62zCdDyRbNU2nrrrevAd5rgL/vLD5O99tqrevjDH77U7nuvJXBOoJ0vp0nTpGVp9perc6Ts0wR/eW8M8mWXK+20r1/c7Het3V/iEpeoXvOa10wU/OXc0w3+zGc+s8oaoPkMv/nNb544+Mv7E2Su1ELXs/7czXr/8VhumuXfc3NO+ds87LDDJg7+8r6dd955LPBr9uV+vgV0Ac93/VXvete7xsbspTgJxN797nfXX1jpkpvlOJ073/nO1Qtf+MLel+KPf/zjugv129/+dpUxYuluyPij29/+9r3Wuac97Wn1GMHFWle6VZTxOi960YsWvZpJdyJL3p8WjaOPPrrX1ZcW1Ix7zNjJjIFMi0euhpLzbK9Zlu7ctADltlhajWN0j/24xz1urP7TIvaNb3yj7mZPHeSHQC7zl668dnkSOOea0WndXCilLK94xSvGLiuYLqGTTjqpXlj8Rz/6UZW1JNMamUA+XcTnX1d8bFc5t+SbdFb6Yx7zmKrdFbVSZWlOKl/8+eJLC2A75Yo36cpMF2g+BxlzlS7b/MjIfZPSYpwfA9n+iEc8Yqy1K+Ph2j/G0vqWz3uT8tnqjsGc1XWPE0Sk9e4GN7hBffi07n31q1+tTjnllCot6BlCkNbzdP81wyaSMe974hOfWJ93c6WgdBFnqEaGbeT8kyfvzeLz8WynBPxp0Vqfcciz/tzNev9tj+U8ntXfc3MuqfMs8N9N+SzmM5Jb/mbzf8a//Mu/1P+3NMMZ0vqXvw2pHAFdwHNel/lSespTnlKP/VqoKPnPP+NzcjWQLPz8/e9/f6Fsy9qWFpv3vOc99aSA9g4yvi/dIAkYFkrbb799fZmstFI0KV+QacXqXn5uoS7gfFHvsccezVvrgCfdabl+cb6k8h9YvrjaKS14+dJqUi6Nl4vR5wtroZQviryeL/t2SrdJguqF0mocI+fbdAGnBTj1n1sG8ud8m7E63fNLq05ahjOhokkxyCUEM+mhm7KWZMYTNin185//+Z/156jZ1r1Pt1rqvV2vCerzpbZQapel/fpyypJuz7RSLFSW7Dutkmml7o5lS5CZv5/FFiXOPvN6fnS0U1rL8tlfLGXc3WpeCm4xywwJ+Y//+I8qP8S6KT8C8ploB7ntPAkW00LfDVyTJ5+51OvDHvaw9luqQw45pB7fO7bxn08m6Rqd9edu1vtPUScpZ+PTrrdZ/j3nePl/I+Oa2y3/OWZ+wL/zne9sTmnsPp/7lKd7tagmky7gRmI+73UBz2e9jc46QVbGZiwWlKTVJ4FPxigde+yxVf7DSctOWjAyTi5B3HJTFqHOjNB2yhdKvgQWC/6S99Of/nQdTOQ/nyYlaMhg/KGUwKwZa5X3v/71r6/SpZvuro9+9KP1l1U3+EsrZTv4S4tkugBjsVhKoLz3+QP7uwHVox/96LGWtOb9q3GM5ljNfYKafBGfeeaZdWte91ybfLn/4Q9/WI8Ha5vn1/yWW27ZzjZ63B1DmUk1+RGxVEpragbAt1NaEabpMl1uWdJFu1hZcj6ZUNIN/vJDJX8HiwV/eV/GPu2+++71j4o8b1KCn4te9KLN0zV5n5a4XNN7oeAvJ5xJLWlJXyjl858u3YWCv+TP33e+/POjq50222yz9tOpH8/6czfr/U9d4NYbZvn3nMPkB107+MuPpbT4Lhb85T1/+tOf6jHTmQgllScgACygTvOfcX6ppwUqSzQslfIrMC1wj33sY+ugKUFQgrYERO3uoKX2kdfSjdYd4/Xf//3fi3YpdveXYKI7A3CSRafTtZb/KDPJJV/eCWbPPffc7u5Hz5sB/6MN5z9I99hSMzqbvAmWnvvc59bLmzTbYnSve92reVrfr8Yxxg7YeZJuzaWCmCZ7uii7szUXq/MsC9FO3/nOd9pPF32cLuL2zPF84SzWwrTQTmZRlpxDtxUyXZr53LcD4oXOJ9vSPdZuzcu2LJeRsVRrNeX/hFzPO628S6V8Jrp12/x/MskySN1W0G6QvdSxF3pt1p+7We9/oTJNu20WfwNpCGh+ODfnk+Efk1wxKp+DJz/5yYPfLc1+3c+PgABwfupqyTPNF1lawdKNmqAlv+AnSWniT+CVbq7Mxs1s0ozpGkqZaZwvwXZKF9s06QMf+MBY9rQAZozRJOmYY46p0n03lHKe7bUM0w3eDTyX2ke+QDMxoZ26Ey5W4xjt47cfJ5BZahZ1O28ed1t0FpsslC7VdkoL2iQpwUPGdKarvLkN/Shp9jursqTlozvbMa3UkwR/zbmlK7s7RjUtxN2u4Sb/hr5Pi+3pp58+0WlkyEQ75fkkf1t5T/e9zdjB9v6meTzrz92s9z9NWRfKO6u/gQz1aE+YS1DXXZZoofNptiV//s+VyhIwCaSs+qxbxjIxJLfMeMzkhYzfSNdHe2zWQsVOV126dfNl/973vrceG7LYmKrugP+0LHWDi4WO0d72yU9+clkzgPOf0Rvf+Mb2rhZ93O1W7n6JL/rG1guf+cxnWs+qemB0vugyCSJpNY4xdgKtJ/kFP00g020p7LaINLtOl3EW/21SuvYS0GU80FBAlwkVy0mzKstNb3rTsdPJ+Z144olj2yZ50p2hnJbFDEmYNNCa5BgrlWeou759nAyJaKdui2D7te7jbkDVfX3a57P+3M16/9OWt5t/Vn8D3R+tGdow1DrcPbd0FedHT3pgpDIE1GQZ9bhgKfIf+Vvf+tZ66Yq08mUsU7o/88efGaKLpbTCZWmWDA5e7I+9PcMx+/nIRz6y2O4W3Z7Zuulea98WmsHb3UHOP2PFhlLKkWVC2mk5l8pLl2bbK/ttZquuxjHa5999HLtpUlro2mmx8XlZMLqb8sMgY03333//+ofF+owf7e47z2dVlkyAaaevfOUr7acTP86C4N0fRAkA12KaZvHy7pjZaeqh+971tZj1527W+1/f8k9jn2NN+vfc7dVZzv+D+fGYSUVSOQJaAMupyyVLksAqg8Jzawb9Zs2vTF7ILa0kmVDQTlkKJcuepOWnm7oB4CSLCnf3sdzn3SsWLLafdPu1Bz0nX7q6lxozuNi+MvawnZq18VbjGO3jdh+nRWMWKRN10kWUq6W0U8aQ5odEblleKEvoJKDKF0q6DddnyaFZlSWf83aa9ku2eW++bHOO7YkO3X03eTf0fVrJl5tWOqib5jxm/bmb9f6nKetCeWfxN5Af8d2hHlm2aDkpAWC7Z2A5+/CetSMgAFw7dbHqZ5Ivwje84Q31LWvfpXWw+4WWlsAEXOlSblKCoeZyZM22SVrkmrzrez9psNkN2nLclHMlUhMArsYxljrfXA5uVikTHzJmMuNCm/K2j5XgOku/5JaUwCGBYLqxMuM8MwinSbMoS8apdsfprc+XbMbWzkMAOI37Wss768/drPe/Pp6z+BvIMI9uS/9yA8BJ/+9dHwPvXT0BXcCrZ72mj5SWwcwS+5//+Z/eebbXg8uL3da/bFvNALA7ji3HXygNjXlc6D2TbmsCotU4xqTnNIt8CeYyQzwTfIa6f9IlnGAwS8Fkgk9mibcXn57F+Q3ts9v1lfy5BOFyU9egPcFoufv0vr7ArD93s95/v0Qbbku39S9n0l1vddKzW07vyaT7lm/1BbQArr75ihwxLXDddc9y4e716fpJ910WuE0LR/saoBnvll+RTZC30HWGV3o82FJIk/4K7X5ZZ59ZE22aSROLnUfjvBrHWOwcVmt7BvpnPGhumViUC8LnajNZJLvbutacU7qKs/RKWlwzZnAlzJt9T3PfneCQ92Y25HInL7RnUmZfkywnlHzS9AKz/tzNev/Tl3g271ho0laGriy0fegMFgomh97j9bUrIABcu3Wz5JnliyjrRbVTLu2VZQTWJ2WcU7rvctWHdsp6cU0A2F36IfnSEjJpYNbe73IeT9q1mJbC5G0HKbkMUntCx3KO337PahyjfbwN/TgTi3LLLOyMLcqPgyz2fNe73rW+755fxpfms5SliTZESoCerun2D5QMc1ju30l30sdyxxNuCIt5PuasP3ez3v+GtE+Ld36AtSf0ZWjEYguEL3Wu3YX/l8rrtbUvoAt47dfRgme4UMvGYov6LriDJTYuFCC1rwGZlsImGGx2s9z/GLKcSlqLmlt3rEqz/+XcJ5jtroe43PNc7PircYzFjr2ht+dLJUMHMtP8oQ99aH15vnT9dlv7sk5iOwhfzfPOuXQ/z91xrtOcT3dGcffzNc2+5F2ewKw/d7Pe//JKvfx35QdQd8zfcoYu5EfUSv//ufxSeedKCAgAV0JxA+wj12btjuPIrN2VSN0vueyzu2ZUdyD9NFd7aM4xwV+uRNK+dReXbvIu9767NuFy/uMbOvZqHGPoHNbC61kPL9da7V41Iy0P3Wsqr+b5doO05V6tIsMg8kOlnbQAtjU2zONZf+5mvf/VUOv+f50W+2lT3rPWL384bZk29vwCwDn+BHQXe81in90rHiyneN3rZWYf3V+Q3f9Qdtlll8GFprvnkgWq290S6ZLoHqf7nmmfp2unne5xj3u0n070OK2SWRIl6w/mlkWy27N/V+MYE53oCmW6293uVi8VlOWCcute33foMLkkYHc2Y3e86tA+VvL1bv3ki+y6173u1IfIpQfbKS1F3Wvhtl/3eDqBWX/uZr3/6Uq7urm760Lm/95px/NlMphUloAAcI7r80Mf+tDY2adFLVcr6K59N5Zp4EmCna233nosV8b8dQe7d4+d2bDda+SO7WSBJzvuuOPY1qzRtdIp1ypNa2mTEtxut912zdOJ7jPpIRNj0nrYjHVsD6BejWNMdKIrlCktZmkla24JmtuB+iSHafsk/yQLfE+y3+XkSRDbXp8wi3c/8YlPnGpXmfySrux2Sr13y9l+3ePpBGb9uZv1/qcr7ermzjCNZuJajpy/5+7qDkudUVrwM9ZXKktAADjH9ZlLlHVbN5r1/Kb9wg5Dluw4+OCDx1q3sj1LgGSsWzvlclrdgC3LfjRXyGjnXejxDjvsUOXWTt0WzfZry32crutc9Lyd9ttvv7HrYrZf6z5Ol0fK1U7daxivxjHax5/147TEnnXWWaPDpNszV5KZNGXple44u9WaILTQOeZKLpm00k7bb799leujTpIyfjELiLf/pjK5qDsJq72vtA6200UucpH2U48XEJj1527W+1+gSGtmU8Zsd6/9m3G7Wed1KOU75RWveMVQNq/PoYAAcA4rrTnlrMmUq3R0v2zyZZ2gbeedd67S2jFJypd2vtC64wi/8Y1v1Jf/WmgfL3vZy8aOnYkir3vd60YLAy/0nmzL+eUKI+2UBYdPOeWU9qYVe3zUUUeNtWBe73rXq/8zzFImS6V88b/4xS+uNt9881G2XArsox/96Oh582A1jtEca9b3+Tx16yLj+rrXPF7oPNIK/eQnP3nspbQ8dK+nPJZhFZ68+c1v7k1cyszkAw88cMm1CvPll8uHdS8pmICy2yreLkaCznbK7OFLX/rS7U0edwRm/bmb9f47xVlzT/N/c7s3JFd+yv/DS/Xc5JrvRx555Gjs60JLgK25gjqhiQUsAzMx1drMeOqpp1YvfelLq7RqtVO6757znOfUa7FlcecMVs+SGFkSIH/EWUYmt3RtZuzgQmO0zjjjjOrRj370old0yC/qdIPd5z73GR06XcEJJD/5yU/WQUSuDJFZmOk6TatQxgp2u2DzRfq0pz2t18o42ul6Pkj33xFHHFEfo9lVyn744YdXxx9/fH05s8xmzTiZtNTkXDNGZrfddhv9x5f35QskkxzOPvvsZjej+9U4xuhgq/AgXxYJkJuWq7QOv/KVr6wv95YxkPlsJMhJS1gCm5htu+22VbqL87id8mOkOyaw/fpqPE7gnuER+Ztop9RxFq/OtaxzxZt8DvJDJpdGzN9EPq/tJWTy3vwdJaBcKnXHsqYVNcsr5XJ5mbyVLvH8uGpfYWep/W0sr836czfr/a/lesrfYFry8qOnuexnWrWz9usee+xR/7BNS30+m1lRIj/42j98EjzmylH77LPPWi6mc5tCQAA4BdZazZrWpwR1+cPutvhl2v6jHvWoqU8966TlfUNf3GkhS2oHgfnCvMtd7lLfhg6coCrda8tdmHdo/83r7373u+sA7oADDhjNZMt/gpOeZ/ZzyCGH1AFjs8/u/Woco3vMWT3PD4u05GVIQLvrM18I7S+FoeMnwDn00EOHsq3K65nAk4D1oIMOGgvsM74vt0lSWjJzabyhlpAExwkC28tm5Oox7dmX+fEhABxXn/Xnbtb7Hy/N2nuWbuAEefkh1L6cZzPed6kzfslLXjKzH+lLHddrsxPQBTw721Xdc2ZeZjzHMcccUy98u9yDp7vufe97X/XIRz5yMPjLMdLy9axnPatugRwKFrvnlNaWzKzMeMLVSGmByS/daRcBzni4BEJHH3304GmuxjEGT2KFMmR5nmc/+9lj3UbT7Dpdp8973vPW1JfGCSecUGXB9Fw1Z5qUtdTyGdh3330n+rGSHzYbavHracq1FvPO+nM36/2vRdP2OeUyeLvvvvvE/w/m/78soH/cccf1VpkwCaotO3+PtQDOX50tesbpas0X9qtf/erqIQ95SD1rsenCW/RN/3wh701rRP7Ipw3ksou0ZuTX9eMf//h6HGH712X32PkFmnEl6ZrOF+VqpqzZt+eee9Zd2+n6brfQdM8jXb1pXc0Muu6ai9287eercYz28Wb5OJ+HdI+mKzTdpd3JHd1jp26bpXLW6hp5GRCf1u18Cd7znvesZzt3y9E8T9fxSSedVL3qVa+qu2yb7ZPcp7Uwx8g1trNOZpYOSldwu0V1kv1sjHlm/bmb9f7Xep1lZYe99tqr/rvO8jhp1e9+LrPEURoDMsynGe+atTDbqbvIevs1j9e+wLrzB3mOT+9c++fsDCcUyHIwm2666eiWsVl5nqArX9TNLX/Ep5122oq11KQbequttqrHgqXbK0Foxh5m7cAEBQuNoZuwSCueLV0fmRSS88yXc66wEo/c0oW3EsuXrMYxVhxmgR2myzyfn4wPam7Zli+HuCWwyrjQ1Q7qFzjVqTbl7yKTPTIuNOP/0r2bIQm5zF/GsA519051MJmnFpj1527W+5+6wBvgDVn+qxkXnl6dfDfkvpsynKO9IkB+GAoCu0rz81wAOD915UwJECBAgMAGEcgP+yyB1bQC5odeJn5lNQppPgV0Ac9nvTlrAgQIECAwkUB6N9prtKZ1L0N2pklZt7UJ/vK+tJAL/qYRXHt5BYBrr06cEQECBAgQWDGBDNnIuOsmZVJTArppxns/+MEPbt5e36/W5L2xg3qyogJmAa8op50RIECAAIG1JZDL4LXHsmaprgc+8IETnWTGSD72sY8dWxA/+8raqtJ8C1zg/AtCP3O+i+DsCRAgQIAAgcUEMpktE5xyTd8mZQH0zEzPkkiLTXbLpTCzlNMDHvCA5m31fRZCz8oP0nwLmAQy3/Xn7AkQIECAwKBAxgHm6kftS1vmTVkcPVduOvnkk+ur++S675kVn3wJGBM4tlPW/ssVf9qXlWu/7vH8CAgA56eunCkBAgQIEFi2QC7VmaVcttlmm2XtI5cyzFqz6VKW5l9AF/D816ESECBAgACBQYFM/vjQhz5UXwv4mte8ZpWAcJKUtVtz2c8XvvCFlat/TCI2H3m0AM5HPTlLAgQIECCwYgK58sd2221X7bjjjtU1rnGNenH3K13pSvWlRJtF+5v7E088cXQ1kBU7ATva4AICwA1eBU6AAAECBAhseIHM+M0YQGnjELAMzMZRz0pJgAABAgSWFBD8LclT3IsCwOKqVIEIECBAgAABAksLCACX9vEqAQIECBAgQKA4AQFgcVWqQAQIECBAgACBpQUEgEv7eJUAAQIECBAgUJyAALC4KlUgAgQIECBAgMDSAgLApX28SoAAAQIECBAoTkAAWFyVKhABAgQIECBAYGkBAeDSPl4lQIAAAQIECBQnIAAsrkoViAABAgQIECCwtIAAcGkfrxIgQIAAAQIEihMQABZXpQpEgAABAgQIEFha4IJLv+xVAgQIrJ7A9a53vWqLLbaoNt100+qqV71qdc4551Tf+973qve///3V73//+9U7kfOPtNlmm1WXv/zlR8f87ne/W5155pmj5+0H0+Rtv2+tP95yyy2ri1/84qPTPP3006uzzjpr9NwDAgTmV0AAOL9158wJFCOQYG+fffapdtppp2rdunW9cn3rW9+qvvCFL/S2z3LDYx7zmOqOd7zj6BDPetazqmOPPXb0vP1gmrzt9631xwcddFCVoLxJD3/4w6uTTz65eeqeAIE5FhAAznHlOXUCJQhc7GIXq1796ldX17zmNUsojjIQIEBgLgSMAZyLanKSBMoV+Pd///cFg79//OMf1bnnnltuwZWMAAECG1BAC+AGxHdoAgSq6u53v/sYw0knnVS97W1vq0455ZR6vNmVr3zl6s9//vNYHk8IECBAYP0EBIDr5+fdBAish8DlLne56rKXvexoD2n123///auzzz57tG2xiRejDDN68PWvf7268IUvPNr7L37xi9FjDwgQIDDvAgLAea9B509gjgWuda1rjZ39z3/+87Hgb+zFVX6ScYkSAQIEShUwBrDUmlUuAnMgcMELjv8G/ctf/jIHZ+0UCRAgMP8CAsD5r0MlIECAAAECBAhMJTD+83uqt8pMgMCGEMi4tOtc5zrVFa5whXqh4rSa/eY3v6kyRu2nP/3pzE7pAhe4QD1b94pXvGJ93A9/+MMzO9ZydrzJJptUGVN4latcpb5lAeOMJcwC0r/85S9narOc8+2+J8vhXPe6162udKUrVZe85CWrX/3qV6M6/etf/9rNvuznV7/61etFtlOPMctn5zvf+U7161//etn79EYCBOZPQAA4f3XmjDdSge222666973vXd32trcduzpDm+MHP/hBdcIJJ1RHHXXUxF/oCTZe97rXjXZzxhlnVM94xjNGzy9zmctU97vf/apdd921Dqzywt///vdqOQHgjjvuWD30oQ8d7bt9lYlszBVA3vGOd4xez4NPfvKT1WGHHTa2rf3kpje9abX77rtXd73rXccmbbTz5HGu5PGxj32svqpInIbSk570pOqWt7zlKNuRRx5ZHX/88aPnK/Vg2223rR74wAdWqd+LXOQivd1mBvQHP/jB2uWb3/xm7/VJNlziEpeo9txzz+pud7vb2MLOzXvPO++8KpNePvCBD1TvfOc7q7/97W/NS+4JEChUQABYaMUqVjkCCQr222+/6gEPeMBgoa597WtXe++9d3XPe96zevrTn1599rOfHXxPWvZyKbMm/elPf2oeVje84Q2rI444om5tHG1cjwdpoWsfq7urtG52X18s6EkraK7OcbOb3ay7mwWf54oWuT34wQ+uHv/4x1dZbmapdI1rXGPsXBIIr2S60IUuVO277751YLbQ1U+aY6Vl8L73vW99e9Ob3lS9/OUvrzJbetKUMh988MF1q/Fi78nxN9988/qWQP/Zz3529cUvfnGx7LYTIFCAgDGABVSiIpQrcP3rX79uzZsk+Gsr5Bq2r3zlK+tApzvRop1vqccJrNIymK7mtZbSTZrAdNLgr33+F73oResgavvtt29vXtXHmf2cYC7B6FLBX/ekHvKQh1SHH374ki2d7ffssMMO1Vve8pYlg792/jzOub3iFa9Ylm13X54TILB2BbQArt26cWYbucDWW29dd312uwX/8Ic/VJ///Oerr33ta3W3XVrVtthii/oLO/dNSmCRgCFB0iMe8YipWo3SNfuiF72outSlLtXsbuw+XcDLSRlnduqpp47emu7njHtr0jnnnFN1W/x+9KMfNS/X93lPuoRz/eB2+vGPf1x3lX7/+9+vx/yllSxrDG655ZZ193DGvjUpLY3PfOYzq3RJL7cszb6mvb/O+S2XRx99dK8bP9c6Pu200+o6/eMf/1jd+MY3rm5yk5tUt7/97ccCvnQZp3U3t6XSHnvsUaUbu5u+/e1v1129ccoYybTyxiifk7R6JiVIThCYrmGJAIEyBQSAZdarUs25QAbnH3jggb0xYV/60peqpzzlKfXkgHYRM3Yraeedd65fb4+tu8UtblHtsssu1Xve8572W5Z8/NjHPnYswPrGN75Rj3/73ve+V4+l6wZlS+6s9eJHPvKRKrcmJch97Wtf2zytfvKTn1R77bXX6PlCD9IamqClSQlS0hWc8i0UsGTcXwLGBLR3uMMdmrfVLZsJpibpJh+9aQUeHHDAAWPB329/+9v6/D/+8Y+P7b05rwSM3a7ue9zjHtXJJ59cHXfccWPvaZ6ku/oxj3lM83R0n1bh9njPvJCu3lx5JWmnnXaqA+N0T690l3d9AP8QILBmBHQBr5mqcCIE/k8gY/jaQU5eSVdeWvKWuiLF+9///npCRDdAe9zjHle36vzfERZ/lIDjQQ96UJ0hrWivf/3r667K17zmNdVHP/rROgDckJMEttlmm7GTT0tVAqGFgr8mY2bRpjUsraftlEknq5nufOc7V7e5zW1Gh8xM3wS03eBvlOH8B2mpy7jOBLLt9OhHP3qsZbD9Wlp+M/GjSbF5/vOf3wv+mteb+/yQyH4zc1oiQKBsAQFg2fWrdHMokEH/Cdja6Vvf+lZ16KGHTtSNmxmuL3jBC9pvr5cWGWpZa96QbtO0QKY7NgFnAqxzzz23eXmD3ue8ttpqq7FzeO973zv2fLEnKcNXv/rVsZczVnK1UjOZp328BGWTLL+SQPy5z31uldbCJmW5m3vd617N09F9xmzutttuo+d5kBa+zO6dJKWV+aCDDpokqzwECMyxgABwjivPqZcpcP/737/KGm3tdMghh0wU/DXvSfdh04XYbEsrUrtruNm+2P0xxxxTJRhYSymTYhKYZu263LJ0SVrRJk1ZD7CdElCuVspM3vY4xM997nNTLSuT4C8zgNvp7ne/e/tp/Titt/kR0U6TBn/Ne7L0Trr7JQIEyhVYvf/9yjVUMgIrKpB17dopEz5OPPHE9qaJHneDhQQF6d6dJKXL9I1vfOMkWVc1T1pC73KXu4xuWf9v0pSJH+mC3VAp6ze2UzdAb7+22OPPfOYzYy+lNbQ7USeTR9opn59J1j1svyddxm9+85vbmzwmQKAwAQFgYRWqOPMvkGU42ukrX/lK++nEjzObtntt3UkDwIwlPPPMMyc+1lrPeKtb3ap61ate1QuWVuu8s9ZiJuO005e//OX204kepwUzs52blP3e6EY3ap7W991xjd2xg2OZl3iSBcUlAgTKFTALuNy6VbI5Fchizu00betN89604vzwhz8cW8y4u+8mb/c+S8zMY8rM1Swrk0A3CyBnUelMplnNsX4LuaVLv9stm9ncyxlbmTGa7dQuW7q0m6Vcmjw///nPm4dT3WciSJajaU8mmWoHMhMgsKYFBIBrunqc3MYmkAWOu+P0EsQtN2UGafvKGpMGgMsNGpZ7nst9X9ZATJfwzW9+8/rWDX6Wu9+Vfl83aMv+s8bfSqR2AHi1q12t6i78vdSs8aHj/+xnP6tucIMbDGXzOgECcyggAJzDSnPK5QosFMBkbbzlpp/+9Kdjb21PQhh7ofNkfYKGzq5m8jSLQGepk1wbOYsWD6W0ZGW2cGbO3ulOdxrKvuKvz7IVrR0AXvnKV+6de3fiSy/DEhsyDEAAuASQlwjMsYAAcI4rz6mXJ7DQjNYs65EZr8tJ3cu4TbLkSI6zllsAMxM4E1RyRZBuypjHjH3MOoi5pQX0O9/5Tj0JImsXZhHmDZG6gXjOIVfkmOaavouddybsNGmhcZsJPpf7+Vmo5bI5lnsCBOZbQAA43/Xn7AsTSKCQQCVXYmhSum0z+3U5qTvpY9LxhH/605+Wc7iZvydj6XI1i27wl6uAfPCDH6xOOeWUqh0QzfyEJjxAWlRj2u7ef8ITnjA2oWPCXS2ZLV223c9PJoV0FwZfcietF7uX22u95CEBAnMuYBbwnFeg0y9LIC1C7VmeKd2k4/YWkujOKE6L2DynLJDdDkrStbv//vvXly876aST1mTwF+9MyOnaZ7zeSqd8frpDBrqzgic9ZhaubncvT/o++QgQmA8BAeB81JOz3IgEuoFC95Jwk1JkPFj3eq6TtgBOeozVznezm91s7JAZ15fL002aujNxJ33fSuT77ne/O7abScdjjr1pgifdOs71lpeTbn3rWy/nbd5DgMCcCAgA56SinObGI5Axa+1017vetV7apL1tkse5jFs7pXVonq/ukO7TLPHSTp/+9KfbTwcfb7nlloN5ZpWhW6/3uMc9pj5UZvi+4x3vqLJOY24JgLvj9DIGsp122GGHajmtgHvssUd7Nx4TIFCYgACwsApVnPkXyGW7/vznP48KksV+n/jEJ46eT/IgEyVy6bF2yji53/3ud+1Nc/U4Zepeuq0bVC1VoCwV0w0gl8q/0q/FP13WTdpmm22q7bbbrnk60f3tbne7elmftB7mlsk63Tp9+9vfPvb5iVlmTE+TsnTQLW95y2neIi8BAnMmIACcswpzuuULZNmOzHJtp+23377aaaed2psWfZyWsiwy3A6WMgHhsMMOW/Q98/DCQjNpt9hii4lO/dKXvnT1/Oc/v1q3bt1Y/vZkm7EXZvAk1/J905veNLbn/fbbr+rO1B7L0HqS5W4e/vCHt7ZU1Qc+8IGx53mSGb9ve9vbxrbf6173qnbcccexbYs9yVI5z3ve8xZ7edHtsW1fpq95nLUtJQIE1p6AAHDt1YkzIlBfh7W7pMdzn/vc6sADD6xyTdvFUhYXzpd/97JjCSgnXQJmsX1v6O05/+76hI961KOqBHdLpbSy5bq27ckjTf5u92mzfVb3Rx111Fg95Gol6dIdGm+XoP7FL35xtfnmm49OLUveLDb+MYHmH/7wh1HeBLoJgB/2sIeNti30ILPG81lJa+u0KT84XvKSl/Ru3WtbT7tf+QkQmI2AZWBm42qvBNZLIF/uL3/5y6vnPOc5Y/vZbbfd6q65j3zkI1Uu1/b1r3+9ytUw8iWb8W277LLL2BIyeXNazhIAlZBOO+20ejHnpixZpPjoo4+u3vrWt1aZBZzW00td6lJ1V2+Cq2233ba67W1v22Svr43cXjg63ZyZWHL22WfXl81biXX5Rgdb4EG69o844ojqaU972ujVtAAefvjh1fHHH1+lfKnTjOPLLNx08+Y6xqn39oSenOdTn/rU+rxHO2o9OOuss6ojjzyySgtjk9JCt88++1TpRv7iF79YffWrX62Pl3GFOU7GmqalsFliJ8vJZEmdWS5i3ZybewIEVl9AALj65o5IYCKBDPJP1+1BBx009uWf1plJW2g+85nPVM94xjOqc845Z6JjrvVML33pS6uMnWsHQ5ngMMkCz6effnodFL3sZS8bFTNXXmmC43Szt8fojTKt8IN3v/vd9QLQOecmGG13n05yuEMOOaQOGJfKm9bGXM83wWa71XirrbaqchtK+QFyn/vcp76m8lBerxMgMH8CuoDnr86c8UYkcMIJJ1S77rpr3WIzTbHTenPwwQdX++6777KvAjHN8VYrb9a4y7p/55577lSH/PCHP1w98pGPrL785S/XrYBTvXkGmY899tgqs2ynXeA7LXup17R6TpIyS3jvvfee6souWbMwE0kmPcYk5yEPAQJrT0AAuPbqxBkRGBPIWMCMdcsX/1DAkK7jT3ziE9Vee+1VpQUoX+alpS984Qv1NYATpKS8i6W0en7qU5+qA6CMnUzrXgKoQw89tHcJtg3hlHUB99xzz+oNb3hDlSt4LJXSRZ2u45133rmu16Xydl9Ll/Luu+9evetd7+rNGG7nTVD9+c9/vp5o8sIXvrDIz067vB4T2NgF1p2/NEJ53xAbe60qf9ECGa+VyR4ZO5bxfwl0MvMzEyTSwlVKd+8klZjxfhkHmAkeubJGulIzWSQB1VIWeU/G/2W8W66//LnPfW6qVrJJzm3aPFnwO+MWc/WNdHHnvHJVmNxSnr///e/T7rKXP0sKpfs3Vpmdm67hfHbyI+NLX/rS2MSR3pttIECgKAEBYFHVqTAECBAgQIAAgWEBXcDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHPoKB/QAACNZJREFUAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEtjkvPPO+2ZRJVIYAgQIECBAgACBRQUS+13gKle5SloBr79u3borLJrTCwQIECBAgAABAnMvkODv/Nth/w9Sg1U8m1UKngAAAABJRU5ErkJggg==`
\ No newline at end of file
+data:image/gif;base64,iVBORw0KGgoAAAANSUhEUgAAAoAAAAHgCAYAAAA10dzkAAAAAXNSR0IArs4c6QAAAAlwSFlzAAALEwAACxMBAJqcGAAAA6ppVFh0WE1MOmNvbS5hZG9iZS54bXAAAAAAADx4OnhtcG1ldGEgeG1sbnM6eD0iYWRvYmU6bnM6bWV0YS8iIHg6eG1wdGs9IlhNUCBDb3JlIDUuNC4wIj4KICAgPHJkZjpSREYgeG1sbnM6cmRmPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5LzAyLzIyLXJkZi1zeW50YXgtbnMjIj4KICAgICAgPHJkZjpEZXNjcmlwdGlvbiByZGY6YWJvdXQ9IiIKICAgICAgICAgICAgeG1sbnM6eG1wPSJodHRwOi8vbnMuYWRvYmUuY29tL3hhcC8xLjAvIgogICAgICAgICAgICB4bWxuczp0aWZmPSJodHRwOi8vbnMuYWRvYmUuY29tL3RpZmYvMS4wLyIKICAgICAgICAgICAgeG1sbnM6ZXhpZj0iaHR0cDovL25zLmFkb2JlLmNvbS9leGlmLzEuMC8iPgogICAgICAgICA8eG1wOk1vZGlmeURhdGU+MjAxNy0xMS0xMVQxMjoxMTo3NDwveG1wOk1vZGlmeURhdGU+CiAgICAgICAgIDx4bXA6Q3JlYXRvclRvb2w+UGl4ZWxtYXRvciAzLjQuMTwveG1wOkNyZWF0b3JUb29sPgogICAgICAgICA8dGlmZjpPcmllbnRhdGlvbj4xPC90aWZmOk9yaWVudGF0aW9uPgogICAgICAgICA8dGlmZjpDb21wcmVzc2lvbj41PC90aWZmOkNvbXByZXNzaW9uPgogICAgICAgICA8dGlmZjpSZXNvbHV0aW9uVW5pdD4yPC90aWZmOlJlc29sdXRpb25Vbml0PgogICAgICAgICA8dGlmZjpZUmVzb2x1dGlvbj43MjwvdGlmZjpZUmVzb2x1dGlvbj4KICAgICAgICAgPHRpZmY6WFJlc29sdXRpb24+NzI8L3RpZmY6WFJlc29sdXRpb24+CiAgICAgICAgIDxleGlmOlBpeGVsWERpbWVuc2lvbj42NDA8L2V4aWY6UGl4ZWxYRGltZW5zaW9uPgogICAgICAgICA8ZXhpZjpDb2xvclNwYWNlPjE8L2V4aWY6Q29sb3JTcGFjZT4KICAgICAgICAgPGV4aWY6UGl4ZWxZRGltZW5zaW9uPjQ4MDwvZXhpZjpQaXhlbFlEaW1lbnNpb24+CiAgICAgIDwvcmRmOkRlc2NyaXB0aW9uPgogICA8L3JkZjpSREY+CjwveDp4bXBtZXRhPgpS3JvOAABAAElEQVR4Ae3dB5gsVZ034LqYc86YFRURXEFRxCzigphFBETWHGBXRcA14ZoTiAEQcwDTKuCa1gRmQUVUEMWcA2YwoSgfv/qs3uqqmanuudNzp899z/P07e7q01V13tNz+98n1bott9zy39etW/e482+bVRIBAgQIECBAgECxAuedd943z78dtm6rrbY6Q/BXbD0rGAECBAgQIEBgTCBB4CaCvzETTwgQIECAAAECRQsk9tuk6BIqHAECBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+lU6AgQIECBAgEBPQADYI7GBAAECBAgQIFC2gACw7PpVOgIECBAgQIBAT0AA2COxgQABAgQIECBQtoAAsOz6VToCBAgQIECAQE9AANgjsYEAAQIECBAgULaAALDs+h0r3SabbFJd/OIXH9vmCQECBAgQILDxCVxw4yvyxlHiC1/4wtVOO+1U3fSmN62ucY1rVJtuuml11atetbrQhS5UnXXWWdVPfvKT6qc//Wl9+/znP199+tOf3jhglHLNCGyzzTbVBS/4f/8FffnLX67+8pe/rJnzcyIrK7DZZptVl7/85Uc7/e53v1udeeaZo+elPNhYyllKfW3M5Vh385vf/LyNGaC0sl/mMpepdt1112q33XYb+892qJynn3569epXv7r6xCc+MZTV6wRWRODjH/94lc9rk+5973tXP/jBD5qn7gsTeOlLX1rd8Y53HJXqWc96VnXssceOnpfyYGMpZyn1tTGX4/9+fm/MCoWU/YEPfGD1+Mc/vrroRS86dYk233zz6tBDD62+/vWvV0996lOr733ve1PvwxsIEPj/Amnpuv71rz/iSKv7GWecMXruAQECBDa0gABwQ9fACh0/3b0HHnhgtW7dut4ezzvvvOq3v/1t9ctf/rLK43QJX+pSl+rly4ab3OQmVX7B7rnnntUf/vCHBfPYSIDA0gK3vOUtqxe84AWjTBlm8ahHPWr03AMCBAhsaAEB4IaugRU4/m1uc5vqv/7rv8aCv3/84x/ViSeeWB1zzDHVpz71qeqvf/3r2JESAG6xxRbV3nvvXd3qVrcae+3a17529ZznPKd6whOeUAeMYy96QoAAAQIECMy9gABwzqvwale7WvWSl7xkbDD93//+92q//fZbcjzf2WefXX3uc5+rbze72c3qVr8rXOEKI4073OEO1SMf+cjqyCOPHG3zgAABAssVyPCSTE5r0i9+8YvmYVH3G0s5i6q0jbQwAsA5r/j73Oc+vaVdMoZvmskcp556at3a99rXvnbsP+iHPvSh1VFHHVX98Y9/nHMlp0+AwIYWyCSzjSFtLOXcGOqy9DJaB3DOa/hf//Vfx0rws5/9rPrQhz40tm2SJwkCX/ziF49lza/1tARKBAgQIECAQFkCAsA5rs+soXb1q199rARZS2256YMf/GCV7uN2ymB2iQABAgQIEChLQBfwHNfnFa94xSpX92injD9ZbkpX72mnnVZttdVWo110A8zRCwMPsr5bFp/OOV7ykpesF3zNwtM///nPe0HmwK4mevkCF7hAdc1rXrM+Xpbg+PCHPzzR+y52sYtV173udasrXelK9Xn+6le/qjI2KefanTgz0Q4XyLQax2gf9nKXu1xtf5WrXKXu0v/9739fpWU4C++ub8qVZDLuNJ+LS1/60vXs8pj9+te/rh9n8tFKphwj9XPlK1+5Lsvvfve7FStLc55p6b7Oda5TZQxsPjtZjPo3v/nN6HPQ5Jv3+5QtfyP5XGS1gHzO85nIEjVDKX/LqfP8nTR1kM/Un//856G3rsjrs/7czXr/64Mwy7/n5rzy95XPRer3Ihe5SP3ZyJqc+buWyhUQAM5x3S70ZZv/5Ncnve997xsL0LJ0zKQpweh973vfascdd6xucYtb9ILT7Cfn/K1vfat6y1veUv3v//7v2LEWOk6Cx9e97nWjl7KW2jOe8YzR8wSa97vf/erFr/MfWFJaMYcCwG233bbKuonbbbdd/R/eaIf/fJAvtrSIvuMd76i++c1vdl+e6PksjhGLmCQlsMtEnSbd5S53qe5///vXs7q7PwySJ3X5tre9rXrTm95U10PzvqH7XD1mhx12qBcXz4ShxVICiuOOO66+JdBfn3T3u9+9uuc971mXJcF9Ny23LO39pO6z+PRtb3vb3jjaJl++BE844YR6LOxSX4aZTd8ejpHAtZ0y4z6fpXbK0jAHH3xwe9OyHy/1ucjfY/4uc+WV7ufib3/7W30VoLz/a1/72tjxU+/528p7b3jDG4691jxJGTLm7eSTT242LXr/pCc9qWr3KGSC2fHHH79o/ll/7ma1/2nKuVS9zervuQHPZ3SXXXapbze60Y2azaP7LBn2xS9+sf7cfuxjH6u33+52t6v22WefUZ63v/3tRS7mPSpg4Q9cCWSOKzj/mX/hC18Y+089LVf5D/ucc85Z1ZJd9rKXrZ7//OdXt771rSc+bi5H96pXvapK0LlYSoD38fOvGNGkdHH/27/9W/00X0pHHHFE3XLTvJ77BID5slso5T/9fffdt17ncKE1Exd6TwKml7/85RMHTbM8Riyaq2eklSpfEmlhTFCcoGmS9KUvfale7HuSIK1Zz26aHxYJ8jN5KOtJLpXaZUm+BGMJvA866KA6MF/qvc1r05SleU9aODJL/gEPeECzafA+1k9/+tOrz372swvmPeCAA6oHPehBC7622MYEPzmPlUhty+Zzkb/JWN6xdfWNxY6V1u5nP/vZo7/FtIjm7/nGN77xYm8Z257jpywL/ShtMk5zhYxZfu5yPrPc/zTlXKjeZvn33NRFfvxk6bD00EyS3v/+91fPe97zqjvd6U71EmHNe175yleO/UBvtrufD4Hx/sP5OGdn+U+B/GebyRvtlG6aXNEj1/1drZRWofwSnCb4y7llQep86eSLf9qUY+bXc3vpmqF9XOta16pbvx784AePrZk49L6HPOQh1eGHHz42Q3qx96zGMdrHTutYguBJg7+8N62zCdDyRbNU2nrrrevAd5rgL/vLD5O99tqrevjDH77U7nuvJXBOoJ0vp0nTpGVp9perc6Ts0wR/eW8M8mWXK+20r1/c7Het3V/iEpeoXvOa10wU/OXc0w3+zGc+s8oaoPkMv/nNb544+Mv7E2Su1ELXs/7czXr/8VhumuXfc3NO+ds87LDDJg7+8r6dd955LPBr9uV+vgV0Ac93/VXvete7xsbspTgJxN797nfXX1jpkpvlOJ073/nO1Qtf+MLel+KPf/zjugv129/+dpUxYuluyPij29/+9r3Wuac97Wn1GMHFWle6VZTxOi960YsWvZpJdyJL3p8WjaOPPrrX1ZcW1Ix7zNjJjIFMi0euhpLzbK9Zlu7ctADltlhajWN0j/24xz1urP7TIvaNb3yj7mZPHeSHQC7zl668dnkSOOea0WndXCilLK94xSvGLiuYLqGTTjqpXlj8Rz/6UZW1JNMamUA+XcTnX1d8bFc5t+SbdFb6Yx7zmKrdFbVSZWlOKl/8+eJLC2A75Yo36cpMF2g+BxlzlS7b/MjIfZPSYpwfA9n+iEc8Yqy1K+Ph2j/G0vqWz3uT8tnqjsGc1XWPE0Sk9e4GN7hBffi07n31q1+tTjnllCot6BlCkNbzdP81wyaSMe974hOfWJ93c6WgdBFnqEaGbeT8kyfvzeLz8WynBPxp0Vqfcciz/tzNev9tj+U8ntXfc3MuqfMs8N9N+SzmM5Jb/mbzf8a//Mu/1P+3NMMZ0vqXvw2pHAFdwHNel/lSespTnlKP/VqoKPnPP+NzcjWQLPz8/e9/f6Fsy9qWFpv3vOc99aSA9g4yvi/dIAkYFkrbb799fZmstFI0KV+QacXqXn5uoS7gfFHvsccezVvrgCfdabl+cb6k8h9YvrjaKS14+dJqUi6Nl4vR5wtroZQviryeL/t2SrdJguqF0mocI+fbdAGnBTj1n1sG8ud8m7E63fNLq05ahjOhokkxyCUEM+mhm7KWZMYTNin185//+Z/156jZ1r1Pt1rqvV2vCerzpbZQapel/fpyypJuz7RSLFSW7Dutkmml7o5lS5CZv5/FFiXOPvN6fnS0U1rL8tlfLGXc3WpeCm4xywwJ+Y//+I8qP8S6KT8C8ploB7ntPAkW00LfDVyTJ5+51OvDHvaw9luqQw45pB7fO7bxn08m6Rqd9edu1vtPUScpZ+PTrrdZ/j3nePl/I+Oa2y3/OWZ+wL/zne9sTmnsPp/7lKd7tagmky7gRmI+73UBz2e9jc46QVbGZiwWlKTVJ4FPxigde+yxVf7DSctOWjAyTi5B3HJTFqHOjNB2yhdKvgQWC/6S99Of/nQdTOQ/nyYlaMhg/KGUwKwZa5X3v/71r6/SpZvuro9+9KP1l1U3+EsrZTv4S4tkugBjsVhKoLz3+QP7uwHVox/96LGWtOb9q3GM5ljNfYKafBGfeeaZdWte91ybfLn/4Q9/WI8Ha5vn1/yWW27ZzjZ63B1DmUk1+RGxVEpragbAt1NaEabpMl1uWdJFu1hZcj6ZUNIN/vJDJX8HiwV/eV/GPu2+++71j4o8b1KCn4te9KLN0zV5n5a4XNN7oeAvJ5xJLWlJXyjl858u3YWCv+TP33e+/POjq50222yz9tOpH8/6czfr/U9d4NYbZvn3nMPkB107+MuPpbT4Lhb85T1/+tOf6jHTmQgllScgACygTvOfcX6ppwUqSzQslfIrMC1wj33sY+ugKUFQgrYERO3uoKX2kdfSjdYd4/Xf//3fi3YpdveXYKI7A3CSRafTtZb/KDPJJV/eCWbPPffc7u5Hz5sB/6MN5z9I99hSMzqbvAmWnvvc59bLmzTbYnSve92reVrfr8Yxxg7YeZJuzaWCmCZ7uii7szUXq/MsC9FO3/nOd9pPF32cLuL2zPF84SzWwrTQTmZRlpxDtxUyXZr53LcD4oXOJ9vSPdZuzcu2LJeRsVRrNeX/hFzPO628S6V8Jrp12/x/MskySN1W0G6QvdSxF3pt1p+7We9/oTJNu20WfwNpCGh+ODfnk+Efk1wxKp+DJz/5yYPfLc1+3c+PgABwfupqyTPNF1lawdKNmqAlv+AnSWniT+CVbq7Mxs1s0ozpGkqZaZwvwXZKF9s06QMf+MBY9rQAZozRJOmYY46p0n03lHKe7bUM0w3eDTyX2ke+QDMxoZ26Ey5W4xjt47cfJ5BZahZ1O28ed1t0FpsslC7VdkoL2iQpwUPGdKarvLkN/Shp9jursqTlozvbMa3UkwR/zbmlK7s7RjUtxN2u4Sb/hr5Pi+3pp58+0WlkyEQ75fkkf1t5T/e9zdjB9v6meTzrz92s9z9NWRfKO6u/gQz1aE+YS1DXXZZoofNptiV//s+VyhIwCaSs+qxbxjIxJLfMeMzkhYzfSNdHe2zWQsVOV126dfNl/973vrceG7LYmKrugP+0LHWDi4WO0d72yU9+clkzgPOf0Rvf+Mb2rhZ93O1W7n6JL/rG1guf+cxnWs+qemB0vugyCSJpNY4xdgKtJ/kFP00g020p7LaINLtOl3EW/21SuvYS0GU80FBAlwkVy0mzKstNb3rTsdPJ+Z144olj2yZ50p2hnJbFDEmYNNCa5BgrlWeou759nAyJaKdui2D7te7jbkDVfX3a57P+3M16/9OWt5t/Vn8D3R+tGdow1DrcPbd0FedHT3pgpDIE1GQZ9bhgKfIf+Vvf+tZ66Yq08mUsU7o/88efGaKLpbTCZWmWDA5e7I+9PcMx+/nIRz6y2O4W3Z7Zuulea98WmsHb3UHOP2PFhlLKkWVC2mk5l8pLl2bbK/ttZquuxjHa5999HLtpUlro2mmx8XlZMLqb8sMgY03333//+ofF+owf7e47z2dVlkyAaaevfOUr7acTP86C4N0fRAkA12KaZvHy7pjZaeqh+971tZj1527W+1/f8k9jn2NN+vfc7dVZzv+D+fGYSUVSOQJaAMupyyVLksAqg8Jzawb9Zs2vTF7ILa0kmVDQTlkKJcuepOWnm7oB4CSLCnf3sdzn3SsWLLafdPu1Bz0nX7q6lxozuNi+MvawnZq18VbjGO3jdh+nRWMWKRN10kWUq6W0U8aQ5odEblleKEvoJKDKF0q6DddnyaFZlSWf83aa9ku2eW++bHOO7YkO3X03eTf0fVrJl5tWOqib5jxm/bmb9f6nKetCeWfxN5Af8d2hHlm2aDkpAWC7Z2A5+/CetSMgAFw7dbHqZ5Ivwje84Q31LWvfpXWw+4WWlsAEXOlSblKCoeZyZM22SVrkmrzrez9psNkN2nLclHMlUhMArsYxljrfXA5uVikTHzJmMuNCm/K2j5XgOku/5JaUwCGBYLqxMuM8MwinSbMoS8apdsfprc+XbMbWzkMAOI37Wss768/drPe/Pp6z+BvIMI9uS/9yA8BJ/+9dHwPvXT0BXcCrZ72mj5SWwcwS+5//+Z/eebbXg8uL3da/bFvNALA7ji3HXygNjXlc6D2TbmsCotU4xqTnNIt8CeYyQzwTfIa6f9IlnGAwS8Fkgk9mibcXn57F+Q3ts9v1lfy5BOFyU9egPcFoufv0vr7ArD93s95/v0Qbbku39S9n0l1vddKzW07vyaT7lm/1BbQArr75ihwxLXDddc9y4e716fpJ910WuE0LR/saoBnvll+RTZC30HWGV3o82FJIk/4K7X5ZZ59ZE22aSROLnUfjvBrHWOwcVmt7BvpnPGhumViUC8LnajNZJLvbutacU7qKs/RKWlwzZnAlzJt9T3PfneCQ92Y25HInL7RnUmZfkywnlHzS9AKz/tzNev/Tl3g271ho0laGriy0fegMFgomh97j9bUrIABcu3Wz5JnliyjrRbVTLu2VZQTWJ2WcU7rvctWHdsp6cU0A2F36IfnSEjJpYNbe73IeT9q1mJbC5G0HKbkMUntCx3KO337PahyjfbwN/TgTi3LLLOyMLcqPgyz2fNe73rW+755fxpfms5SliTZESoCerun2D5QMc1ju30l30sdyxxNuCIt5PuasP3ez3v+GtE+Ld36AtSf0ZWjEYguEL3Wu3YX/l8rrtbUvoAt47dfRgme4UMvGYov6LriDJTYuFCC1rwGZlsImGGx2s9z/GLKcSlqLmlt3rEqz/+XcJ5jtroe43PNc7PircYzFjr2ht+dLJUMHMtP8oQ99aH15vnT9dlv7sk5iOwhfzfPOuXQ/z91xrtOcT3dGcffzNc2+5F2ewKw/d7Pe//JKvfx35QdQd8zfcoYu5EfUSv//ufxSeedKCAgAV0JxA+wj12btjuPIrN2VSN0vueyzu2ZUdyD9NFd7aM4xwV+uRNK+dReXbvIu9767NuFy/uMbOvZqHGPoHNbC61kPL9da7V41Iy0P3Wsqr+b5doO05V6tIsMg8kOlnbQAtjU2zONZf+5mvf/VUOv+f50W+2lT3rPWL384bZk29vwCwDn+BHQXe81in90rHiyneN3rZWYf3V+Q3f9Qdtlll8GFprvnkgWq290S6ZLoHqf7nmmfp2unne5xj3u0n070OK2SWRIl6w/mlkWy27N/V+MYE53oCmW6293uVi8VlOWCcute33foMLkkYHc2Y3e86tA+VvL1bv3ki+y6173u1IfIpQfbKS1F3Wvhtl/3eDqBWX/uZr3/6Uq7urm760Lm/95px/NlMphUloAAcI7r80Mf+tDY2adFLVcr6K59N5Zp4EmCna233nosV8b8dQe7d4+d2bDda+SO7WSBJzvuuOPY1qzRtdIp1ypNa2mTEtxut912zdOJ7jPpIRNj0nrYjHVsD6BejWNMdKIrlCktZmkla24JmtuB+iSHafsk/yQLfE+y3+XkSRDbXp8wi3c/8YlPnGpXmfySrux2Sr13y9l+3ePpBGb9uZv1/qcr7ermzjCNZuJajpy/5+7qDkudUVrwM9ZXKktAADjH9ZlLlHVbN5r1/Kb9wg5Dluw4+OCDx1q3sj1LgGSsWzvlclrdgC3LfjRXyGjnXejxDjvsUOXWTt0WzfZry32crutc9Lyd9ttvv7HrYrZf6z5Ol0fK1U7daxivxjHax5/147TEnnXWWaPDpNszV5KZNGXple44u9WaILTQOeZKLpm00k7bb799leujTpIyfjELiLf/pjK5qDsJq72vtA6200UucpH2U48XEJj1527W+1+gSGtmU8Zsd6/9m3G7Wed1KOU75RWveMVQNq/PoYAAcA4rrTnlrMmUq3R0v2zyZZ2gbeedd67S2jFJypd2vtC64wi/8Y1v1Jf/WmgfL3vZy8aOnYkir3vd60YLAy/0nmzL+eUKI+2UBYdPOeWU9qYVe3zUUUeNtWBe73rXq/8zzFImS6V88b/4xS+uNt9881G2XArsox/96Oh582A1jtEca9b3+Tx16yLj+rrXPF7oPNIK/eQnP3nspbQ8dK+nPJZhFZ68+c1v7k1cyszkAw88cMm1CvPll8uHdS8pmICy2yreLkaCznbK7OFLX/rS7U0edwRm/bmb9f47xVlzT/N/c7s3JFd+yv/DS/Xc5JrvRx555Gjs60JLgK25gjqhiQUsAzMx1drMeOqpp1YvfelLq7RqtVO6757znOfUa7FlcecMVs+SGFkSIH/EWUYmt3RtZuzgQmO0zjjjjOrRj370old0yC/qdIPd5z73GR06XcEJJD/5yU/WQUSuDJFZmOk6TatQxgp2u2DzRfq0pz2t18o42ul6Pkj33xFHHFEfo9lVyn744YdXxx9/fH05s8xmzTiZtNTkXDNGZrfddhv9x5f35QskkxzOPvvsZjej+9U4xuhgq/AgXxYJkJuWq7QOv/KVr6wv95YxkPlsJMhJS1gCm5htu+22VbqL87id8mOkOyaw/fpqPE7gnuER+Ztop9RxFq/OtaxzxZt8DvJDJpdGzN9EPq/tJWTy3vwdJaBcKnXHsqYVNcsr5XJ5mbyVLvH8uGpfYWep/W0sr836czfr/a/lesrfYFry8qOnuexnWrWz9usee+xR/7BNS30+m1lRIj/42j98EjzmylH77LPPWi6mc5tCQAA4BdZazZrWpwR1+cPutvhl2v6jHvWoqU8966TlfUNf3GkhS2oHgfnCvMtd7lLfhg6coCrda8tdmHdo/83r7373u+sA7oADDhjNZMt/gpOeZ/ZzyCGH1AFjs8/u/Woco3vMWT3PD4u05GVIQLvrM18I7S+FoeMnwDn00EOHsq3K65nAk4D1oIMOGgvsM74vt0lSWjJzabyhlpAExwkC28tm5Oox7dmX+fEhABxXn/Xnbtb7Hy/N2nuWbuAEefkh1L6cZzPed6kzfslLXjKzH+lLHddrsxPQBTw721Xdc2ZeZjzHMcccUy98u9yDp7vufe97X/XIRz5yMPjLMdLy9axnPatugRwKFrvnlNaWzKzMeMLVSGmByS/daRcBzni4BEJHH3304GmuxjEGT2KFMmR5nmc/+9lj3UbT7Dpdp8973vPW1JfGCSecUGXB9Fw1Z5qUtdTyGdh3330n+rGSHzYbavHracq1FvPO+nM36/2vRdP2OeUyeLvvvvvE/w/m/78soH/cccf1VpkwCaotO3+PtQDOX50tesbpas0X9qtf/erqIQ95SD1rsenCW/RN/3wh701rRP7Ipw3ksou0ZuTX9eMf//h6HGH712X32PkFmnEl6ZrOF+VqpqzZt+eee9Zd2+n6brfQdM8jXb1pXc0Muu6ai9287eercYz28Wb5OJ+HdI+mKzTdpd3JHd1jp26bpXLW6hp5GRCf1u18Cd7znvesZzt3y9E8T9fxSSedVL3qVa+qu2yb7ZPcp7Uwx8g1trNOZpYOSldwu0V1kv1sjHlm/bmb9f7Xep1lZYe99tqr/rvO8jhp1e9+LrPEURoDMsynGe+atTDbqbvIevs1j9e+wLrzB3mOT+9c++fsDCcUyHIwm2666eiWsVl5nqArX9TNLX/Ep5122oq11KQbequttqrHgqXbK0Foxh5m7cAEBQuNoZuwSCueLV0fmRSS88yXc66wEo/c0oW3EsuXrMYxVhxmgR2myzyfn4wPam7Zli+HuCWwyrjQ1Q7qFzjVqTbl7yKTPTIuNOP/0r2bIQm5zF/GsA519051MJmnFpj1527W+5+6wBvgDVn+qxkXnl6dfDfkvpsynKO9IkB+GAoCu0rz81wAOD915UwJECBAgMAGEcgP+yyB1bQC5odeJn5lNQppPgV0Ac9nvTlrAgQIECAwkUB6N9prtKZ1L0N2pklZt7UJ/vK+tJAL/qYRXHt5BYBrr06cEQECBAgQWDGBDNnIuOsmZVJTArppxns/+MEPbt5e36/W5L2xg3qyogJmAa8op50RIECAAIG1JZDL4LXHsmaprgc+8IETnWTGSD72sY8dWxA/+8raqtJ8C1zg/AtCP3O+i+DsCRAgQIAAgcUEMpktE5xyTd8mZQH0zEzPkkiLTXbLpTCzlNMDHvCA5m31fRZCz8oP0nwLmAQy3/Xn7AkQIECAwKBAxgHm6kftS1vmTVkcPVduOvnkk+ur++S675kVn3wJGBM4tlPW/ssVf9qXlWu/7vH8CAgA56eunCkBAgQIEFi2QC7VmaVcttlmm2XtI5cyzFqz6VKW5l9AF/D816ESECBAgACBQYFM/vjQhz5UXwv4mte8ZpWAcJKUtVtz2c8XvvCFlat/TCI2H3m0AM5HPTlLAgQIECCwYgK58sd2221X7bjjjtU1rnGNenH3K13pSvWlRJtF+5v7E088cXQ1kBU7ATva4AICwA1eBU6AAAECBAhseIHM+M0YQGnjELAMzMZRz0pJgAABAgSWFBD8LclT3IsCwOKqVIEIECBAgAABAksLCACX9vEqAQIECBAgQKA4AQFgcVWqQAQIECBAgACBpQUEgEv7eJUAAQIECBAgUJyAALC4KlUgAgQIECBAgMDSAgLApX28SoAAAQIECBAoTkAAWFyVKhABAgQIECBAYGkBAeDSPl4lQIAAAQIECBQnIAAsrkoViAABAgQIECCwtIAAcGkfrxIgQIAAAQIEihMQABZXpQpEgAABAgQIEFha4IJLv+xVAgQIrJ7A9a53vWqLLbaoNt100+qqV71qdc4551Tf+973qve///3V73//+9U7kfOPtNlmm1WXv/zlR8f87ne/W5155pmj5+0H0+Rtv2+tP95yyy2ri1/84qPTPP3006uzzjpr9NwDAgTmV0AAOL9158wJFCOQYG+fffapdtppp2rdunW9cn3rW9+qvvCFL/S2z3LDYx7zmOqOd7zj6BDPetazqmOPPXb0vP1gmrzt9631xwcddFCVoLxJD3/4w6uTTz65eeqeAIE5FhAAznHlOXUCJQhc7GIXq1796ldX17zmNUsojjIQIEBgLgSMAZyLanKSBMoV+Pd///cFg79//OMf1bnnnltuwZWMAAECG1BAC+AGxHdoAgSq6u53v/sYw0knnVS97W1vq0455ZR6vNmVr3zl6s9//vNYHk8IECBAYP0EBIDr5+fdBAish8DlLne56rKXvexoD2n123///auzzz57tG2xiRejDDN68PWvf7268IUvPNr7L37xi9FjDwgQIDDvAgLAea9B509gjgWuda1rjZ39z3/+87Hgb+zFVX6ScYkSAQIEShUwBrDUmlUuAnMgcMELjv8G/ctf/jIHZ+0UCRAgMP8CAsD5r0MlIECAAAECBAhMJTD+83uqt8pMgMCGEMi4tOtc5zrVFa5whXqh4rSa/eY3v6kyRu2nP/3pzE7pAhe4QD1b94pXvGJ93A9/+MMzO9ZydrzJJptUGVN4latcpb5lAeOMJcwC0r/85S9narOc8+2+J8vhXPe6162udKUrVZe85CWrX/3qV6M6/etf/9rNvuznV7/61etFtlOPMctn5zvf+U7161//etn79EYCBOZPQAA4f3XmjDdSge222666973vXd32trcduzpDm+MHP/hBdcIJJ1RHHXXUxF/oCTZe97rXjXZzxhlnVM94xjNGzy9zmctU97vf/apdd921Dqzywt///vdqOQHgjjvuWD30oQ8d7bt9lYlszBVA3vGOd4xez4NPfvKT1WGHHTa2rf3kpje9abX77rtXd73rXccmbbTz5HGu5PGxj32svqpInIbSk570pOqWt7zlKNuRRx5ZHX/88aPnK/Vg2223rR74wAdWqd+LXOQivd1mBvQHP/jB2uWb3/xm7/VJNlziEpeo9txzz+pud7vb2MLOzXvPO++8KpNePvCBD1TvfOc7q7/97W/NS+4JEChUQABYaMUqVjkCCQr222+/6gEPeMBgoa597WtXe++9d3XPe96zevrTn1599rOfHXxPWvZyKbMm/elPf2oeVje84Q2rI444om5tHG1cjwdpoWsfq7urtG52X18s6EkraK7OcbOb3ay7mwWf54oWuT34wQ+uHv/4x1dZbmapdI1rXGPsXBIIr2S60IUuVO277751YLbQ1U+aY6Vl8L73vW99e9Ob3lS9/OUvrzJbetKUMh988MF1q/Fi78nxN9988/qWQP/Zz3529cUvfnGx7LYTIFCAgDGABVSiIpQrcP3rX79uzZsk+Gsr5Bq2r3zlK+tApzvRop1vqccJrNIymK7mtZbSTZrAdNLgr33+F73oResgavvtt29vXtXHmf2cYC7B6FLBX/ekHvKQh1SHH374ki2d7ffssMMO1Vve8pYlg792/jzOub3iFa9Ylm13X54TILB2BbQArt26cWYbucDWW29dd312uwX/8Ic/VJ///Oerr33ta3W3XVrVtthii/oLO/dNSmCRgCFB0iMe8YipWo3SNfuiF72outSlLtXsbuw+XcDLSRlnduqpp47emu7njHtr0jnnnFN1W/x+9KMfNS/X93lPuoRz/eB2+vGPf1x3lX7/+9+vx/yllSxrDG655ZZ193DGvjUpLY3PfOYzq3RJL7cszb6mvb/O+S2XRx99dK8bP9c6Pu200+o6/eMf/1jd+MY3rm5yk5tUt7/97ccCvnQZp3U3t6XSHnvsUaUbu5u+/e1v1129ccoYybTyxiifk7R6JiVIThCYrmGJAIEyBQSAZdarUs25QAbnH3jggb0xYV/60peqpzzlKfXkgHYRM3Yraeedd65fb4+tu8UtblHtsssu1Xve8572W5Z8/NjHPnYswPrGN75Rj3/73ve+V4+l6wZlS+6s9eJHPvKRKrcmJch97Wtf2zytfvKTn1R77bXX6PlCD9IamqClSQlS0hWc8i0UsGTcXwLGBLR3uMMdmrfVLZsJpibpJh+9aQUeHHDAAWPB329/+9v6/D/+8Y+P7b05rwSM3a7ue9zjHtXJJ59cHXfccWPvaZ6ku/oxj3lM83R0n1bh9njPvJCu3lx5JWmnnXaqA+N0T690l3d9AP8QILBmBHQBr5mqcCIE/k8gY/jaQU5eSVdeWvKWuiLF+9///npCRDdAe9zjHle36vzfERZ/lIDjQQ96UJ0hrWivf/3r667K17zmNdVHP/rROgDckJMEttlmm7GTT0tVAqGFgr8mY2bRpjUsraftlEknq5nufOc7V7e5zW1Gh8xM3wS03eBvlOH8B2mpy7jOBLLt9OhHP3qsZbD9Wlp+M/GjSbF5/vOf3wv+mteb+/yQyH4zc1oiQKBsAQFg2fWrdHMokEH/Cdja6Vvf+lZ16KGHTtSNmxmuL3jBC9pvr5cWGWpZa96QbtO0QKY7NgFnAqxzzz23eXmD3ue8ttpqq7FzeO973zv2fLEnKcNXv/rVsZczVnK1UjOZp328BGWTLL+SQPy5z31uldbCJmW5m3vd617N09F9xmzutttuo+d5kBa+zO6dJKWV+aCDDpokqzwECMyxgABwjivPqZcpcP/737/KGm3tdMghh0wU/DXvSfdh04XYbEsrUrtruNm+2P0xxxxTJRhYSymTYhKYZu263LJ0SVrRJk1ZD7CdElCuVspM3vY4xM997nNTLSuT4C8zgNvp7ne/e/tp/Titt/kR0U6TBn/Ne7L0Trr7JQIEyhVYvf/9yjVUMgIrKpB17dopEz5OPPHE9qaJHneDhQQF6d6dJKXL9I1vfOMkWVc1T1pC73KXu4xuWf9v0pSJH+mC3VAp6ze2UzdAb7+22OPPfOYzYy+lNbQ7USeTR9opn59J1j1svyddxm9+85vbmzwmQKAwAQFgYRWqOPMvkGU42ukrX/lK++nEjzObtntt3UkDwIwlPPPMMyc+1lrPeKtb3ap61ate1QuWVuu8s9ZiJuO005e//OX204kepwUzs52blP3e6EY3ap7W991xjd2xg2OZl3iSBcUlAgTKFTALuNy6VbI5Fchizu00betN89604vzwhz8cW8y4u+8mb/c+S8zMY8rM1Swrk0A3CyBnUelMplnNsX4LuaVLv9stm9ncyxlbmTGa7dQuW7q0m6Vcmjw///nPm4dT3WciSJajaU8mmWoHMhMgsKYFBIBrunqc3MYmkAWOu+P0EsQtN2UGafvKGpMGgMsNGpZ7nst9X9ZATJfwzW9+8/rWDX6Wu9+Vfl83aMv+s8bfSqR2AHi1q12t6i78vdSs8aHj/+xnP6tucIMbDGXzOgECcyggAJzDSnPK5QosFMBkbbzlpp/+9Kdjb21PQhh7ofNkfYKGzq5m8jSLQGepk1wbOYsWD6W0ZGW2cGbO3ulOdxrKvuKvz7IVrR0AXvnKV+6de3fiSy/DEhsyDEAAuASQlwjMsYAAcI4rz6mXJ7DQjNYs65EZr8tJ3cu4TbLkSI6zllsAMxM4E1RyRZBuypjHjH3MOoi5pQX0O9/5Tj0JImsXZhHmDZG6gXjOIVfkmOaavouddybsNGmhcZsJPpf7+Vmo5bI5lnsCBOZbQAA43/Xn7AsTSKCQQCVXYmhSum0z+3U5qTvpY9LxhH/605+Wc7iZvydj6XI1i27wl6uAfPCDH6xOOeWUqh0QzfyEJjxAWlRj2u7ef8ITnjA2oWPCXS2ZLV223c9PJoV0FwZfcietF7uX22u95CEBAnMuYBbwnFeg0y9LIC1C7VmeKd2k4/YWkujOKE6L2DynLJDdDkrStbv//vvXly876aST1mTwF+9MyOnaZ7zeSqd8frpDBrqzgic9ZhaubncvT/o++QgQmA8BAeB81JOz3IgEuoFC95Jwk1JkPFj3eq6TtgBOeozVznezm91s7JAZ15fL002aujNxJ33fSuT77ne/O7abScdjjr1pgifdOs71lpeTbn3rWy/nbd5DgMCcCAgA56SinObGI5Axa+1017vetV7apL1tkse5jFs7pXVonq/ukO7TLPHSTp/+9KfbTwcfb7nlloN5ZpWhW6/3uMc9pj5UZvi+4x3vqLJOY24JgLvj9DIGsp122GGHajmtgHvssUd7Nx4TIFCYgACwsApVnPkXyGW7/vznP48KksV+n/jEJ46eT/IgEyVy6bF2yji53/3ud+1Nc/U4Zepeuq0bVC1VoCwV0w0gl8q/0q/FP13WTdpmm22q7bbbrnk60f3tbne7elmftB7mlsk63Tp9+9vfPvb5iVlmTE+TsnTQLW95y2neIi8BAnMmIACcswpzuuULZNmOzHJtp+23377aaaed2psWfZyWsiwy3A6WMgHhsMMOW/Q98/DCQjNpt9hii4lO/dKXvnT1/Oc/v1q3bt1Y/vZkm7EXZvAk1/J905veNLbn/fbbr+rO1B7L0HqS5W4e/vCHt7ZU1Qc+8IGx53mSGb9ve9vbxrbf6173qnbcccexbYs9yVI5z3ve8xZ7edHtsW1fpq95nLUtJQIE1p6AAHDt1YkzIlBfh7W7pMdzn/vc6sADD6xyTdvFUhYXzpd/97JjCSgnXQJmsX1v6O05/+76hI961KOqBHdLpbSy5bq27ckjTf5u92mzfVb3Rx111Fg95Gol6dIdGm+XoP7FL35xtfnmm49OLUveLDb+MYHmH/7wh1HeBLoJgB/2sIeNti30ILPG81lJa+u0KT84XvKSl/Ru3WtbT7tf+QkQmI2AZWBm42qvBNZLIF/uL3/5y6vnPOc5Y/vZbbfd6q65j3zkI1Uu1/b1r3+9ytUw8iWb8W277LLL2BIyeXNazhIAlZBOO+20ejHnpixZpPjoo4+u3vrWt1aZBZzW00td6lJ1V2+Cq2233ba67W1v22Svr43cXjg63ZyZWHL22WfXl81biXX5Rgdb4EG69o844ojqaU972ujVtAAefvjh1fHHH1+lfKnTjOPLLNx08+Y6xqn39oSenOdTn/rU+rxHO2o9OOuss6ojjzyySgtjk9JCt88++1TpRv7iF79YffWrX62Pl3GFOU7GmqalsFliJ8vJZEmdWS5i3ZybewIEVl9AALj65o5IYCKBDPJP1+1BBx009uWf1plJW2g+85nPVM94xjOqc845Z6JjrvVML33pS6uMnWsHQ5ngMMkCz6effnodFL3sZS8bFTNXXmmC43Szt8fojTKt8IN3v/vd9QLQOecmGG13n05yuEMOOaQOGJfKm9bGXM83wWa71XirrbaqchtK+QFyn/vcp76m8lBerxMgMH8CuoDnr86c8UYkcMIJJ1S77rpr3WIzTbHTenPwwQdX++6777KvAjHN8VYrb9a4y7p/55577lSH/PCHP1w98pGPrL785S/XrYBTvXkGmY899tgqs2ynXeA7LXup17R6TpIyS3jvvfee6souWbMwE0kmPcYk5yEPAQJrT0AAuPbqxBkRGBPIWMCMdcsX/1DAkK7jT3ziE9Vee+1VpQUoX+alpS984Qv1NYATpKS8i6W0en7qU5+qA6CMnUzrXgKoQw89tHcJtg3hlHUB99xzz+oNb3hDlSt4LJXSRZ2u45133rmu16Xydl9Ll/Luu+9evetd7+rNGG7nTVD9+c9/vp5o8sIXvrDIz067vB4T2NgF1p2/NEJ53xAbe60qf9ECGa+VyR4ZO5bxfwl0MvMzEyTSwlVKd+8klZjxfhkHmAkeubJGulIzWSQB1VIWeU/G/2W8W66//LnPfW6qVrJJzm3aPFnwO+MWc/WNdHHnvHJVmNxSnr///e/T7rKXP0sKpfs3Vpmdm67hfHbyI+NLX/rS2MSR3pttIECgKAEBYFHVqTAECBAgQIAAgWEBXcDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHPoKB/QAACNZJREFUAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEhAAFlWdCkOAAAECBAgQGBYQAA4byUGAAAECBAgQKEpAAFhUdSoMAQIECBAgQGBYQAA4bCQHAQIECBAgQKAoAQFgUdWpMAQIECBAgACBYQEB4LCRHAQIECBAgACBogQEgEVVp8IQIECAAAECBIYFBIDDRnIQIECAAAECBIoSEAAWVZ0KQ4AAAQIECBAYFhAADhvJQYAAAQIECBAoSkAAWFR1KgwBAgQIECBAYFhAADhsJAcBAgQIECBAoCgBAWBR1akwBAgQIECAAIFhAQHgsJEcBAgQIECAAIGiBASARVWnwhAgQIAAAQIEhgUEgMNGchAgQIAAAQIEihIQABZVnQpDgAABAgQIEBgWEAAOG8lBgAABAgQIEChKQABYVHUqDAECBAgQIEBgWEAAOGwkBwECBAgQIECgKAEBYFHVqTAECBAgQIAAgWEBAeCwkRwECBAgQIAAgaIEBIBFVafCECBAgAABAgSGBQSAw0ZyECBAgAABAgSKEtjkvPPO+2ZRJVIYAgQIECBAgACBRQUS+13gKle5SloBr79u3borLJrTCwQIECBAgAABAnMvkODv/Nth/w9Sg1U8m1UKngAAAABJRU5ErkJggg==`
@@ -0,0 +1,280 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// artifactScheme prefixes the references of artifacts kept in a bucket
+const artifactScheme = "s3://"
+
+// ArtifactStore keeps the screenshots of captures in an S3 compatible
+// bucket, such as AWS S3 or MinIO, instead of on the scanning host.
+// Objects are addressed path style, which every implementation
+// supports. Requests are signed with AWS Signature Version 4.
+type ArtifactStore struct {
+	Endpoint *url.URL
+	Bucket   string
+	Region   string
+	Prefix   string
+
+	accessKey    string
+	secretKey    string
+	sessionToken string
+
+	client *http.Client
+}
+
+// NewArtifactStore returns an ArtifactStore for bucket. Without an
+// endpoint the AWS endpoint of region is used. The credentials are
+// read from the usual AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN environment variables.
+func NewArtifactStore(endpoint string, bucket string, region string, prefix string) (*ArtifactStore, error) {
+
+	if bucket == "" {
+		return nil, errors.New("a bucket is required")
+	}
+
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	if endpoint == "" {
+		endpoint = "https://s3." + region + ".amazonaws.com"
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.Errorf("invalid endpoint %q, use http(s)://host[:port]", endpoint)
+	}
+
+	store := &ArtifactStore{
+		Endpoint:     u,
+		Bucket:       bucket,
+		Region:       region,
+		Prefix:       strings.TrimPrefix(prefix, "/"),
+		accessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+		client:       &http.Client{Timeout: 60 * time.Second},
+	}
+
+	if store.accessKey == "" || store.secretKey == "" {
+		return nil, errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set")
+	}
+
+	return store, nil
+}
+
+// IsArtifactReference checks if a screenshot file refers to an
+// object in a bucket rather than a local file
+func IsArtifactReference(file string) bool {
+
+	return strings.HasPrefix(file, artifactScheme)
+}
+
+// ArtifactKey returns the key of the object a reference refers to,
+// without its bucket
+func ArtifactKey(reference string) string {
+
+	bucketKey := strings.TrimPrefix(reference, artifactScheme)
+	if slash := strings.Index(bucketKey, "/"); slash >= 0 {
+		return bucketKey[slash+1:]
+	}
+
+	return bucketKey
+}
+
+// Upload puts a local file in the bucket, removing it once it was
+// stored. The reference to the object is returned, to be kept in
+// place of the file name.
+func (store *ArtifactStore) Upload(file string) (string, error) {
+
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	key := store.Prefix + filepath.Base(file)
+	contentType := mime.TypeByExtension(filepath.Ext(file))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	req, err := store.request(http.MethodPut, key, content)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := store.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("storing %s: %s", key, s3Error(resp))
+	}
+
+	if err := os.Remove(file); err != nil {
+		log.WithFields(log.Fields{"file": file, "err": err}).Warn("Failed to remove uploaded screenshot")
+	}
+
+	reference := artifactScheme + store.Bucket + "/" + key
+	log.WithFields(log.Fields{"file": file, "reference": reference}).Debug("Uploaded screenshot")
+
+	return reference, nil
+}
+
+// Download writes the object a reference refers to into dir, keeping
+// the object's name, and returns the path it was written to. Objects
+// downloaded before are not downloaded again.
+func (store *ArtifactStore) Download(reference string, dir string) (string, error) {
+
+	bucketKey := strings.TrimPrefix(reference, artifactScheme)
+	slash := strings.Index(bucketKey, "/")
+	if slash < 0 {
+		return "", errors.Errorf("invalid artifact reference %q", reference)
+	}
+
+	bucket, key := bucketKey[:slash], bucketKey[slash+1:]
+	if bucket != store.Bucket {
+		return "", errors.Errorf("%s is in bucket %s, not %s", reference, bucket, store.Bucket)
+	}
+
+	local := filepath.Join(dir, path.Base(key))
+	if _, err := os.Stat(local); err == nil {
+		return local, nil
+	}
+
+	req, err := store.request(http.MethodGet, key, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := store.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("fetching %s: %s", key, s3Error(resp))
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return local, WriteFileAtomic(local, content, 0640)
+}
+
+// request prepares a signed request for the object key
+func (store *ArtifactStore) request(method string, key string, body []byte) (*http.Request, error) {
+
+	objectURL := *store.Endpoint
+	objectURL.Path = store.Endpoint.Path + "/" + store.Bucket + "/" + key
+	objectURL.RawPath = store.Endpoint.Path + "/" + s3Escape(store.Bucket) + "/" + s3Escape(key)
+
+	req, err := http.NewRequest(method, objectURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	store.sign(req, body, time.Now().UTC())
+
+	return req, nil
+}
+
+// sign adds the Signature Version 4 authorization to a request
+func (store *ArtifactStore) sign(req *http.Request, body []byte, now time.Time) {
+
+	payload := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(payload[:])
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	signed := "host;x-amz-content-sha256;x-amz-date"
+	headers := "host:" + req.URL.Host + "\nx-amz-content-sha256:" + payloadHash + "\nx-amz-date:" + amzDate + "\n"
+	if store.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", store.sessionToken)
+		signed += ";x-amz-security-token"
+		headers += "x-amz-security-token:" + store.sessionToken + "\n"
+	}
+
+	canonical := strings.Join([]string{req.Method, req.URL.EscapedPath(), req.URL.RawQuery, headers, signed, payloadHash}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonical))
+
+	scope := date + "/" + store.Region + "/s3/aws4_request"
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	key := []byte("AWS4" + store.secretKey)
+	for _, part := range []string{date, store.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		store.accessKey, scope, signed, hex.EncodeToString(hmacSHA256(key, toSign))))
+}
+
+// hmacSHA256 returns the HMAC-SHA256 of data with key
+func hmacSHA256(key []byte, data string) []byte {
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// s3Escape percent encodes a path the way Signature Version 4
+// expects it: everything but unreserved characters and slashes
+func s3Escape(p string) string {
+
+	var escaped strings.Builder
+	for _, b := range []byte(p) {
+
+		switch {
+		case b >= 'A' && b <= 'Z', b >= 'a' && b <= 'z', b >= '0' && b <= '9',
+			b == '-', b == '_', b == '.', b == '~', b == '/':
+			escaped.WriteByte(b)
+		default:
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+
+	return escaped.String()
+}
+
+// s3Error returns the status of a failed request, with the
+// error code S3 gave in the body if there is one
+func s3Error(resp *http.Response) string {
+
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+	if start := bytes.Index(body, []byte("<Code>")); start >= 0 {
+		if end := bytes.Index(body[start:], []byte("</Code>")); end >= 0 {
+			return resp.Status + " (" + string(body[start+len("<Code>"):start+end]) + ")"
+		}
+	}
+
+	return resp.Status
+}
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// authSchemeNames are the usual spelling of well known schemes, as
+// servers write them in any case
+var authSchemeNames = map[string]string{
+	"basic": "Basic", "digest": "Digest", "ntlm": "NTLM", "negotiate": "Negotiate", "bearer": "Bearer",
+}
+
+// AuthMethods returns the authentication schemes a 401 response
+// offers in its WWW-Authenticate headers, with their realms
+func AuthMethods(resp *http.Response) []storage.AuthMethod {
+
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		return nil
+	}
+
+	var methods []storage.AuthMethod
+	for _, header := range resp.Header["Www-Authenticate"] {
+		methods = append(methods, parseChallenges(header)...)
+	}
+
+	return methods
+}
+
+// parseChallenges parses the challenges of a WWW-Authenticate header,
+// such as `Basic realm="intranet", Negotiate`. A header may hold many
+// challenges, each a scheme followed by name=value parameters.
+func parseChallenges(header string) []storage.AuthMethod {
+
+	var methods []storage.AuthMethod
+	rest := header
+	for {
+
+		trimmed := strings.TrimLeft(rest, " \t,")
+		separated := strings.Contains(rest[:len(rest)-len(trimmed)], ",")
+		rest = trimmed
+		if rest == "" {
+			return methods
+		}
+
+		end := strings.IndexAny(rest, " \t,=")
+		if end < 0 {
+			end = len(rest)
+		}
+		token := rest[:end]
+		rest = rest[end:]
+
+		// a parameter of the current challenge
+		if after := strings.TrimLeft(rest, " \t"); strings.HasPrefix(after, "=") && len(methods) > 0 {
+
+			var value string
+			value, rest = parseParamValue(strings.TrimLeft(after[1:], " \t"))
+			if strings.EqualFold(token, "realm") {
+				methods[len(methods)-1].Realm = value
+			}
+			continue
+		}
+
+		// a token68 credential of the current challenge, such as an
+		// NTLM message, as challenges are separated by commas
+		if len(methods) > 0 && !separated {
+			rest = strings.TrimLeft(rest, "=")
+			continue
+		}
+
+		if name, ok := authSchemeNames[strings.ToLower(token)]; ok {
+			token = name
+		}
+		methods = append(methods, storage.AuthMethod{Scheme: token})
+	}
+}
+
+// parseParamValue reads a quoted string or token, returning it and
+// what follows it
+func parseParamValue(s string) (string, string) {
+
+	if !strings.HasPrefix(s, `"`) {
+
+		end := strings.IndexAny(s, " \t,")
+		if end < 0 {
+			return s, ""
+		}
+		return s[:end], s[end:]
+	}
+
+	var value strings.Builder
+	for i := 1; i < len(s); i++ {
+
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				value.WriteByte(s[i])
+			}
+		case '"':
+			return value.String(), s[i+1:]
+		default:
+			value.WriteByte(s[i])
+		}
+	}
+
+	return value.String(), ""
+}
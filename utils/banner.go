@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+const (
+	// maxBannerSize is the most bytes of a banner kept
+	maxBannerSize = 512
+
+	// bannerWait is how long a service is given to greet before
+	// it is assumed to wait for the client to speak first
+	bannerWait = 3 * time.Second
+)
+
+// GrabBanner connects to the port of u that did not answer HTTP and
+// records what the service there sends unprompted. Services that wait
+// for the client, such as most TLS ones, are tried with a TLS
+// handshake instead to read their certificate. Nil is returned when
+// the port can not be connected to, or nothing could be learnt.
+func GrabBanner(u *url.URL, options *Options) *storage.Banner {
+
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	address := net.JoinHostPort(u.Hostname(), port)
+	timeout := time.Duration(options.Timeout) * time.Second
+
+	conn, err := dialBanner(address, timeout, options)
+	if err != nil {
+		log.WithFields(log.Fields{"address": address, "err": err}).Debug("Port is not open, no banner to grab")
+		return nil
+	}
+
+	banner := &storage.Banner{Address: address}
+
+	buffer := make([]byte, maxBannerSize)
+	conn.SetReadDeadline(time.Now().Add(bannerWait))
+	read, _ := conn.Read(buffer)
+	conn.Close()
+
+	if read > 0 {
+		banner.Text = printableBanner(buffer[:read])
+		return banner
+	}
+
+	conn, err = dialBanner(address, timeout, options)
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(timeout))
+	client := tls.Client(conn, &tls.Config{InsecureSkipVerify: true, ServerName: u.Hostname()})
+	if err := client.Handshake(); err != nil {
+		log.WithFields(log.Fields{"address": address, "err": err}).Debug("Service is silent and does not speak TLS")
+		return nil
+	}
+
+	state := client.ConnectionState()
+	banner.TLSVersion = tlsVersionName(state.Version)
+	if len(state.PeerCertificates) > 0 {
+		leaf := state.PeerCertificates[0]
+		banner.TLSSubject = leaf.Subject.CommonName
+		banner.TLSIssuer = leaf.Issuer.CommonName
+		banner.TLSDNSNames = leaf.DNSNames
+	}
+
+	return banner
+}
+
+// dialBanner connects to address, through the resolver when there is
+// one so that --resolve pins apply
+func dialBanner(address string, timeout time.Duration, options *Options) (net.Conn, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if options.Resolver != nil {
+		return options.Resolver.DialContext(ctx, "tcp", address)
+	}
+
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", address)
+}
+
+// printableBanner returns a banner as text, escaping the bytes that
+// are not printable so that binary protocols stay readable
+func printableBanner(data []byte) string {
+
+	var text strings.Builder
+	for len(data) > 0 {
+
+		r, size := utf8.DecodeRune(data)
+		switch {
+		case r == '\n' || r == '\t':
+			text.WriteRune(r)
+		case r == '\r':
+		case r == utf8.RuneError && size <= 1, !unicode.IsPrint(r):
+			for _, b := range data[:size] {
+				fmt.Fprintf(&text, "\\x%02x", b)
+			}
+		default:
+			text.WriteRune(r)
+		}
+
+		data = data[size:]
+	}
+
+	return text.String()
+}
+
+// tlsVersionName returns the name of a TLS version, such as TLS 1.2
+func tlsVersionName(version uint16) string {
+
+	for _, known := range tlsScanVersions {
+		if known.version == version {
+			return known.name
+		}
+	}
+
+	return fmt.Sprintf("0x%04x", version)
+}
@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"image"
+	"image/draw"
+	"math"
+	"path/filepath"
+	"strings"
+)
+
+// BlurredFile returns the path of the blurred copy of a screenshot
+func BlurredFile(screenshot string) string {
+
+	extension := filepath.Ext(screenshot)
+	return strings.TrimSuffix(screenshot, extension) + "-blurred" + extension
+}
+
+// WriteBlurred writes a copy of a screenshot with a gaussian blur of
+// radius pixels next to it, in the screenshot's format. The copy
+// shows the layout of a page while its text can not be read, so that
+// it can be shared without what the page displays.
+func WriteBlurred(screenshot string, radius int, options *Options) (string, error) {
+
+	img, err := decodeImage(screenshot)
+	if err != nil {
+		return "", err
+	}
+
+	blurredFile := BlurredFile(screenshot)
+	return blurredFile, writeScreenshotImage(blurredFile, GaussianBlur(img, radius), options)
+}
+
+// GaussianBlur blurs an image with a gaussian of standard deviation
+// sigma, approximated by three successive box blurs
+func GaussianBlur(img image.Image, sigma int) *image.RGBA {
+
+	bounds := img.Bounds()
+	blurred := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(blurred, blurred.Bounds(), img, bounds.Min, draw.Src)
+
+	if sigma < 1 {
+		return blurred
+	}
+
+	scratch := make([]uint8, len(blurred.Pix))
+	for _, size := range gaussianBoxes(float64(sigma), 3) {
+
+		radius := (size - 1) / 2
+		boxBlur(blurred.Pix, scratch, blurred.Rect.Dx(), blurred.Rect.Dy(), 4, blurred.Stride, radius)
+		boxBlur(scratch, blurred.Pix, blurred.Rect.Dy(), blurred.Rect.Dx(), blurred.Stride, 4, radius)
+	}
+
+	return blurred
+}
+
+// gaussianBoxes returns the widths of n box blurs that together
+// approximate a gaussian blur of standard deviation sigma
+func gaussianBoxes(sigma float64, n int) []int {
+
+	ideal := math.Sqrt(12*sigma*sigma/float64(n) + 1)
+	lower := int(math.Floor(ideal))
+	if lower%2 == 0 {
+		lower--
+	}
+	upper := lower + 2
+
+	m := int(math.Round((12*sigma*sigma - float64(n*lower*lower+4*n*lower+3*n)) / float64(-4*lower-4)))
+
+	sizes := make([]int, n)
+	for i := range sizes {
+		if i < m {
+			sizes[i] = lower
+		} else {
+			sizes[i] = upper
+		}
+	}
+
+	return sizes
+}
+
+// boxBlur averages every pixel of src with the radius pixels on
+// either side of it along one axis, writing the result to dst. Lines
+// are lineStride bytes apart and pixels step bytes apart along them,
+// so the same pass blurs rows or columns. Edges are extended.
+func boxBlur(src []uint8, dst []uint8, length int, lines int, step int, lineStride int, radius int) {
+
+	width := 2*radius + 1
+	for line := 0; line < lines; line++ {
+
+		start := line * lineStride
+		at := func(i int) int {
+			if i < 0 {
+				i = 0
+			} else if i >= length {
+				i = length - 1
+			}
+			return start + i*step
+		}
+
+		for channel := 0; channel < 4; channel++ {
+
+			sum := 0
+			for i := -radius - 1; i < radius; i++ {
+				sum += int(src[at(i)+channel])
+			}
+
+			for i := 0; i < length; i++ {
+				sum += int(src[at(i+radius)+channel]) - int(src[at(i-radius-1)+channel])
+				dst[start+i*step+channel] = uint8(sum / width)
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// CaptureLogs keeps a log per capture of everything logged about its
+// URLs, debug messages included, so that a single capture can be
+// debugged without debug logging on the console. It hooks the standard
+// logger, which then logs everything, and writes the messages at the
+// console level to the console itself.
+type CaptureLogs struct {
+	console   log.Level
+	out       io.Writer
+	formatter log.Formatter
+
+	mu     sync.Mutex
+	active map[string][]*CaptureLog
+}
+
+// CaptureLog is the log of a single capture, recording the messages
+// logged with any of its URLs as the url field
+type CaptureLog struct {
+	urls  []string
+	lines bytes.Buffer
+}
+
+// NewCaptureLogs returns CaptureLogs hooked to the standard logger
+func NewCaptureLogs() *CaptureLogs {
+
+	logger := log.StandardLogger()
+	logs := &CaptureLogs{
+		console: log.GetLevel(),
+		out:     logger.Out,
+		formatter: &log.TextFormatter{
+			DisableColors:   true,
+			FullTimestamp:   true,
+			TimestampFormat: "15:04:05.000",
+		},
+		active: make(map[string][]*CaptureLog),
+	}
+
+	log.AddHook(logs)
+	log.SetOutput(ioutil.Discard)
+	log.SetLevel(log.DebugLevel)
+
+	return logs
+}
+
+// Levels returns the levels of the messages the hook sees
+func (logs *CaptureLogs) Levels() []log.Level {
+
+	return log.AllLevels
+}
+
+// Fire writes entry to the console when it is at the console level,
+// and adds it to the logs of the captures of its URL
+func (logs *CaptureLogs) Fire(entry *log.Entry) error {
+
+	logs.mu.Lock()
+	defer logs.mu.Unlock()
+
+	if entry.Level <= logs.console {
+		if serialized, err := entry.Logger.Formatter.Format(entry); err == nil {
+			logs.out.Write(serialized)
+		}
+	}
+
+	url, ok := entry.Data["url"]
+	if !ok {
+		return nil
+	}
+
+	captures := logs.active[fmt.Sprint(url)]
+	if len(captures) == 0 {
+		return nil
+	}
+
+	serialized, err := logs.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+	for _, capture := range captures {
+		capture.lines.Write(serialized)
+	}
+
+	return nil
+}
+
+// Start begins the log of a capture of url
+func (logs *CaptureLogs) Start(url string) *CaptureLog {
+
+	capture := &CaptureLog{}
+	logs.Watch(capture, url)
+
+	return capture
+}
+
+// Watch adds the messages logged about url to the log of capture, as
+// the URL captured changes along the way with redirects
+func (logs *CaptureLogs) Watch(capture *CaptureLog, url string) {
+
+	logs.mu.Lock()
+	defer logs.mu.Unlock()
+
+	for _, watched := range capture.urls {
+		if watched == url {
+			return
+		}
+	}
+
+	capture.urls = append(capture.urls, url)
+	logs.active[url] = append(logs.active[url], capture)
+}
+
+// Finish ends the log of capture, writing it to filename
+func (logs *CaptureLogs) Finish(capture *CaptureLog, filename string) {
+
+	logs.mu.Lock()
+	for _, url := range capture.urls {
+
+		var remaining []*CaptureLog
+		for _, active := range logs.active[url] {
+			if active != capture {
+				remaining = append(remaining, active)
+			}
+		}
+
+		if len(remaining) == 0 {
+			delete(logs.active, url)
+		} else {
+			logs.active[url] = remaining
+		}
+	}
+	logs.mu.Unlock()
+
+	if err := WriteFileAtomic(filename, capture.lines.Bytes(), 0640); err != nil {
+		log.WithFields(log.Fields{"capture-log": filename, "err": err}).Warn("Failed to write the capture log")
+	}
+}
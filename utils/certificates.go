@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// CertificateValidity checks the certificate chain presented in a TLS
+// connection against the system roots and host. Screenshots are taken
+// regardless of certificate errors, so this records whether the
+// certificate would actually have been trusted.
+func CertificateValidity(state *tls.ConnectionState, host string) string {
+
+	if len(state.PeerCertificates) == 0 {
+		return ""
+	}
+
+	leaf := state.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+	for _, c := range state.PeerCertificates[1:] {
+		intermediates.AddCert(c)
+	}
+
+	_, err := leaf.Verify(x509.VerifyOptions{DNSName: host, Intermediates: intermediates})
+	switch err := err.(type) {
+
+	case nil:
+		return storage.CertificateValid
+
+	case x509.CertificateInvalidError:
+		if err.Reason == x509.Expired {
+			return storage.CertificateExpired
+		}
+
+	case x509.HostnameError:
+		return storage.CertificateHostnameMismatch
+
+	case x509.UnknownAuthorityError:
+		if leaf.CheckSignatureFrom(leaf) == nil {
+			return storage.CertificateSelfSigned
+		}
+	}
+
+	return storage.CertificateUntrusted
+}
+
+// ChainIncomplete reports whether a presented chain stops short of a
+// root, the last certificate in it being neither self-signed nor issued
+// by one of the system roots, as when a server leaves an intermediate out
+func ChainIncomplete(certificates []*x509.Certificate) bool {
+
+	if len(certificates) == 0 {
+		return false
+	}
+
+	last := certificates[len(certificates)-1]
+	if last.CheckSignatureFrom(last) == nil {
+		return false
+	}
+
+	roots, err := x509.SystemCertPool()
+	if err != nil {
+		return false
+	}
+
+	_, err = last.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}})
+	_, unknown := err.(x509.UnknownAuthorityError)
+
+	return unknown
+}
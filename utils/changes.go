@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync/atomic"
+)
+
+// ContentHash returns a hash of what a URL responded with, used to
+// tell if it changed between scans
+func ContentHash(status int, body string) string {
+
+	hash := sha256.New()
+	hash.Write([]byte(strconv.Itoa(status) + "\n"))
+	hash.Write([]byte(body))
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// ChangeTracker counts the URLs that were not captured again because
+// their content had not changed since the previous capture
+type ChangeTracker struct {
+	unchanged int64
+}
+
+// NewChangeTracker returns an empty ChangeTracker
+func NewChangeTracker() *ChangeTracker {
+
+	return &ChangeTracker{}
+}
+
+// Skip records a URL whose content had not changed
+func (tracker *ChangeTracker) Skip() {
+
+	atomic.AddInt64(&tracker.unchanged, 1)
+}
+
+// Unchanged returns the number of URLs whose content had not changed
+func (tracker *ChangeTracker) Unchanged() int64 {
+
+	return atomic.LoadInt64(&tracker.unchanged)
+}
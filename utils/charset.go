@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"mime"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// langAttribute matches the lang attribute of the html element
+var langAttribute = regexp.MustCompile(`(?is)<html\b[^>]*?\blang\s*=\s*["']?([^"'\s>]+)`)
+
+// metaCharset matches the charset declared by a meta element, either
+// <meta charset> or the http-equiv Content-Type form
+var metaCharset = regexp.MustCompile(`(?is)<meta\b[^>]*?\bcharset\s*=\s*["']?([\w.:-]+)`)
+
+// metaPrescanBytes is how much of a body browsers search for a meta
+// charset declaration
+const metaPrescanBytes = 1024
+
+// DetectCharset determines the character set of a response body using
+// the Content-Type header, a byte order mark or a meta element
+func DetectCharset(body string, contentType string) string {
+
+	_, name, _ := charset.DetermineEncoding([]byte(body), contentType)
+
+	return name
+}
+
+// CheckCharset compares the character sets the Content-Type header and
+// a meta element declare with what the body is encoded in, returning
+// nil when they agree. Only UTF-8 can be told apart reliably from the
+// bytes, so a body is detected as UTF-8 when it is valid UTF-8 with
+// non ASCII text, and as windows-1252 when it is declared UTF-8 but
+// is not valid UTF-8.
+func CheckCharset(body string, contentType string) *storage.CharsetIssue {
+
+	mismatch := &storage.CharsetIssue{}
+	if _, params, err := mime.ParseMediaType(contentType); err == nil && params["charset"] != "" {
+		mismatch.Header = canonicalCharset(params["charset"])
+	}
+
+	prescan := body
+	if len(prescan) > metaPrescanBytes {
+		prescan = prescan[:metaPrescanBytes]
+	}
+	if match := metaCharset.FindStringSubmatch(prescan); len(match) > 1 {
+		mismatch.Meta = canonicalCharset(match[1])
+	}
+
+	declared := mismatch.Header
+	if declared == "" {
+		declared = mismatch.Meta
+	}
+	if declared == "" {
+		return nil
+	}
+
+	switch {
+	case !utf8.ValidString(body) && declared == "utf-8":
+		mismatch.Detected = "windows-1252"
+	case utf8.ValidString(body) && !isASCII(body) && declared != "utf-8":
+		mismatch.Detected = "utf-8"
+	}
+
+	if mismatch.Detected == "" && (mismatch.Header == "" || mismatch.Meta == "" || mismatch.Header == mismatch.Meta) {
+		return nil
+	}
+
+	return mismatch
+}
+
+// canonicalCharset returns the standard name of a charset label, such
+// as windows-1252 for latin1, or the label itself if it is unknown
+func canonicalCharset(label string) string {
+
+	if _, name := charset.Lookup(label); name != "" {
+		return name
+	}
+
+	return strings.ToLower(strings.TrimSpace(label))
+}
+
+// isASCII checks if a string only holds ASCII characters
+func isASCII(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DecodeBody converts a response body in the named character set
+// to UTF-8. The body is returned as is if it can't be decoded.
+func DecodeBody(body string, name string) string {
+
+	encoding, _ := charset.Lookup(name)
+	if encoding == nil {
+		return body
+	}
+
+	decoded, err := encoding.NewDecoder().String(body)
+	if err != nil {
+		return body
+	}
+
+	return decoded
+}
+
+// PageLanguage returns the lang attribute of the document, if any
+func PageLanguage(body string) string {
+
+	match := langAttribute.FindStringSubmatch(body)
+	if len(match) < 2 {
+		return ""
+	}
+
+	return strings.TrimSpace(match[1])
+}
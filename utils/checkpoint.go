@@ -0,0 +1,151 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// Checkpoint writes the progress of a scan to a JSON file every
+// interval, for scripts to poll. None is written by a nil Checkpoint.
+type Checkpoint struct {
+	path     string
+	interval time.Duration
+
+	mutex     sync.Mutex
+	state     CheckpointState
+	lastTime  time.Time
+	lastCount int64
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// CheckpointState is the content of the checkpoint file. Rate is the
+// URLs completed per second since the previous write, or over the whole
+// scan once finished, and ETASeconds the time the rest would take at
+// that rate, when it is known.
+type CheckpointState struct {
+	Completed  int64     `json:"completed"`
+	Total      int64     `json:"total"`
+	Failed     int64     `json:"failed"`
+	Rate       float64   `json:"rate"`
+	ETASeconds *int64    `json:"eta_seconds"`
+	StartedAt  time.Time `json:"started_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Finished   bool      `json:"finished"`
+}
+
+// NewCheckpoint starts writing the progress to path every interval,
+// until Close is called
+func NewCheckpoint(path string, interval time.Duration) *Checkpoint {
+
+	now := time.Now()
+	checkpoint := &Checkpoint{
+		path: path, interval: interval, lastTime: now,
+		state: CheckpointState{StartedAt: now},
+		stop:  make(chan struct{}), done: make(chan struct{}),
+	}
+
+	go func() {
+
+		defer close(checkpoint.done)
+
+		checkpoint.write(false)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				checkpoint.write(false)
+			case <-checkpoint.stop:
+				checkpoint.write(true)
+				return
+			}
+		}
+	}()
+
+	return checkpoint
+}
+
+// AddTotal adds URLs about to be captured to the total
+func (checkpoint *Checkpoint) AddTotal(urls int) {
+
+	if checkpoint == nil {
+		return
+	}
+
+	checkpoint.mutex.Lock()
+	defer checkpoint.mutex.Unlock()
+
+	checkpoint.state.Total += int64(urls)
+}
+
+// Complete counts a URL that is done with, which failed when it ended
+// with an error kind other than an HTTP error status
+func (checkpoint *Checkpoint) Complete(errorKind string) {
+
+	if checkpoint == nil {
+		return
+	}
+
+	checkpoint.mutex.Lock()
+	defer checkpoint.mutex.Unlock()
+
+	checkpoint.state.Completed++
+	if errorKind != "" && errorKind != storage.ErrorKindHTTP {
+		checkpoint.state.Failed++
+	}
+}
+
+// Close stops the periodic writes, writing the progress a last time
+// marked as finished
+func (checkpoint *Checkpoint) Close() {
+
+	if checkpoint == nil {
+		return
+	}
+
+	close(checkpoint.stop)
+	<-checkpoint.done
+}
+
+// write writes the progress to the checkpoint file
+func (checkpoint *Checkpoint) write(finished bool) {
+
+	checkpoint.mutex.Lock()
+	now := time.Now()
+	state := checkpoint.state
+	state.UpdatedAt = now
+	state.Finished = finished
+
+	if finished {
+		checkpoint.lastTime = state.StartedAt
+		checkpoint.lastCount = 0
+	}
+	if elapsed := now.Sub(checkpoint.lastTime).Seconds(); elapsed > 0 {
+		state.Rate = math.Round(float64(state.Completed-checkpoint.lastCount)/elapsed*100) / 100
+	}
+	if state.Rate > 0 && state.Total >= state.Completed {
+		eta := int64(float64(state.Total-state.Completed) / state.Rate)
+		state.ETASeconds = &eta
+	}
+	checkpoint.lastTime = now
+	checkpoint.lastCount = state.Completed
+	checkpoint.mutex.Unlock()
+
+	encoded, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return
+	}
+
+	if err := WriteFileAtomic(checkpoint.path, encoded, 0644); err != nil {
+		log.WithFields(log.Fields{"checkpoint-file": checkpoint.path, "err": err}).Warn("Failed to write the checkpoint file")
+	}
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxStructuredBody is the maximum number of bytes of a structured
+// response body kept for the report
+const maxStructuredBody int = 4096
+
+// maxSavedBody is the maximum number of bytes of a response body kept
+// with --save-body
+const maxSavedBody int = 1 << 20
+
+// MediaType returns the media type of a Content-Type, without its
+// parameters, or nothing when it does not parse
+func MediaType(contentType string) string {
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	return mediaType
+}
+
+// IsStructuredContent checks if a Content-Type is structured data
+// (JSON, XML, gRPC or protobuf) rather than a renderable page
+func IsStructuredContent(contentType string) bool {
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case mediaType == "application/json", strings.HasSuffix(mediaType, "+json"):
+		return true
+	case mediaType == "application/xml", mediaType == "text/xml", strings.HasSuffix(mediaType, "+xml"):
+		// xhtml is still a page
+		return mediaType != "application/xhtml+xml"
+	case strings.HasPrefix(mediaType, "application/grpc"), mediaType == "application/x-protobuf":
+		return true
+	}
+
+	return false
+}
+
+// StructuredBody returns a pretty-printed, truncated version of a
+// structured response body suitable for display in a report
+func StructuredBody(contentType string, body string) string {
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	var pretty string
+	switch {
+	case mediaType == "application/json", strings.HasSuffix(mediaType, "+json"):
+		var out bytes.Buffer
+		if err := json.Indent(&out, []byte(body), "", "  "); err == nil {
+			pretty = out.String()
+		} else {
+			pretty = body
+		}
+
+	case strings.Contains(mediaType, "xml"):
+		pretty = indentXML(body)
+
+	default:
+		// binary payloads such as protobuf can't be shown as text
+		return fmt.Sprintf("<%d bytes of %s>", len(body), mediaType)
+	}
+
+	// cut before any character that would be split
+	if len(pretty) > maxStructuredBody {
+		cut := maxStructuredBody
+		for cut > 0 && !utf8.RuneStart(pretty[cut]) {
+			cut--
+		}
+		pretty = pretty[:cut] + "\n..."
+	}
+
+	return pretty
+}
+
+// SavedBody returns the body kept with --save-body, cut before any
+// character that would be split
+func SavedBody(body string) string {
+
+	if len(body) <= maxSavedBody {
+		return body
+	}
+
+	cut := maxSavedBody
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+
+	return body[:cut]
+}
+
+// indentXML re-indents an XML document, returning it
+// unchanged if it can't be parsed
+func indentXML(body string) string {
+
+	var out bytes.Buffer
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	encoder := xml.NewEncoder(&out)
+	encoder.Indent("", "  ")
+
+	for {
+
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return body
+		}
+
+		// whitespace between elements is replaced by the indentation
+		if data, ok := token.(xml.CharData); ok && len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+
+		if err := encoder.EncodeToken(token); err != nil {
+			return body
+		}
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return body
+	}
+
+	return out.String()
+}
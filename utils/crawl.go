@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"net/url"
+	"sync"
+)
+
+// CrawlLink is a link found on a captured page, to be captured in turn
+type CrawlLink struct {
+	URL   *url.URL
+	Depth int
+}
+
+// Crawler collects the links of captured pages that lead to pages on
+// the same origin, so that they can be captured too. Links are only
+// followed MaxDepth links away from the URLs the crawl started from,
+// and every URL is only queued once.
+type Crawler struct {
+	MaxDepth int
+
+	mutex  sync.Mutex
+	seen   map[string]bool
+	queued []CrawlLink
+}
+
+// NewCrawler returns a Crawler following links up to maxDepth deep
+func NewCrawler(maxDepth int) *Crawler {
+
+	return &Crawler{MaxDepth: maxDepth, seen: make(map[string]bool)}
+}
+
+// Visit records a URL that is captured regardless of the crawl, so
+// that links to it are not queued
+func (crawler *Crawler) Visit(u *url.URL) {
+
+	crawler.mutex.Lock()
+	defer crawler.mutex.Unlock()
+
+	crawler.seen[crawlKey(u)] = true
+}
+
+// Queue queues the links of a page depth links away from where the
+// crawl started that are on its origin and have not been seen before,
+// returning how many were
+func (crawler *Crawler) Queue(pageURL *url.URL, depth int, links []string) int {
+
+	if depth >= crawler.MaxDepth {
+		return 0
+	}
+
+	crawler.mutex.Lock()
+	defer crawler.mutex.Unlock()
+
+	queued := 0
+	for _, link := range links {
+
+		u, err := url.Parse(link)
+		if err != nil || !sameOrigin(pageURL, u) {
+			continue
+		}
+
+		if key := crawlKey(u); !crawler.seen[key] {
+			crawler.seen[key] = true
+			crawler.queued = append(crawler.queued, CrawlLink{URL: u, Depth: depth + 1})
+			queued++
+		}
+	}
+
+	return queued
+}
+
+// Next returns the links queued since it was last called
+func (crawler *Crawler) Next() []CrawlLink {
+
+	crawler.mutex.Lock()
+	defer crawler.mutex.Unlock()
+
+	next := crawler.queued
+	crawler.queued = nil
+
+	return next
+}
+
+// crawlKey is how a URL is told apart from the others crawled
+func crawlKey(u *url.URL) string {
+
+	key := *u
+	key.Fragment = ""
+	if key.Path == "" {
+		key.Path = "/"
+	}
+
+	return key.String()
+}
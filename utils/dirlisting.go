@@ -0,0 +1,29 @@
+package utils
+
+import "regexp"
+
+// listingTitle matches the titles autoindex pages are given by Apache,
+// nginx, lighttpd, Tomcat and the development servers of Python
+var listingTitle = regexp.MustCompile(`(?i)^\s*(index of /|directory listing for)`)
+
+// listingBody matches the markup listing the files of a directory:
+// parent links and headings of autoindex pages, and IIS's listing
+// of [To Parent Directory] and <dir> entries
+var listingBody = regexp.MustCompile(`(?i)(parent directory|<a href="\.\./">|<h1>\s*(index of|directory listing for) /|\[to parent directory\]|&lt;dir&gt;)`)
+
+// iisListing matches the listings of IIS, which are titled with the
+// host and path and mark directories with <dir>
+var iisListing = regexp.MustCompile(`(?is)<pre>.*(\[to parent directory\]|&lt;dir&gt;)`)
+
+// DirectoryListing checks if a page is the listing of a directory a
+// web server generated, which exposes every file in the directory.
+// Pages titled like one must also list files, so that documentation
+// about directory listings is not flagged.
+func DirectoryListing(title string, body string) bool {
+
+	if !listingBody.MatchString(body) {
+		return false
+	}
+
+	return listingTitle.MatchString(title) || iisListing.MatchString(body)
+}
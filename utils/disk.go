@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// sizeUnits are the suffixes understood by ParseSize
+var sizeUnits = []struct {
+	suffix     string
+	multiplier int64
+}{
+	{"TB", 1 << 40}, {"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10}, {"B", 1},
+}
+
+// ParseSize parses a size such as 500MB or 10GB into bytes. A
+// size without a suffix is in bytes.
+func ParseSize(size string) (int64, error) {
+
+	size = strings.ToUpper(strings.TrimSpace(size))
+
+	multiplier := int64(1)
+	for _, unit := range sizeUnits {
+
+		if strings.HasSuffix(size, unit.suffix) {
+			size = strings.TrimSpace(strings.TrimSuffix(size, unit.suffix))
+			multiplier = unit.multiplier
+			break
+		}
+	}
+
+	// ParseFloat takes NaN and Inf as well
+	value, err := strconv.ParseFloat(size, 64)
+	if err != nil || value < 0 || math.IsNaN(value) || value*float64(multiplier) >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size: %q", size)
+	}
+
+	return int64(value * float64(multiplier)), nil
+}
+
+// DiskBudget tracks the bytes of screenshots written against a
+// limit, so that a scan stops before filling the disk
+type DiskBudget struct {
+	Limit int64
+
+	written int64
+	skipped int64
+	reached sync.Once
+}
+
+// NewDiskBudget returns a DiskBudget allowing limit bytes
+func NewDiskBudget(limit int64) *DiskBudget {
+
+	return &DiskBudget{Limit: limit}
+}
+
+// Add records bytes written
+func (budget *DiskBudget) Add(bytes int64) {
+
+	if atomic.AddInt64(&budget.written, bytes) >= budget.Limit {
+
+		budget.reached.Do(func() {
+			log.WithFields(log.Fields{"limit": budget.Limit, "written": budget.Written()}).
+				Warn("Disk limit reached, no further URLs will be captured")
+		})
+	}
+}
+
+// Exceeded checks if the limit has been reached
+func (budget *DiskBudget) Exceeded() bool {
+
+	return atomic.LoadInt64(&budget.written) >= budget.Limit
+}
+
+// Skip records a URL that was not captured because the
+// limit was reached
+func (budget *DiskBudget) Skip() {
+
+	atomic.AddInt64(&budget.skipped, 1)
+}
+
+// Written returns the number of bytes written
+func (budget *DiskBudget) Written() int64 {
+
+	return atomic.LoadInt64(&budget.written)
+}
+
+// Skipped returns the number of URLs skipped
+func (budget *DiskBudget) Skipped() int64 {
+
+	return atomic.LoadInt64(&budget.skipped)
+}
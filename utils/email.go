@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Mailer sends email through an SMTP server, upgrading the connection
+// with STARTTLS when the server offers it
+type Mailer struct {
+	server   string
+	from     string
+	to       []string
+	username string
+	password string
+}
+
+// NewMailer returns a Mailer sending from the address from to the
+// addresses to through server, a host:port. The server is logged in
+// to when a username is given.
+func NewMailer(server string, from string, to []string, username string, password string) (*Mailer, error) {
+
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		return nil, errors.Errorf("invalid smtp server %q, use host:port", server)
+	}
+
+	if _, err := mail.ParseAddress(from); err != nil {
+		return nil, errors.Wrapf(err, "invalid sender %q", from)
+	}
+
+	for _, address := range to {
+		if _, err := mail.ParseAddress(address); err != nil {
+			return nil, errors.Wrapf(err, "invalid recipient %q", address)
+		}
+	}
+
+	return &Mailer{server: server, from: from, to: to, username: username, password: password}, nil
+}
+
+// Send sends a plain text message
+func (mailer *Mailer) Send(subject string, body string) error {
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", mailer.from)
+	fmt.Fprintf(&message, "To: %s\r\n", strings.Join(mailer.to, ", "))
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	message.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	message.WriteString(strings.Replace(body, "\n", "\r\n", -1))
+
+	var auth smtp.Auth
+	if mailer.username != "" {
+		host, _, _ := net.SplitHostPort(mailer.server)
+		auth = smtp.PlainAuth("", mailer.username, mailer.password, host)
+	}
+
+	sender, _ := mail.ParseAddress(mailer.from)
+	return smtp.SendMail(mailer.server, auth, sender.Address, mailer.recipients(), message.Bytes())
+}
+
+// recipients returns the bare addresses of the recipients
+func (mailer *Mailer) recipients() []string {
+
+	var addresses []string
+	for _, to := range mailer.to {
+		if address, err := mail.ParseAddress(to); err == nil {
+			addresses = append(addresses, address.Address)
+		}
+	}
+
+	return addresses
+}
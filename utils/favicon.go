@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"encoding/base64"
+	"math/bits"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+	"github.com/RiskSense-Ops/gowitness/storage"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxFaviconSize is the largest favicon that is hashed. Anything bigger
+// is unlikely to be an icon.
+const maxFaviconSize = 256 << 10
+
+// maxFaviconData is the largest favicon whose data is stored to show in
+// the report. Only the hash of bigger ones is kept.
+const maxFaviconData = 16 << 10
+
+// defaultFavicons are the hashes, as calculated by FaviconHash, of
+// the icons applications ship with. Finding one usually means the
+// application was installed and left unconfigured.
+var defaultFavicons = map[int32]string{
+	116323821:  "Spring Boot",
+	-297069493: "Apache Tomcat",
+	81586312:   "Jenkins",
+	1485257654: "SonarQube",
+	-335242539: "F5 BIG-IP",
+	1278323681: "GitLab",
+	-476231906: "phpMyAdmin",
+	442749392:  "Microsoft OWA",
+	999357577:  "Hikvision",
+}
+
+var (
+	faviconLinkTag = regexp.MustCompile(`(?is)<link\s[^>]*>`)
+	faviconRel     = regexp.MustCompile(`(?is)\brel\s*=\s*["']?([^"'>]*)`)
+	faviconHref    = regexp.MustCompile(`(?is)\bhref\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+)
+
+// FaviconURL returns the URL of the favicon a page links to, or
+// /favicon.ico when it does not link to one
+func FaviconURL(pageURL *url.URL, body string) *url.URL {
+
+	for _, tag := range faviconLinkTag.FindAllString(body, -1) {
+
+		rel := faviconRel.FindStringSubmatch(tag)
+		if len(rel) < 2 || !strings.Contains(strings.ToLower(rel[1]), "icon") {
+			continue
+		}
+
+		href := faviconHref.FindStringSubmatch(tag)
+		if len(href) < 4 {
+			continue
+		}
+
+		if u, err := pageURL.Parse(strings.TrimSpace(href[1] + href[2] + href[3])); err == nil {
+			return u
+		}
+	}
+
+	return &url.URL{Scheme: pageURL.Scheme, Host: pageURL.Host, Path: "/favicon.ico"}
+}
+
+// FetchFavicon downloads the favicon of a page, returning nil if the
+// page does not have one
+func FetchFavicon(pageURL *url.URL, body string, chrome *chrm.Chrome, options *Options) *storage.Favicon {
+
+	faviconURL := FaviconURL(pageURL, body)
+	resp, icon, errs := newRequest(chrome, options).Get(faviconURL.String()).EndBytes()
+	if errs != nil {
+		log.WithFields(log.Fields{"url": pageURL, "favicon-url": faviconURL, "error": errs}).Debug("Failed to fetch favicon")
+		return nil
+	}
+
+	if resp.StatusCode != http.StatusOK || len(icon) == 0 || len(icon) > maxFaviconSize {
+		log.WithFields(log.Fields{"url": pageURL, "favicon-url": faviconURL, "status": resp.Status, "size": len(icon)}).
+			Debug("No usable favicon")
+		return nil
+	}
+
+	// servers commonly answer with an error page instead of a 404
+	contentType := http.DetectContentType(icon)
+	if strings.HasPrefix(contentType, "text/html") {
+		return nil
+	}
+
+	// svg icons are sniffed as text
+	if strings.HasPrefix(contentType, "text/") {
+		contentType = "image/svg+xml"
+	}
+
+	favicon := &storage.Favicon{
+		URL:  faviconURL.String(),
+		Hash: FaviconHash(icon),
+	}
+	if len(icon) <= maxFaviconData {
+		favicon.Data = "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(icon)
+	}
+	favicon.DefaultApp = defaultFavicons[favicon.Hash]
+	log.WithFields(log.Fields{
+		"url": pageURL, "favicon-url": favicon.URL, "hash": favicon.Hash, "default-app": favicon.DefaultApp,
+	}).Debug("Favicon")
+
+	return favicon
+}
+
+// FaviconHash returns the hash of a favicon the way Shodan calculates
+// it: the MurmurHash3 of the icon, base64 encoded with a newline after
+// every 76 characters. This allows the hash to be searched for there.
+func FaviconHash(icon []byte) int32 {
+
+	encoded := base64.StdEncoding.EncodeToString(icon)
+
+	var wrapped strings.Builder
+	for len(encoded) > 76 {
+		wrapped.WriteString(encoded[:76] + "\n")
+		encoded = encoded[76:]
+	}
+	wrapped.WriteString(encoded + "\n")
+
+	return int32(murmur3([]byte(wrapped.String())))
+}
+
+// murmur3 is the 32 bit x86 MurmurHash3, with a seed of 0
+func murmur3(data []byte) uint32 {
+
+	const c1, c2 = 0xcc9e2d51, 0x1b873593
+
+	var h uint32
+	blocks := len(data) / 4
+	for i := 0; i < blocks; i++ {
+
+		k := uint32(data[i*4]) | uint32(data[i*4+1])<<8 | uint32(data[i*4+2])<<16 | uint32(data[i*4+3])<<24
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+
+	var k uint32
+	tail := data[blocks*4:]
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+
+	return h
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// HeroFile returns the path of the hero crop of a screenshot
+func HeroFile(screenshot string) string {
+
+	extension := filepath.Ext(screenshot)
+	return strings.TrimSuffix(screenshot, extension) + "-hero" + extension
+}
+
+// WriteHero crops the top height pixels of a screenshot, the part
+// shown above the fold, writing it in the screenshot's format next
+// to it. Screenshots shorter than height are copied as they are.
+func WriteHero(screenshot string, height int, options *Options) (string, error) {
+
+	img, err := decodeImage(screenshot)
+	if err != nil {
+		return "", err
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dy() > height {
+		bounds.Max.Y = bounds.Min.Y + height
+	}
+
+	hero := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(hero, hero.Bounds(), img, bounds.Min, draw.Src)
+
+	heroFile := HeroFile(screenshot)
+	return heroFile, writeScreenshotImage(heroFile, hero, options)
+}
+
+// writeScreenshotImage encodes an image derived from a screenshot in
+// the format screenshots are saved in
+func writeScreenshotImage(path string, img image.Image, options *Options) error {
+
+	if options.ScreenshotFormat != "jpeg" {
+		return WritePNG(path, img)
+	}
+
+	var encoded bytes.Buffer
+	var err error
+	if options.JPEGSubsampling == Subsampling420 {
+		err = jpeg.Encode(&encoded, img, &jpeg.Options{Quality: options.JPEGQuality})
+	} else {
+		err = encodeJPEG444(&encoded, img, options.JPEGQuality)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, encoded.Bytes(), 0644)
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"strings"
+	"sync"
+)
+
+// HostLimiter bounds the number of captures of any one hostname that
+// run at a time, however many threads there are. A slot is acquired
+// before a thread is taken, so that threads are not left waiting on a
+// busy host. A nil HostLimiter does not limit anything.
+type HostLimiter struct {
+	limit int
+
+	mutex sync.Mutex
+	slots map[string]chan struct{}
+}
+
+// NewHostLimiter returns a HostLimiter letting at most limit captures
+// of a hostname run at once
+func NewHostLimiter(limit int) *HostLimiter {
+
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &HostLimiter{
+		limit: limit,
+		slots: make(map[string]chan struct{}),
+	}
+}
+
+// Acquire waits for a free slot for host, returning the function that
+// frees it again
+func (limiter *HostLimiter) Acquire(host string) func() {
+
+	if limiter == nil {
+		return func() {}
+	}
+
+	host = strings.ToLower(host)
+
+	limiter.mutex.Lock()
+	slots, ok := limiter.slots[host]
+	if !ok {
+		slots = make(chan struct{}, limiter.limit)
+		limiter.slots[host] = slots
+	}
+	limiter.mutex.Unlock()
+
+	slots <- struct{}{}
+
+	return func() { <-slots }
+}
+
+// InterleaveHosts returns the order to capture targets on hosts in, a
+// target of each host in turn, so that a list sorted by host does not
+// stall on the first one. Targets of a host keep their order.
+func InterleaveHosts(hosts []string) []int {
+
+	var names []string
+	byHost := make(map[string][]int)
+	for i, host := range hosts {
+
+		host = strings.ToLower(host)
+		if _, ok := byHost[host]; !ok {
+			names = append(names, host)
+		}
+		byHost[host] = append(byHost[host], i)
+	}
+
+	order := make([]int, 0, len(hosts))
+	for len(names) > 0 {
+
+		// hosts drop out of the rounds once their targets run out
+		remaining := names[:0]
+		for _, host := range names {
+			order = append(order, byHost[host][0])
+			if byHost[host] = byHost[host][1:]; len(byHost[host]) > 0 {
+				remaining = append(remaining, host)
+			}
+		}
+		names = remaining
+	}
+
+	return order
+}
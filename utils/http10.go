@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+)
+
+// http10Conn sends the request written to it as HTTP/1.0. Go's HTTP
+// client can only write HTTP/1.1 requests, so the version is swapped
+// in the request line on its way out.
+type http10Conn struct {
+	net.Conn
+	line    []byte
+	swapped bool
+}
+
+func (conn *http10Conn) Write(p []byte) (int, error) {
+
+	if conn.swapped {
+		return conn.Conn.Write(p)
+	}
+
+	conn.line = append(conn.line, p...)
+	end := bytes.Index(conn.line, []byte("\r\n"))
+	if end < 0 {
+		return len(p), nil
+	}
+
+	conn.swapped = true
+	if bytes.HasSuffix(conn.line[:end], []byte(" HTTP/1.1")) {
+		copy(conn.line[end-len("1.1"):end], "1.0")
+	}
+
+	if _, err := conn.Conn.Write(conn.line); err != nil {
+		return 0, err
+	}
+	conn.line = nil
+
+	return len(p), nil
+}
+
+// http10TLSConn is a http10Conn over TLS, keeping the connection state
+// readable so that responses still carry their certificates
+type http10TLSConn struct {
+	*http10Conn
+	tls *tls.Conn
+}
+
+func (conn *http10TLSConn) ConnectionState() tls.ConnectionState {
+	return conn.tls.ConnectionState()
+}
+
+// useHTTP10 makes transport send its requests as HTTP/1.0. Connections
+// are not kept alive, as HTTP/1.0 servers close them after a response.
+func useHTTP10(transport *http.Transport) {
+
+	dial := transport.DialContext
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+
+	transport.DisableKeepAlives = true
+	transport.DialContext = func(ctx context.Context, network string, address string) (net.Conn, error) {
+
+		conn, err := dial(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+
+		return &http10Conn{Conn: conn}, nil
+	}
+
+	// the request line is encrypted once it reaches the connection
+	// dialed for https, so TLS is done here, under the version swap
+	transport.DialTLSContext = func(ctx context.Context, network string, address string) (net.Conn, error) {
+
+		conn, err := dial(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+
+		config := transport.TLSClientConfig.Clone()
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			config.ServerName = host
+		}
+
+		tlsConn := tls.Client(conn, config)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		return &http10TLSConn{http10Conn: &http10Conn{Conn: tlsConn}, tls: tlsConn}, nil
+	}
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"image"
+	"image/png"
+	"os"
+)
+
+// decodeImage reads and decodes an image file
+func decodeImage(path string) (image.Image, error) {
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	return img, err
+}
+
+// ImageDimensions returns the width and height of an image file
+// without decoding the whole image
+func ImageDimensions(path string) (int, int, error) {
+
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	config, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return config.Width, config.Height, nil
+}
+
+// WritePNG encodes an image as PNG to path
+func WritePNG(path string, img image.Image) error {
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return png.Encode(file, img)
+}
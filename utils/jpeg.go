@@ -0,0 +1,400 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/bits"
+)
+
+// Chroma subsampling modes for JPEG screenshots. The standard library
+// encoder always uses 4:2:0, which smears the colour of small text, so
+// 4:4:4 is written by jpegEncoder instead.
+const (
+	Subsampling444 string = "444"
+	Subsampling420 string = "420"
+)
+
+// ConvertJPEG re-encodes the screenshot at path as a JPEG, in place
+func ConvertJPEG(path string, quality int, subsampling string) error {
+
+	img, err := decodeImage(path)
+	if err != nil {
+		return err
+	}
+
+	var encoded bytes.Buffer
+	if subsampling == Subsampling420 {
+		err = jpeg.Encode(&encoded, img, &jpeg.Options{Quality: quality})
+	} else {
+		err = encodeJPEG444(&encoded, img, quality)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, encoded.Bytes(), 0644)
+}
+
+// jpegZigzag maps the zig-zag order of coefficients to their
+// natural order in a block
+var jpegZigzag = [64]int{
+	0, 1, 8, 16, 9, 2, 3, 10, 17, 24, 32, 25, 18, 11, 4, 5,
+	12, 19, 26, 33, 40, 48, 41, 34, 27, 20, 13, 6, 7, 14, 21, 28,
+	35, 42, 49, 56, 57, 50, 43, 36, 29, 22, 15, 23, 30, 37, 44, 51,
+	58, 59, 52, 45, 38, 31, 39, 46, 53, 60, 61, 54, 47, 55, 62, 63,
+}
+
+// jpegQuant are the luminance and chrominance quantization tables from
+// section K.1 of the spec, in zig-zag order
+var jpegQuant = [2][64]int{
+	// Luminance.
+	{
+		16, 11, 12, 14, 12, 10, 16, 14,
+		13, 14, 18, 17, 16, 19, 24, 40,
+		26, 24, 22, 22, 24, 49, 35, 37,
+		29, 40, 58, 51, 61, 60, 57, 51,
+		56, 55, 64, 72, 92, 78, 64, 68,
+		87, 69, 55, 56, 80, 109, 81, 87,
+		95, 98, 103, 104, 103, 62, 77, 113,
+		121, 112, 100, 120, 92, 101, 103, 99,
+	},
+	// Chrominance.
+	{
+		17, 18, 18, 24, 21, 24, 47, 26,
+		26, 47, 99, 66, 56, 66, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+	},
+}
+
+// jpegHuffman are the Huffman tables from section K.3 of the spec, as
+// the number of codes of each length followed by the values, in the
+// order luminance DC, luminance AC, chrominance DC and chrominance AC
+var jpegHuffman = [4]struct {
+	counts [16]byte
+	values []byte
+}{
+	// Luminance DC.
+	{
+		[16]byte{0, 1, 5, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0},
+		[]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+	},
+	// Luminance AC.
+	{
+		[16]byte{0, 2, 1, 3, 3, 2, 4, 3, 5, 5, 4, 4, 0, 0, 1, 125},
+		[]byte{
+			0x01, 0x02, 0x03, 0x00, 0x04, 0x11, 0x05, 0x12,
+			0x21, 0x31, 0x41, 0x06, 0x13, 0x51, 0x61, 0x07,
+			0x22, 0x71, 0x14, 0x32, 0x81, 0x91, 0xa1, 0x08,
+			0x23, 0x42, 0xb1, 0xc1, 0x15, 0x52, 0xd1, 0xf0,
+			0x24, 0x33, 0x62, 0x72, 0x82, 0x09, 0x0a, 0x16,
+			0x17, 0x18, 0x19, 0x1a, 0x25, 0x26, 0x27, 0x28,
+			0x29, 0x2a, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39,
+			0x3a, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49,
+			0x4a, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59,
+			0x5a, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68, 0x69,
+			0x6a, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78, 0x79,
+			0x7a, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89,
+			0x8a, 0x92, 0x93, 0x94, 0x95, 0x96, 0x97, 0x98,
+			0x99, 0x9a, 0xa2, 0xa3, 0xa4, 0xa5, 0xa6, 0xa7,
+			0xa8, 0xa9, 0xaa, 0xb2, 0xb3, 0xb4, 0xb5, 0xb6,
+			0xb7, 0xb8, 0xb9, 0xba, 0xc2, 0xc3, 0xc4, 0xc5,
+			0xc6, 0xc7, 0xc8, 0xc9, 0xca, 0xd2, 0xd3, 0xd4,
+			0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0xda, 0xe1, 0xe2,
+			0xe3, 0xe4, 0xe5, 0xe6, 0xe7, 0xe8, 0xe9, 0xea,
+			0xf1, 0xf2, 0xf3, 0xf4, 0xf5, 0xf6, 0xf7, 0xf8,
+			0xf9, 0xfa,
+		},
+	},
+	// Chrominance DC.
+	{
+		[16]byte{0, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0},
+		[]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+	},
+	// Chrominance AC.
+	{
+		[16]byte{0, 2, 1, 2, 4, 4, 3, 4, 7, 5, 4, 4, 0, 1, 2, 119},
+		[]byte{
+			0x00, 0x01, 0x02, 0x03, 0x11, 0x04, 0x05, 0x21,
+			0x31, 0x06, 0x12, 0x41, 0x51, 0x07, 0x61, 0x71,
+			0x13, 0x22, 0x32, 0x81, 0x08, 0x14, 0x42, 0x91,
+			0xa1, 0xb1, 0xc1, 0x09, 0x23, 0x33, 0x52, 0xf0,
+			0x15, 0x62, 0x72, 0xd1, 0x0a, 0x16, 0x24, 0x34,
+			0xe1, 0x25, 0xf1, 0x17, 0x18, 0x19, 0x1a, 0x26,
+			0x27, 0x28, 0x29, 0x2a, 0x35, 0x36, 0x37, 0x38,
+			0x39, 0x3a, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48,
+			0x49, 0x4a, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58,
+			0x59, 0x5a, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68,
+			0x69, 0x6a, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78,
+			0x79, 0x7a, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87,
+			0x88, 0x89, 0x8a, 0x92, 0x93, 0x94, 0x95, 0x96,
+			0x97, 0x98, 0x99, 0x9a, 0xa2, 0xa3, 0xa4, 0xa5,
+			0xa6, 0xa7, 0xa8, 0xa9, 0xaa, 0xb2, 0xb3, 0xb4,
+			0xb5, 0xb6, 0xb7, 0xb8, 0xb9, 0xba, 0xc2, 0xc3,
+			0xc4, 0xc5, 0xc6, 0xc7, 0xc8, 0xc9, 0xca, 0xd2,
+			0xd3, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0xda,
+			0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe7, 0xe8, 0xe9,
+			0xea, 0xf2, 0xf3, 0xf4, 0xf5, 0xf6, 0xf7, 0xf8,
+			0xf9, 0xfa,
+		},
+	},
+}
+
+// jpegHuffmanClass are the table class and destination of jpegHuffman
+var jpegHuffmanClass = [4]byte{0x00, 0x10, 0x01, 0x11}
+
+// jpegCosines caches the DCT cosine terms
+var jpegCosines [8][8]float64
+
+func init() {
+
+	for x := 0; x < 8; x++ {
+		for u := 0; u < 8; u++ {
+			jpegCosines[x][u] = math.Cos(float64(2*x+1) * float64(u) * math.Pi / 16)
+		}
+	}
+}
+
+// jpegCode is a Huffman code and its length in bits
+type jpegCode struct {
+	code uint32
+	size uint
+}
+
+// jpegEncoder writes baseline JPEG images without chroma subsampling
+type jpegEncoder struct {
+	w     *bufio.Writer
+	bits  uint32
+	nBits uint
+	codes [4]map[byte]jpegCode
+	quant [2][64]float64
+}
+
+// encodeJPEG444 encodes an image as a baseline JPEG with 4:4:4
+// chroma subsampling
+func encodeJPEG444(w io.Writer, img image.Image, quality int) error {
+
+	encoder := &jpegEncoder{w: bufio.NewWriter(w)}
+
+	// scale the quantization tables the same way as libjpeg
+	scale := 200 - quality*2
+	if quality < 50 {
+		scale = 5000 / quality
+	}
+
+	var dqt []byte
+	for table := range jpegQuant {
+
+		dqt = append(dqt, byte(table))
+		for k, base := range jpegQuant[table] {
+
+			q := (base*scale + 50) / 100
+			if q < 1 {
+				q = 1
+			} else if q > 255 {
+				q = 255
+			}
+
+			dqt = append(dqt, byte(q))
+			encoder.quant[table][k] = float64(q)
+		}
+	}
+
+	var dht []byte
+	for table, spec := range jpegHuffman {
+
+		dht = append(dht, jpegHuffmanClass[table])
+		dht = append(dht, spec.counts[:]...)
+		dht = append(dht, spec.values...)
+
+		// assign the canonical codes
+		encoder.codes[table] = make(map[byte]jpegCode)
+		code, k := uint32(0), 0
+		for length, count := range spec.counts {
+			for i := byte(0); i < count; i++ {
+				encoder.codes[table][spec.values[k]] = jpegCode{code: code, size: uint(length + 1)}
+				code++
+				k++
+			}
+			code <<= 1
+		}
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	encoder.w.Write([]byte{0xff, 0xd8})
+	encoder.writeMarker(0xdb, dqt)
+	encoder.writeMarker(0xc0, []byte{
+		8, byte(height >> 8), byte(height), byte(width >> 8), byte(width), 3,
+		1, 0x11, 0, 2, 0x11, 1, 3, 0x11, 1,
+	})
+	encoder.writeMarker(0xc4, dht)
+	encoder.writeMarker(0xda, []byte{3, 1, 0x00, 2, 0x11, 3, 0x11, 0, 63, 0})
+
+	var previous [3]int
+	var blocks [3][64]float64
+	for by := 0; by < height; by += 8 {
+		for bx := 0; bx < width; bx += 8 {
+
+			for y := 0; y < 8; y++ {
+				for x := 0; x < 8; x++ {
+
+					// blocks past the edge repeat the last pixel
+					px, py := bx+x, by+y
+					if px >= width {
+						px = width - 1
+					}
+					if py >= height {
+						py = height - 1
+					}
+
+					r, g, b, _ := img.At(bounds.Min.X+px, bounds.Min.Y+py).RGBA()
+					yy, cb, cr := color.RGBToYCbCr(uint8(r>>8), uint8(g>>8), uint8(b>>8))
+
+					blocks[0][y*8+x] = float64(yy) - 128
+					blocks[1][y*8+x] = float64(cb) - 128
+					blocks[2][y*8+x] = float64(cr) - 128
+				}
+			}
+
+			for component := range blocks {
+				table := 0
+				if component > 0 {
+					table = 1
+				}
+				previous[component] = encoder.writeBlock(&blocks[component], table, previous[component])
+			}
+		}
+	}
+
+	// pad the last byte with 1 bits
+	encoder.emit(0x7f, 7)
+	encoder.w.Write([]byte{0xff, 0xd9})
+
+	return encoder.w.Flush()
+}
+
+// writeMarker writes a marker segment
+func (encoder *jpegEncoder) writeMarker(marker byte, data []byte) {
+
+	length := len(data) + 2
+	encoder.w.Write([]byte{0xff, marker, byte(length >> 8), byte(length)})
+	encoder.w.Write(data)
+}
+
+// emit writes size bits of code to the entropy coded data
+func (encoder *jpegEncoder) emit(code uint32, size uint) {
+
+	encoder.bits = encoder.bits<<size | code&(1<<size-1)
+	encoder.nBits += size
+
+	for encoder.nBits >= 8 {
+
+		b := byte(encoder.bits >> (encoder.nBits - 8))
+		encoder.w.WriteByte(b)
+		if b == 0xff {
+			encoder.w.WriteByte(0)
+		}
+
+		encoder.nBits -= 8
+		encoder.bits &= 1<<encoder.nBits - 1
+	}
+}
+
+// emitValue writes a Huffman coded symbol followed by the bits of
+// value, as described in section F.1.2 of the spec
+func (encoder *jpegEncoder) emitValue(table int, run int, value int) {
+
+	magnitude := value
+	if value < 0 {
+		magnitude = -value
+		value--
+	}
+
+	size := uint(bits.Len(uint(magnitude)))
+	code := encoder.codes[table][byte(run<<4)|byte(size)]
+	encoder.emit(code.code, code.size)
+	encoder.emit(uint32(value), size)
+}
+
+// writeBlock transforms, quantizes and writes a block, returning
+// its DC coefficient
+func (encoder *jpegEncoder) writeBlock(block *[64]float64, table int, previousDC int) int {
+
+	// the DCT is separable, so transform the rows and then the columns
+	var rows, coefficients [64]float64
+	for y := 0; y < 8; y++ {
+		for u := 0; u < 8; u++ {
+
+			sum := 0.0
+			for x := 0; x < 8; x++ {
+				sum += block[y*8+x] * jpegCosines[x][u]
+			}
+			rows[y*8+u] = sum
+		}
+	}
+
+	for u := 0; u < 8; u++ {
+		for v := 0; v < 8; v++ {
+
+			sum := 0.0
+			for y := 0; y < 8; y++ {
+				sum += rows[y*8+u] * jpegCosines[y][v]
+			}
+
+			cu, cv := 1.0, 1.0
+			if u == 0 {
+				cu = math.Sqrt2 / 2
+			}
+			if v == 0 {
+				cv = math.Sqrt2 / 2
+			}
+
+			coefficients[v*8+u] = sum * cu * cv / 4
+		}
+	}
+
+	var quantized [64]int
+	for k := range quantized {
+		quantized[k] = int(math.Round(coefficients[jpegZigzag[k]] / encoder.quant[table][k]))
+	}
+
+	encoder.emitValue(table*2, 0, quantized[0]-previousDC)
+
+	run := 0
+	for k := 1; k < 64; k++ {
+
+		if quantized[k] == 0 {
+			run++
+			continue
+		}
+
+		for run > 15 {
+			code := encoder.codes[table*2+1][0xf0]
+			encoder.emit(code.code, code.size)
+			run -= 16
+		}
+
+		encoder.emitValue(table*2+1, run, quantized[k])
+		run = 0
+	}
+
+	if run > 0 {
+		code := encoder.codes[table*2+1][0x00]
+		encoder.emit(code.code, code.size)
+	}
+
+	return quantized[0]
+}
@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// hostnameLabels matches a dotted hostname. url.Parse lets through
+// characters such as quotes that no hostname has.
+var hostnameLabels = regexp.MustCompile(`^[a-z0-9_]([a-z0-9_-]*[a-z0-9_])?(\.[a-z0-9_]([a-z0-9_-]*[a-z0-9_])?)*\.?$`)
+
+// ResolvePageLinks resolves the canonical and alternate links of a page
+// against its URL, dropping any that are not http or https URLs
+func ResolvePageLinks(pageURL *url.URL, links chrm.PageLinks) (string, []storage.Alternate) {
+
+	resolve := func(href string) string {
+
+		u, err := pageURL.Parse(strings.TrimSpace(href))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return ""
+		}
+
+		return u.String()
+	}
+
+	var alternates []storage.Alternate
+	for _, link := range links.Alternates {
+		if resolved := resolve(link.Href); resolved != "" {
+			alternates = append(alternates, storage.Alternate{Lang: strings.TrimSpace(link.Lang), URL: resolved})
+		}
+	}
+
+	canonical := ""
+	if links.Canonical != "" {
+		canonical = resolve(links.Canonical)
+	}
+
+	return canonical, alternates
+}
+
+// OffHostLinks returns the hosts, other than the page's own, that its
+// canonical and alternate links point to
+func OffHostLinks(pageURL *url.URL, canonical string, alternates []storage.Alternate) []string {
+
+	links := []string{canonical}
+	for _, alternate := range alternates {
+		links = append(links, alternate.URL)
+	}
+
+	var hosts []string
+	seen := map[string]bool{strings.ToLower(pageURL.Hostname()): true}
+	for _, link := range links {
+
+		u, err := url.Parse(link)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+
+		host := strings.ToLower(u.Hostname())
+		if !validHostname(host) {
+			continue
+		}
+
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts
+}
+
+// validHostname reports whether host is an IP address or a hostname
+// made of letters, digits, hyphens and underscores
+func validHostname(host string) bool {
+
+	if net.ParseIP(host) != nil {
+		return true
+	}
+
+	return len(host) <= 253 && hostnameLabels.MatchString(host)
+}
+
+// OutboundLinks resolves the hyperlinks of a page against its URL,
+// returning the http and https ones on its origin, and those on other
+// origins too when crossOrigin is set. Fragments are dropped, as they
+// link to the same page, and so are links to the page itself.
+func OutboundLinks(pageURL *url.URL, hrefs []string, crossOrigin bool) []string {
+
+	page := *pageURL
+	page.Fragment = ""
+
+	var links []string
+	seen := map[string]bool{page.String(): true}
+	for _, href := range hrefs {
+
+		u, err := pageURL.Parse(strings.TrimSpace(href))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			continue
+		}
+		u.Fragment = ""
+
+		if !crossOrigin && !sameOrigin(pageURL, u) {
+			continue
+		}
+
+		if link := u.String(); !seen[link] {
+			seen[link] = true
+			links = append(links, link)
+		}
+	}
+
+	return links
+}
+
+// sameOrigin reports whether two URLs share a scheme, host and port
+func sameOrigin(a *url.URL, b *url.URL) bool {
+
+	return strings.EqualFold(a.Scheme, b.Scheme) && strings.EqualFold(a.Hostname(), b.Hostname()) && urlPort(a) == urlPort(b)
+}
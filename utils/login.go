@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"net/url"
+	"regexp"
+)
+
+// DefaultLoginPattern matches the paths of common login pages
+const DefaultLoginPattern = `(?i)(^|/)(log[-_]?in|log[-_]?on|sign[-_]?in|sso|auth|oauth2?|saml2?|adfs|cas|idp|account/login)(/|\.|$|\?)`
+
+// LoginRedirect reports whether a request for requested ended up on
+// a login page at final. Only redirects to a different path count,
+// so that a login page requested directly is not flagged.
+func LoginRedirect(requested *url.URL, final *url.URL, pattern *regexp.Regexp) bool {
+
+	if pattern == nil || final == nil {
+		return false
+	}
+
+	if requested.Host == final.Host && requested.EscapedPath() == final.EscapedPath() {
+		return false
+	}
+
+	return pattern.MatchString(final.EscapedPath())
+}
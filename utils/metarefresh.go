@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/parnurzeal/gorequest"
+	log "github.com/sirupsen/logrus"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+)
+
+// maxMetaRefreshDelay is the longest delay in seconds of a meta refresh
+// that is followed. Longer ones are usually session timeouts sending
+// idle users to a logout page rather than redirects.
+const maxMetaRefreshDelay = 10
+
+var (
+	metaRefreshEquiv   = regexp.MustCompile(`(?is)\bhttp-equiv\s*=\s*["']?refresh\b`)
+	metaRefreshContent = regexp.MustCompile(`(?is)^\s*(\d+)(?:\.\d*)?\s*(?:[;,]\s*(?:url\s*=\s*)?(.*))?$`)
+)
+
+// MetaRefreshTarget returns the URL a <meta http-equiv="refresh"> tag in
+// body redirects to, resolved against base. Nil is returned when there
+// is no such tag, it only reloads the page or waits too long to count
+// as a redirect.
+func MetaRefreshTarget(base *url.URL, body string) *url.URL {
+
+	for _, tag := range metaTag.FindAllString(body, -1) {
+
+		if !metaRefreshEquiv.MatchString(tag) {
+			continue
+		}
+
+		content := metaContent.FindStringSubmatch(tag)
+		if len(content) < 4 {
+			continue
+		}
+
+		refresh := metaRefreshContent.FindStringSubmatch(html.UnescapeString(content[1] + content[2] + content[3]))
+		if len(refresh) < 3 {
+			continue
+		}
+
+		delay, err := strconv.Atoi(refresh[1])
+		if err != nil || delay > maxMetaRefreshDelay {
+			return nil
+		}
+
+		target := strings.Trim(strings.TrimSpace(refresh[2]), `"'`)
+		if target == "" {
+			return nil
+		}
+
+		resolved, err := base.Parse(target)
+		if err != nil || (resolved.Scheme != "http" && resolved.Scheme != "https") {
+			return nil
+		}
+		if resolved.String() == base.String() {
+			return nil
+		}
+
+		return resolved
+	}
+
+	return nil
+}
+
+// followMetaRefresh follows the meta refresh redirects of the page in
+// resp, recording each as a hop of the redirect chain in recorder. The
+// page is kept when it refreshes to a URL already in the chain, which
+// would loop, or once the chain reaches the redirect limit.
+func followMetaRefresh(resp gorequest.Response, body string, chrome *chrm.Chrome, options *Options,
+	recorder *redirectRecorder) (gorequest.Response, string, []error) {
+
+	for {
+
+		target := MetaRefreshTarget(resp.Request.URL, body)
+		if target == nil {
+			return resp, body, nil
+		}
+
+		if recorder.visited(target.String()) || len(recorder.hops) >= recorder.limit {
+			log.WithFields(log.Fields{"url": resp.Request.URL, "target": target}).
+				Warn("Not following meta refresh, it loops or the redirect limit was reached")
+			return resp, body, nil
+		}
+
+		log.WithFields(log.Fields{"url": resp.Request.URL, "target": target}).Info("Following meta refresh")
+		recorder.refresh(resp)
+
+		next, nextBody, errs := newRequest(chrome, options).RedirectPolicy(recorder.policy).Get(target.String()).End()
+		if errs != nil {
+			return next, nextBody, errs
+		}
+
+		resp, body = next, nextBody
+	}
+}
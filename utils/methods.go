@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// dangerousMethods are the methods that let a client change what a
+// server holds, or have requests reflected back at it
+var dangerousMethods = map[string]bool{"PUT": true, "DELETE": true, "TRACE": true}
+
+// AllowedMethods returns the methods a response lists in its Allow
+// header, or nil when it has none. Only 405 responses are required to
+// send one, so for others an OPTIONS request is needed.
+func AllowedMethods(resp *http.Response, source string) *storage.HTTPMethods {
+
+	if resp == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	allowed := &storage.HTTPMethods{Source: source}
+	for _, header := range resp.Header["Allow"] {
+		for _, method := range strings.Split(header, ",") {
+
+			method = strings.ToUpper(strings.TrimSpace(method))
+			if method == "" || seen[method] {
+				continue
+			}
+
+			seen[method] = true
+			allowed.Methods = append(allowed.Methods, method)
+			if dangerousMethods[method] {
+				allowed.Dangerous = append(allowed.Dangerous, method)
+			}
+		}
+	}
+
+	if len(allowed.Methods) == 0 {
+		return nil
+	}
+
+	return allowed
+}
+
+// FetchAllowedMethods sends an OPTIONS request to u, returning the
+// methods the server says it allows, or nil if it does not say
+func FetchAllowedMethods(u *url.URL, chrome *chrm.Chrome, options *Options) *storage.HTTPMethods {
+
+	resp, _, errs := newRequest(chrome, options).Options(u.String()).End()
+	if errs != nil {
+		log.WithFields(log.Fields{"url": u, "error": errs}).Debug("Failed to send OPTIONS request")
+		return nil
+	}
+
+	return AllowedMethods(resp, storage.MethodsFromOptions)
+}
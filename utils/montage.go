@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	// montagePadding is the space in pixels around each thumbnail
+	montagePadding int = 8
+	// montageCaptionHeight is the height in pixels of a caption
+	montageCaptionHeight int = 16
+)
+
+// MontageTile is a single screenshot in a montage
+type MontageTile struct {
+	File    string
+	Caption string
+}
+
+// Montage composites screenshots into a single grid image. Each
+// screenshot is scaled to thumbWidth and cropped from the top to a
+// 16:10 cell. Tiles that fail to decode are left blank.
+func Montage(tiles []MontageTile, columns int, thumbWidth int, captions bool) image.Image {
+
+	if columns < 1 {
+		columns = 1
+	}
+
+	thumbHeight := thumbWidth * 10 / 16
+	cellWidth := thumbWidth + montagePadding
+	cellHeight := thumbHeight + montagePadding
+	if captions {
+		cellHeight += montageCaptionHeight
+	}
+
+	rows := (len(tiles) + columns - 1) / columns
+	canvas := image.NewRGBA(image.Rect(0, 0, columns*cellWidth+montagePadding, rows*cellHeight+montagePadding))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(color.White), image.ZP, draw.Src)
+
+	face := basicfont.Face7x13
+	for i, tile := range tiles {
+
+		x := montagePadding + (i%columns)*cellWidth
+		y := montagePadding + (i/columns)*cellHeight
+		cell := image.Rect(x, y, x+thumbWidth, y+thumbHeight)
+
+		if src, err := decodeImage(tile.File); err == nil {
+
+			// crop the source to the aspect ratio of the cell, from the top
+			bounds := src.Bounds()
+			srcHeight := bounds.Dx() * thumbHeight / thumbWidth
+			if srcHeight > bounds.Dy() {
+				srcHeight = bounds.Dy()
+			}
+			srcRect := image.Rect(bounds.Min.X, bounds.Min.Y, bounds.Max.X, bounds.Min.Y+srcHeight)
+
+			draw.ApproxBiLinear.Scale(canvas, cell, src, srcRect, draw.Over, nil)
+		} else {
+			draw.Draw(canvas, cell, image.NewUniform(color.Gray{Y: 0xdd}), image.ZP, draw.Src)
+		}
+
+		if captions {
+
+			// truncate the caption to what fits under the thumbnail
+			caption := tile.Caption
+			if max := thumbWidth / face.Advance; len(caption) > max {
+				caption = caption[:max]
+			}
+
+			drawer := font.Drawer{
+				Dst:  canvas,
+				Src:  image.NewUniform(color.Black),
+				Face: face,
+				Dot:  fixed.P(x, y+thumbHeight+face.Ascent+2),
+			}
+			drawer.DrawString(caption)
+		}
+	}
+
+	return canvas
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+// NormalizeURL returns a canonical form of a URL, used to tell when
+// two differently written URLs are the same target. The scheme and
+// host are lowercased, default ports and fragments are dropped and an
+// empty path becomes /.
+func NormalizeURL(u *url.URL) string {
+
+	normalized := *u
+	normalized.Scheme = strings.ToLower(u.Scheme)
+	normalized.Fragment = ""
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if (normalized.Scheme == "http" && port == "80") || (normalized.Scheme == "https" && port == "443") {
+		port = ""
+	}
+
+	if port != "" {
+		normalized.Host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		normalized.Host = "[" + host + "]"
+	} else {
+		normalized.Host = host
+	}
+
+	if normalized.Path == "" {
+		normalized.Path = "/"
+	}
+
+	return normalized.String()
+}
@@ -0,0 +1,402 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math/bits"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+	"github.com/parnurzeal/gorequest"
+	"github.com/pkg/errors"
+)
+
+// NTLM negotiate flags
+const (
+	ntlmNegotiateUnicode          = 0x00000001
+	ntlmRequestTarget             = 0x00000004
+	ntlmNegotiateNTLM             = 0x00000200
+	ntlmNegotiateAlwaysSign       = 0x00008000
+	ntlmNegotiateExtendedSecurity = 0x00080000
+	ntlmNegotiateTargetInfo       = 0x00800000
+	ntlmNegotiate128              = 0x20000000
+	ntlmNegotiate56               = 0x80000000
+
+	ntlmNegotiateFlags = ntlmNegotiateUnicode | ntlmRequestTarget | ntlmNegotiateNTLM | ntlmNegotiateAlwaysSign |
+		ntlmNegotiateExtendedSecurity | ntlmNegotiateTargetInfo | ntlmNegotiate128 | ntlmNegotiate56
+)
+
+// ntlmSignature starts every NTLM message
+var ntlmSignature = []byte("NTLMSSP\x00")
+
+// NTLMCredentials are the domain credentials used to authenticate
+// to servers asking for NTLM or Negotiate authentication
+type NTLMCredentials struct {
+	Domain   string
+	User     string
+	Password string
+}
+
+// ParseNTLMUser splits a DOMAIN\user or user@domain login
+func ParseNTLMUser(login string, password string) *NTLMCredentials {
+
+	if slash := strings.Index(login, `\`); slash >= 0 {
+		return &NTLMCredentials{Domain: login[:slash], User: login[slash+1:], Password: password}
+	}
+
+	if at := strings.LastIndex(login, "@"); at >= 0 {
+		return &NTLMCredentials{Domain: login[at+1:], User: login[:at], Password: password}
+	}
+
+	return &NTLMCredentials{User: login, Password: password}
+}
+
+// NTLMScheme returns the NTLM capable scheme a 401 response asks for,
+// or an empty string if it does not ask for one
+func NTLMScheme(resp *http.Response) string {
+
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		return ""
+	}
+
+	var scheme string
+	for _, challenge := range resp.Header["Www-Authenticate"] {
+
+		name := strings.Fields(challenge + " ")[0]
+		if strings.EqualFold(name, "NTLM") {
+			return "NTLM"
+		}
+		if strings.EqualFold(name, "Negotiate") {
+			scheme = "Negotiate"
+		}
+	}
+
+	return scheme
+}
+
+// ntlmTransport authenticates requests that are answered with an
+// NTLM challenge. The handshake authenticates a connection rather than
+// a request, so it uses a transport of its own that only ever keeps one
+// connection to a host open.
+type ntlmTransport struct {
+	transport   *http.Transport
+	credentials *NTLMCredentials
+}
+
+// NewNTLMClient returns a client that authenticates with credentials,
+// using the settings of base for its connections. Hostnames are resolved
+// with resolver if it is not nil. The dialer of base is not used, as
+// --http10 makes it rewrite requests and the handshake needs HTTP/1.1.
+func NewNTLMClient(base *http.Transport, resolver *Resolver, credentials *NTLMCredentials, timeout time.Duration) *http.Client {
+
+	transport := &http.Transport{MaxIdleConnsPerHost: 1, IdleConnTimeout: 30 * time.Second}
+	if base != nil {
+		transport.TLSClientConfig = base.TLSClientConfig
+		transport.Proxy = base.Proxy
+	}
+	if resolver != nil {
+		transport.DialContext = resolver.DialContext
+	}
+
+	return &http.Client{Timeout: timeout, Transport: &ntlmTransport{transport: transport, credentials: credentials}}
+}
+
+// RoundTrip runs the NTLM handshake when the server asks for it
+func (t *ntlmTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := NTLMScheme(resp)
+	if scheme == "" {
+		return resp, nil
+	}
+	drain(resp)
+
+	negotiate := withAuthorization(req, scheme+" "+base64.StdEncoding.EncodeToString(ntlmNegotiateMessage()))
+	resp, err = t.transport.RoundTrip(negotiate)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := ntlmChallenge(resp, scheme)
+	if err != nil {
+		// without a challenge there is nothing better to return
+		// than the server's response
+		return resp, nil
+	}
+	drain(resp)
+
+	authenticate, err := ntlmAuthenticateMessage(challenge, t.credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.transport.RoundTrip(withAuthorization(req, scheme+" "+base64.StdEncoding.EncodeToString(authenticate)))
+}
+
+// CloseIdleConnections closes the authenticated connections that are
+// kept open, which no other request should reuse
+func (t *ntlmTransport) CloseIdleConnections() {
+
+	t.transport.CloseIdleConnections()
+}
+
+// fetchWithNTLM gets a URL with the NTLM client, in the same way as the
+// rest of the pre-flight requests are made
+func fetchWithNTLM(target string, chrome *chrm.Chrome, options *Options, recorder *redirectRecorder) (gorequest.Response, string, []error) {
+
+	client := NewNTLMClient(options.Transport, options.Resolver, options.NTLM, time.Duration(options.Timeout)*time.Second)
+	defer client.CloseIdleConnections()
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+
+		var previous []gorequest.Request
+		for _, request := range via {
+			previous = append(previous, request)
+		}
+		return recorder.policy(req, previous)
+	}
+
+	req, err := http.NewRequest("GET", target, nil)
+	if err != nil {
+		return nil, "", []error{err}
+	}
+	req.Header.Set("User-Agent", chrome.UserAgent)
+	for name, value := range chrome.Headers {
+		req.Header.Set(name, value)
+	}
+	for name, value := range chrome.Cookies {
+		req.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", []error{err}
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", []error{err}
+	}
+
+	return resp, string(body), nil
+}
+
+// withAuthorization returns a copy of req with an Authorization header
+func withAuthorization(req *http.Request, authorization string) *http.Request {
+
+	copied := *req
+	copied.Header = make(http.Header)
+	for key, values := range req.Header {
+		copied.Header[key] = values
+	}
+	copied.Header.Set("Authorization", authorization)
+
+	return &copied
+}
+
+// drain reads and closes a response body so that its connection
+// can be used for the next step of the handshake
+func drain(resp *http.Response) {
+
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<20))
+	resp.Body.Close()
+}
+
+// ntlmChallenge returns the challenge message of a response
+func ntlmChallenge(resp *http.Response, scheme string) ([]byte, error) {
+
+	for _, header := range resp.Header["Www-Authenticate"] {
+
+		fields := strings.Fields(header)
+		if len(fields) != 2 || !strings.EqualFold(fields[0], scheme) {
+			continue
+		}
+
+		challenge, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, errors.Wrap(err, "decoding NTLM challenge")
+		}
+
+		if len(challenge) < 48 || !bytes.Equal(challenge[:8], ntlmSignature) || binary.LittleEndian.Uint32(challenge[8:]) != 2 {
+			return nil, errors.New("invalid NTLM challenge")
+		}
+
+		return challenge, nil
+	}
+
+	return nil, errors.New("no NTLM challenge in response")
+}
+
+// ntlmNegotiateMessage returns the message that starts the handshake
+func ntlmNegotiateMessage() []byte {
+
+	message := make([]byte, 32)
+	copy(message, ntlmSignature)
+	binary.LittleEndian.PutUint32(message[8:], 1)
+	binary.LittleEndian.PutUint32(message[12:], ntlmNegotiateFlags)
+
+	return message
+}
+
+// ntlmAuthenticateMessage answers a challenge with an NTLMv2 response
+func ntlmAuthenticateMessage(challenge []byte, credentials *NTLMCredentials) ([]byte, error) {
+
+	flags := binary.LittleEndian.Uint32(challenge[20:]) & ntlmNegotiateFlags
+	serverChallenge := challenge[24:32]
+
+	infoLength := int(binary.LittleEndian.Uint16(challenge[40:]))
+	infoOffset := int(binary.LittleEndian.Uint32(challenge[44:]))
+	if infoOffset+infoLength > len(challenge) {
+		return nil, errors.New("invalid NTLM challenge target info")
+	}
+	targetInfo := challenge[infoOffset : infoOffset+infoLength]
+
+	// the server's time is preferred, so that clock skew doesn't matter
+	timestamp := make([]byte, 8)
+	binary.LittleEndian.PutUint64(timestamp, uint64(time.Now().UnixNano()/100+116444736000000000))
+	for info := targetInfo; len(info) >= 4; {
+
+		id := binary.LittleEndian.Uint16(info)
+		length := int(binary.LittleEndian.Uint16(info[2:]))
+		if id == 0 || len(info) < 4+length {
+			break
+		}
+		if id == 7 && length == 8 {
+			copy(timestamp, info[4:12])
+		}
+		info = info[4+length:]
+	}
+
+	clientChallenge := make([]byte, 8)
+	if _, err := rand.Read(clientChallenge); err != nil {
+		return nil, err
+	}
+
+	ntHash := md4(utf16le(credentials.Password))
+	v2Hash := hmacMD5(ntHash, utf16le(strings.ToUpper(credentials.User)+credentials.Domain))
+
+	var blob bytes.Buffer
+	blob.Write([]byte{1, 1, 0, 0, 0, 0, 0, 0})
+	blob.Write(timestamp)
+	blob.Write(clientChallenge)
+	blob.Write([]byte{0, 0, 0, 0})
+	blob.Write(targetInfo)
+	blob.Write([]byte{0, 0, 0, 0})
+
+	proof := hmacMD5(v2Hash, append(append([]byte{}, serverChallenge...), blob.Bytes()...))
+	ntResponse := append(proof, blob.Bytes()...)
+	lmResponse := append(hmacMD5(v2Hash, append(append([]byte{}, serverChallenge...), clientChallenge...)), clientChallenge...)
+
+	fields := [][]byte{lmResponse, ntResponse, utf16le(credentials.Domain), utf16le(credentials.User), utf16le(""), nil}
+
+	const headerSize = 64
+	message := make([]byte, headerSize)
+	copy(message, ntlmSignature)
+	binary.LittleEndian.PutUint32(message[8:], 3)
+
+	offset := headerSize
+	for i, field := range fields {
+		position := 12 + i*8
+		binary.LittleEndian.PutUint16(message[position:], uint16(len(field)))
+		binary.LittleEndian.PutUint16(message[position+2:], uint16(len(field)))
+		binary.LittleEndian.PutUint32(message[position+4:], uint32(offset))
+		offset += len(field)
+	}
+	binary.LittleEndian.PutUint32(message[60:], flags)
+
+	for _, field := range fields {
+		message = append(message, field...)
+	}
+
+	return message, nil
+}
+
+func hmacMD5(key []byte, data []byte) []byte {
+
+	mac := hmac.New(md5.New, key)
+	mac.Write(data)
+
+	return mac.Sum(nil)
+}
+
+func utf16le(s string) []byte {
+
+	encoded := utf16.Encode([]rune(s))
+	b := make([]byte, len(encoded)*2)
+	for i, r := range encoded {
+		binary.LittleEndian.PutUint16(b[i*2:], r)
+	}
+
+	return b
+}
+
+// md4 is the MD4 digest (RFC 1320) used for NTLM password hashes,
+// which the standard library does not have
+func md4(data []byte) []byte {
+
+	message := append([]byte{}, data...)
+	message = append(message, 0x80)
+	for len(message)%64 != 56 {
+		message = append(message, 0)
+	}
+	length := make([]byte, 8)
+	binary.LittleEndian.PutUint64(length, uint64(len(data))*8)
+	message = append(message, length...)
+
+	a, b, c, d := uint32(0x67452301), uint32(0xefcdab89), uint32(0x98badcfe), uint32(0x10325476)
+	for block := 0; block < len(message); block += 64 {
+
+		var x [16]uint32
+		for i := range x {
+			x[i] = binary.LittleEndian.Uint32(message[block+i*4:])
+		}
+
+		aa, bb, cc, dd := a, b, c, d
+
+		f := func(x, y, z uint32) uint32 { return x&y | ^x&z }
+		g := func(x, y, z uint32) uint32 { return x&y | x&z | y&z }
+		h := func(x, y, z uint32) uint32 { return x ^ y ^ z }
+
+		for _, i := range []int{0, 4, 8, 12} {
+			a = bits.RotateLeft32(a+f(b, c, d)+x[i], 3)
+			d = bits.RotateLeft32(d+f(a, b, c)+x[i+1], 7)
+			c = bits.RotateLeft32(c+f(d, a, b)+x[i+2], 11)
+			b = bits.RotateLeft32(b+f(c, d, a)+x[i+3], 19)
+		}
+		for _, i := range []int{0, 1, 2, 3} {
+			a = bits.RotateLeft32(a+g(b, c, d)+x[i]+0x5a827999, 3)
+			d = bits.RotateLeft32(d+g(a, b, c)+x[i+4]+0x5a827999, 5)
+			c = bits.RotateLeft32(c+g(d, a, b)+x[i+8]+0x5a827999, 9)
+			b = bits.RotateLeft32(b+g(c, d, a)+x[i+12]+0x5a827999, 13)
+		}
+		for _, i := range []int{0, 2, 1, 3} {
+			a = bits.RotateLeft32(a+h(b, c, d)+x[i]+0x6ed9eba1, 3)
+			d = bits.RotateLeft32(d+h(a, b, c)+x[i+8]+0x6ed9eba1, 9)
+			c = bits.RotateLeft32(c+h(d, a, b)+x[i+4]+0x6ed9eba1, 11)
+			b = bits.RotateLeft32(b+h(c, d, a)+x[i+12]+0x6ed9eba1, 15)
+		}
+
+		a, b, c, d = a+aa, b+bb, c+cc, d+dd
+	}
+
+	digest := make([]byte, 16)
+	binary.LittleEndian.PutUint32(digest[0:], a)
+	binary.LittleEndian.PutUint32(digest[4:], b)
+	binary.LittleEndian.PutUint32(digest[8:], c)
+	binary.LittleEndian.PutUint32(digest[12:], d)
+
+	return digest
+}
@@ -52,7 +52,8 @@ func Permutations(ips []string, ports []int, skipHTTP bool, skipHTTPS bool) ([]s
 }
 
 // ShufflePermutations and return a new slice.
-// 	https://gist.github.com/quux00/8258425
+//
+//	https://gist.github.com/quux00/8258425
 func ShufflePermutations(permutations []string) []string {
 
 	rand.Seed(time.Now().UTC().UnixNano())
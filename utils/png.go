@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"io"
+	"io/ioutil"
+)
+
+// DefaultPNGCompression is the zlib level of PNG screenshots that
+// leaves them as the browser encoded them
+const DefaultPNGCompression int = 6
+
+// ConvertPNG re-encodes the PNG screenshot at path in place, with the
+// zlib compression level, from 0 for none to 9 for the smallest file.
+// The standard library encoder only offers a few presets, so every
+// level is written by encodePNG instead.
+func ConvertPNG(path string, level int) error {
+
+	img, err := decodeImage(path)
+	if err != nil {
+		return err
+	}
+
+	var encoded bytes.Buffer
+	if err := encodePNG(&encoded, img, level); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, encoded.Bytes(), 0644)
+}
+
+// encodePNG writes img as an 8 bit RGB PNG, or RGBA if it is not
+// opaque, compressed at the zlib level
+func encodePNG(w io.Writer, img image.Image, level int) error {
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	opaque := true
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		opaque = o.Opaque()
+	}
+
+	bpp, colorType := 4, byte(6)
+	if opaque {
+		bpp, colorType = 3, 2
+	}
+
+	out := bufio.NewWriter(w)
+	out.WriteString("\x89PNG\r\n\x1a\n")
+
+	header := make([]byte, 13)
+	binary.BigEndian.PutUint32(header[0:], uint32(width))
+	binary.BigEndian.PutUint32(header[4:], uint32(height))
+	header[8], header[9] = 8, colorType
+	if err := writePNGChunk(out, "IHDR", header); err != nil {
+		return err
+	}
+
+	var data bytes.Buffer
+	compressor, err := zlib.NewWriterLevel(&data, level)
+	if err != nil {
+		return err
+	}
+
+	// each row is filtered with whichever filter leaves the smallest
+	// values, which is the usual heuristic of PNG encoders
+	stride := width * bpp
+	previous := make([]byte, stride)
+	current := make([]byte, stride)
+	var filtered [5][]byte
+	for i := range filtered {
+		filtered[i] = make([]byte, stride+1)
+		filtered[i][0] = byte(i)
+	}
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+
+			pixel := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			offset := (x - bounds.Min.X) * bpp
+			current[offset], current[offset+1], current[offset+2] = pixel.R, pixel.G, pixel.B
+			if !opaque {
+				current[offset+3] = pixel.A
+			}
+		}
+
+		row := filtered[0]
+		copy(row[1:], current)
+		if level != zlib.NoCompression {
+			row = filterPNGRow(filtered, current, previous, bpp)
+		}
+
+		if _, err := compressor.Write(row); err != nil {
+			return err
+		}
+
+		previous, current = current, previous
+	}
+
+	if err := compressor.Close(); err != nil {
+		return err
+	}
+
+	if err := writePNGChunk(out, "IDAT", data.Bytes()); err != nil {
+		return err
+	}
+	if err := writePNGChunk(out, "IEND", nil); err != nil {
+		return err
+	}
+
+	return out.Flush()
+}
+
+// filterPNGRow fills filtered with current filtered by each of the
+// PNG filters, returning the one whose bytes sum the lowest
+func filterPNGRow(filtered [5][]byte, current []byte, previous []byte, bpp int) []byte {
+
+	for i := range current {
+
+		var left, upperLeft byte
+		if i >= bpp {
+			left, upperLeft = current[i-bpp], previous[i-bpp]
+		}
+		up := previous[i]
+
+		filtered[0][i+1] = current[i]
+		filtered[1][i+1] = current[i] - left
+		filtered[2][i+1] = current[i] - up
+		filtered[3][i+1] = current[i] - byte((int(left)+int(up))/2)
+		filtered[4][i+1] = current[i] - paeth(left, up, upperLeft)
+	}
+
+	best, bestSum := 0, -1
+	for i, row := range filtered {
+
+		sum := 0
+		for _, value := range row[1:] {
+			if value < 128 {
+				sum += int(value)
+			} else {
+				sum += 256 - int(value)
+			}
+		}
+
+		if bestSum < 0 || sum < bestSum {
+			best, bestSum = i, sum
+		}
+	}
+
+	return filtered[best]
+}
+
+// paeth is the Paeth predictor of a byte from its left, upper and
+// upper left neighbours
+func paeth(left byte, up byte, upperLeft byte) byte {
+
+	estimate := int(left) + int(up) - int(upperLeft)
+	distanceLeft, distanceUp, distanceUpperLeft := abs(estimate-int(left)), abs(estimate-int(up)), abs(estimate-int(upperLeft))
+
+	if distanceLeft <= distanceUp && distanceLeft <= distanceUpperLeft {
+		return left
+	}
+	if distanceUp <= distanceUpperLeft {
+		return up
+	}
+
+	return upperLeft
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+// writePNGChunk writes a PNG chunk of kind with data, followed by its
+// checksum
+func writePNGChunk(w io.Writer, kind string, data []byte) error {
+
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[:4], uint32(len(data)))
+	copy(header[4:], kind)
+
+	checksum := crc32.NewIEEE()
+	checksum.Write(header[4:])
+	checksum.Write(data)
+
+	var footer [4]byte
+	binary.BigEndian.PutUint32(footer[:], checksum.Sum32())
+
+	for _, part := range [][]byte{header[:], data, footer[:]} {
+		if _, err := w.Write(part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,17 +2,23 @@ package utils
 
 import (
 	"crypto/tls"
+	"encoding/pem"
+	"net"
+	"net/http"
 	"net/url"
+	"os"
 	"path/filepath"
-        "regexp"
+	"regexp"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	chrm "github.com/RiskSense-Ops/gowitness/chrome"
 	log "github.com/sirupsen/logrus"
 
-	"github.com/parnurzeal/gorequest"
 	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/parnurzeal/gorequest"
 )
 
 const (
@@ -22,51 +28,497 @@ const (
 	HTTPS string = "https://"
 )
 
+// Options contains the options used when processing a URL
+type Options struct {
+	// Timeout is the time in seconds to wait for a HTTP connection
+	Timeout int
+
+	// DowngradeOnTLSError retries https targets over http when the
+	// TLS handshake fails
+	DowngradeOnTLSError bool
+
+	// Resolver is used to resolve hostnames before (and while)
+	// querying URLs
+	Resolver *Resolver
+
+	// Transport is shared by all of the HTTP requests made before
+	// taking a screenshot. Every request gets its own when nil.
+	Transport *http.Transport
+
+	// RawHeaders records the response headers as they were sent,
+	// at the cost of an extra request
+	RawHeaders bool
+
+	// TLSScan checks which TLS versions https targets accept,
+	// with a handshake per version
+	TLSScan bool
+
+	// HTTP2Push records the resources https targets push over
+	// HTTP/2, at the cost of an extra request
+	HTTP2Push bool
+
+	// FetchFavicon downloads the favicon of every page, at the cost
+	// of an extra request
+	FetchFavicon bool
+
+	// Engine takes the screenshots. Chrome is used when nil.
+	Engine chrm.Engine
+
+	// SaveRequest records the request that was sent, with
+	// secrets redacted
+	SaveRequest bool
+
+	// SaveBody keeps the response body, up to maxSavedBody bytes,
+	// for retitle --pattern to match
+	SaveBody bool
+
+	// BareHost marks a URL read without a scheme, which is tried
+	// over both in the order Schemes picks
+	BareHost bool
+	Schemes  *SchemeChooser
+
+	// Path is the --paths entry appended to the input URL to
+	// build this one, if any
+	Path string
+
+	// Source is what a search engine export reported about the
+	// URL, if it was read from one
+	Source *storage.SourceInfo
+
+	// Repeat numbers repeated captures of the same URL so that
+	// each is stored separately. 0 when not repeating.
+	Repeat int
+
+	// ScreenshotFormat is png or jpeg. JPEG screenshots are encoded
+	// with JPEGQuality and JPEGSubsampling.
+	ScreenshotFormat string
+	JPEGQuality      int
+	JPEGSubsampling  string
+
+	// PNGCompression is the zlib level PNG screenshots are
+	// re-encoded with, unless it is DefaultPNGCompression
+	PNGCompression int
+
+	// HeroHeight crops the top of every screenshot to this many
+	// pixels for the report cards. No crop is made when 0.
+	HeroHeight int
+
+	// NTLM authenticates to servers that ask for NTLM or
+	// Negotiate authentication, when not nil
+	NTLM *NTLMCredentials
+
+	// LoginPattern matches the paths of login pages, flagging the
+	// entries redirected to one as auth gated. Nothing is flagged
+	// when nil.
+	LoginPattern *regexp.Regexp
+
+	// MatchBody skips capturing pages whose body does not
+	// match it, when not nil
+	MatchBody *regexp.Regexp
+
+	// Tracer records a span per capture. Nothing is traced
+	// when nil.
+	Tracer *Tracer
+
+	// Disk limits the bytes of screenshots written. There is
+	// no limit when nil.
+	Disk *DiskBudget
+
+	// ScreenshotStatuses are the only response codes screenshots
+	// are taken of, when not empty. Entries are stored for all.
+	ScreenshotStatuses []int
+
+	// HTTP10 marks the pre-flight requests as sent with HTTP/1.0,
+	// which the transport is set up to do
+	HTTP10 bool
+
+	// MaxRedirects is the most redirects followed before a URL
+	// is recorded as a redirect loop. DefaultMaxRedirects when 0.
+	MaxRedirects int
+
+	// FollowMetaRefresh follows pages redirecting with a meta
+	// refresh tag, capturing the page they redirect to instead
+	FollowMetaRefresh bool
+
+	// Changes skips capturing URLs whose content has not changed
+	// since they were last captured, when not nil
+	Changes *ChangeTracker
+
+	// Artifacts stores screenshots in a bucket instead of on
+	// disk, when not nil
+	Artifacts *ArtifactStore
+
+	// BlurRadius also stores a copy of every screenshot blurred
+	// with this radius, for sharing. No copy is stored when 0.
+	BlurRadius int
+
+	// CaptureLogs writes a log of every capture next to its
+	// screenshot, when not nil
+	CaptureLogs *CaptureLogs
+
+	// AppendQuery is added to the query of every URL captured,
+	// when not empty
+	AppendQuery url.Values
+
+	// SaveLinks keeps the hyperlinks of every page on its origin,
+	// and on other origins too when CrossOriginLinks is set
+	SaveLinks        bool
+	CrossOriginLinks bool
+
+	// Crawler queues the links on the origin of every page to be
+	// captured as well, when not nil. CrawlDepth is how many links
+	// away from where the crawl started this URL is.
+	Crawler    *Crawler
+	CrawlDepth int
+
+	// TextFallback keeps the text of pages whose capture timed
+	// out, or whose body is larger than TextFallbackSize when it
+	// is not 0, instead of a screenshot
+	TextFallback     bool
+	TextFallbackSize int64
+
+	// CheckMethods sends an OPTIONS request to every URL to record
+	// the methods it allows
+	CheckMethods bool
+
+	// GrabBanner records what services on ports that do not answer
+	// HTTP say when connected to
+	GrabBanner bool
+
+	// Checkpoint counts the URLs processed, for the checkpoint
+	// file. Nothing is counted when nil.
+	Checkpoint *Checkpoint
+
+	// HostLimiter bounds the concurrent captures of each hostname, for
+	// the commands capturing many targets. Hosts are not limited when nil.
+	HostLimiter *HostLimiter
+}
+
+// RecordSkip stores that the target url was not captured for reason,
+// so that it shows up in export --skipped
+func RecordSkip(db *storage.Storage, url string, reason string) {
+
+	if err := db.SetSkipped(url, reason); err != nil {
+		log.WithFields(log.Fields{"url": url, "reason": reason, "err": err}).Warn("Failed to record the skipped target")
+	}
+}
+
 // ProcessURL processes a URL
-func ProcessURL(url *url.URL, chrome *chrm.Chrome, db *storage.Storage, timeout int) {
+func ProcessURL(url *url.URL, chrome *chrm.Chrome, db *storage.Storage, options *Options) {
+
+	// skips are recorded under the appended URL too, which is the one
+	// a later capture clears them with
+	if len(options.AppendQuery) > 0 {
+		url = AppendQuery(url, options.AppendQuery)
+	}
+
+	// Once the disk limit is reached nothing else is captured, leaving
+	// what has already been stored intact
+	if options.Disk != nil && options.Disk.Exceeded() {
+		log.WithField("url", url).Debug("Disk limit reached, skipping URL")
+		options.Disk.Skip()
+		RecordSkip(db, url.String(), storage.SkipDiskLimit)
+		options.Checkpoint.Complete("")
+
+		return
+	}
 
 	// prepare some storage for this URL
-	HTTPResponseStorage := storage.HTTResponse{URL: url.String()}
+	HTTPResponseStorage := storage.HTTResponse{
+		URL: url.String(), Path: options.Path, Repeat: options.Repeat, CapturedAt: time.Now(), Source: options.Source,
+		AppendedQuery: options.AppendQuery.Encode(), CrawlDepth: options.CrawlDepth,
+	}
+
+	// the capture log is written next to the screenshot once the
+	// capture is done, whichever way it ends
+	var captureLog *CaptureLog
+	if options.CaptureLogs != nil {
+		HTTPResponseStorage.CaptureLog = filepath.Join(chrome.ScreenshotPath,
+			SafeFileName(url.String())+"-"+HTTPResponseStorage.CapturedAt.Format("20060102T150405.000")+".log")
+		captureLog = options.CaptureLogs.Start(url.String())
+		defer options.CaptureLogs.Finish(captureLog, HTTPResponseStorage.CaptureLog)
+	}
 
 	// prepare a storage instance for this URL
 	log.WithField("url", url).Debug("Processing URL")
 
-	request := gorequest.New().Timeout(time.Duration(timeout)*time.Second).
-		TLSClientConfig(&tls.Config{InsecureSkipVerify: true}).
-		Set("User-Agent", chrome.UserAgent)
+	defer func() { options.Checkpoint.Complete(HTTPResponseStorage.ErrorKind) }()
+
+	span := options.Tracer.Start("capture")
+	defer func() {
+		span.SetAttribute("url.full", HTTPResponseStorage.URL)
+		span.SetAttribute("gowitness.final_url", HTTPResponseStorage.FinalURL)
+		span.SetAttribute("http.response.status_code", HTTPResponseStorage.ResponseCode)
+		span.SetAttribute("gowitness.engine", HTTPResponseStorage.Engine)
+		span.SetAttribute("gowitness.duration_ms", int64(time.Since(HTTPResponseStorage.CapturedAt)/time.Millisecond))
+		if HTTPResponseStorage.ErrorKind != "" {
+			span.SetAttribute("gowitness.error_kind", HTTPResponseStorage.ErrorKind)
+			span.SetError(HTTPResponseStorage.Error)
+		}
+		span.End()
+	}()
+
+	// Resolve the hostname first. Lookups are bounded separately from
+	// the number of threads so that huge lists don't overwhelm a resolver.
+	if options.Resolver != nil {
+
+		addresses, err := options.Resolver.Lookup(url.Hostname())
+		if err != nil {
+			log.WithFields(log.Fields{"url": url, "error": err}).Error("Failed to resolve host")
+
+			HTTPResponseStorage.ErrorKind = storage.ErrorKindDNS
+			HTTPResponseStorage.Error = err.Error()
+			db.SetHTTPData(&HTTPResponseStorage)
+
+			return
+		}
+
+		HTTPResponseStorage.Addresses = addresses
+	}
+
+	// hosts listed without a scheme are captured over the first
+	// one they answer on
+	if options.BareHost && options.Schemes != nil {
+		url = probeScheme(url, chrome, options)
+		HTTPResponseStorage.URL = url.String()
+		HTTPResponseStorage.ProbedScheme = url.Scheme
+		if captureLog != nil {
+			options.CaptureLogs.Watch(captureLog, url.String())
+		}
+	}
+
+	HTTPResponseStorage.Accept = chrome.AcceptHeader()
+	recorder := newRedirectRecorder(options.MaxRedirects)
+	resp, body, errs := newRequest(chrome, options).RedirectPolicy(recorder.policy).Get(url.String()).End()
+
+	// Legacy devices frequently have broken TLS stacks but still serve
+	// the same content over plain http. Retry those if we were asked to.
+	if errs != nil && options.DowngradeOnTLSError && url.Scheme == "https" && isTLSError(errs) {
+
+		downgradeURL := *url
+		downgradeURL.Scheme = "http"
+
+		log.WithFields(log.Fields{"url": url, "downgrade-url": downgradeURL.String(), "error": errs}).
+			Warn("TLS handshake failed, retrying over http")
+
+		recorder = newRedirectRecorder(options.MaxRedirects)
+		resp, body, errs = newRequest(chrome, options).RedirectPolicy(recorder.policy).Get(downgradeURL.String()).End()
+		HTTPResponseStorage.Downgraded = true
+	}
+
+	// intranet sites commonly ask for NTLM, which takes a handshake
+	// that the regular requests can't do
+	if errs == nil && options.NTLM != nil && NTLMScheme(resp) != "" {
+
+		log.WithFields(log.Fields{"url": url, "scheme": NTLMScheme(resp)}).Info("Authenticating with NTLM")
+
+		recorder = newRedirectRecorder(options.MaxRedirects)
+		resp, body, errs = fetchWithNTLM(resp.Request.URL.String(), chrome, options, recorder)
+		if errs == nil && resp.StatusCode != http.StatusUnauthorized {
+			HTTPResponseStorage.AuthScheme = "ntlm"
+		} else if errs == nil {
+			log.WithField("url", url).Warn("NTLM credentials were rejected")
+		}
+	}
+
+	// legacy pages redirect with a meta refresh, which would leave the
+	// screenshot on the intermediate page
+	if errs == nil && options.FollowMetaRefresh {
+		resp, body, errs = followMetaRefresh(resp, body, chrome, options, recorder)
+	}
 
-	resp, body, errs := request.Get(url.String()).End()
 	if errs != nil {
 		log.WithFields(log.Fields{"url": url, "error": errs}).Error("Failed to query url")
 
+		// keep a record of the failure so that it can be reported on
+		HTTPResponseStorage.ErrorKind = classifyError(errs)
+		HTTPResponseStorage.Error = joinErrors(errs)
+		if HTTPResponseStorage.ErrorKind == storage.ErrorKindRedirect {
+			HTTPResponseStorage.RedirectChain = recorder.partial()
+		}
+
+		// there is nothing listening on refused ports, and nothing
+		// to connect to without an address
+		if options.GrabBanner && HTTPResponseStorage.ErrorKind != storage.ErrorKindDNS &&
+			HTTPResponseStorage.ErrorKind != storage.ErrorKindRefused && HTTPResponseStorage.ErrorKind != storage.ErrorKindRedirect {
+
+			if banner := GrabBanner(url, options); banner != nil {
+				log.WithFields(log.Fields{"url": url, "address": banner.Address, "banner": banner.Text, "tls-subject": banner.TLSSubject}).
+					Info("Port does not answer HTTP, grabbed its banner")
+				HTTPResponseStorage.ErrorKind = storage.ErrorKindNotHTTP
+				HTTPResponseStorage.Banner = banner
+			}
+		}
+
+		db.SetHTTPData(&HTTPResponseStorage)
+
 		return
 	}
 
-        // extract page title
-        re := regexp.MustCompile(`(?i)<title>\s*(.*?)\s*</title>`)
-        var match = re.FindStringSubmatch(body)
-        HTTPResponseStorage.PageTitle = ""
-        if len(match) >= 2 {
-                HTTPResponseStorage.PageTitle = match[1]
-                log.WithField("title", match[1]).Info("Page Title")
-        }
+	// the schemes a 401 offers tell how to follow it up
+	HTTPResponseStorage.AuthRequired = AuthMethods(resp)
+
+	// a 405 lists the methods that would have been accepted
+	if resp.StatusCode == http.StatusMethodNotAllowed {
+		HTTPResponseStorage.AllowedMethods = AllowedMethods(resp, storage.MethodsFromRejected)
+	} else if options.CheckMethods {
+		HTTPResponseStorage.AllowedMethods = FetchAllowedMethods(resp.Request.URL, chrome, options)
+	}
+	if methods := HTTPResponseStorage.AllowedMethods; methods != nil {
+		log.WithFields(log.Fields{"url": url, "methods": methods.Methods, "dangerous": methods.Dangerous}).Info("Allowed methods")
+	}
+
+	// titles can only be extracted correctly once the body is UTF-8
+	HTTPResponseStorage.Charset = DetectCharset(body, resp.Header.Get("Content-Type"))
+	HTTPResponseStorage.CharsetMismatch = CheckCharset(body, resp.Header.Get("Content-Type"))
+	HTTPResponseStorage.SniffMismatch = CheckContentType(body, resp.Header)
+	if HTTPResponseStorage.SniffMismatch != nil {
+		log.WithFields(log.Fields{"url": url, "content-type": HTTPResponseStorage.SniffMismatch.Declared,
+			"sniffed": HTTPResponseStorage.SniffMismatch.Sniffed}).Info("Content-Type disagrees with the body")
+	}
+	if HTTPResponseStorage.CharsetMismatch != nil && HTTPResponseStorage.CharsetMismatch.Detected != "" {
+		HTTPResponseStorage.Charset = HTTPResponseStorage.CharsetMismatch.Detected
+	}
+	HTTPResponseStorage.Lang = PageLanguage(body)
+	body = DecodeBody(body, HTTPResponseStorage.Charset)
+	log.WithFields(log.Fields{"url": url, "charset": HTTPResponseStorage.Charset, "lang": HTTPResponseStorage.Lang}).
+		Debug("Page charset and language")
+
+	// extract page title
+	re := regexp.MustCompile(`(?i)<title>\s*(.*?)\s*</title>`)
+	var match = re.FindStringSubmatch(body)
+	HTTPResponseStorage.PageTitle = ""
+	if len(match) >= 2 {
+		HTTPResponseStorage.PageTitle = match[1]
+		log.WithField("title", match[1]).Info("Page Title")
+	}
+	HTTPResponseStorage.TitleSources = ExtractTitleSources(body)
+
+	// when hunting for a signature, pages without it are not
+	// captured at all
+	if options.MatchBody != nil && !options.MatchBody.MatchString(body) {
+		log.WithFields(log.Fields{"url": url, "match-body": options.MatchBody}).Info("Body does not match, skipping URL")
+		RecordSkip(db, url.String(), storage.SkipMatchBody)
+
+		return
+	}
 
 	// update the response code
 	HTTPResponseStorage.ResponseCode = resp.StatusCode
 	HTTPResponseStorage.ResponseCodeString = resp.Status
-	log.WithFields(log.Fields{"url": url, "status": resp.Status}).Info("Response code")
+	HTTPResponseStorage.Protocol = resp.Proto
+	HTTPResponseStorage.HTTP10 = options.HTTP10
+	log.WithFields(log.Fields{"url": url, "status": resp.Status, "protocol": resp.Proto}).Info("Response code")
+
+	if resp.StatusCode >= 400 {
+		HTTPResponseStorage.ErrorKind = storage.ErrorKindHTTP
+		HTTPResponseStorage.Error = resp.Status
+	}
+
+	// when monitoring, only captures that changed are kept. The
+	// previous capture remains, recording that it was checked again.
+	HTTPResponseStorage.ContentHash = ContentHash(resp.StatusCode, body)
+	HTTPResponseStorage.CheckedAt = HTTPResponseStorage.CapturedAt
+	if options.Changes != nil {
+
+		previous, err := db.GetHTTPEntry(HTTPResponseStorage.Key())
+		if err != nil {
+			log.WithFields(log.Fields{"url": url, "err": err}).Warn("Failed to read the previous capture")
+		} else if previous != nil && previous.ContentHash == HTTPResponseStorage.ContentHash {
+
+			if err := db.MarkUnchanged(HTTPResponseStorage.Key(), HTTPResponseStorage.CapturedAt); err != nil {
+				log.WithFields(log.Fields{"url": url, "err": err}).Warn("Failed to update the previous capture")
+			}
+
+			log.WithFields(log.Fields{"url": url, "captured-at": previous.CapturedAt}).
+				Info("Content unchanged since the last capture, skipping URL")
+			options.Changes.Skip()
+			RecordSkip(db, url.String(), storage.SkipUnchanged)
+
+			return
+		}
+	}
 
 	finalURL := resp.Request.URL
 	HTTPResponseStorage.FinalURL = resp.Request.URL.String()
+	if captureLog != nil {
+		options.CaptureLogs.Watch(captureLog, finalURL.String())
+	}
 	log.WithFields(log.Fields{"url": url, "final-url": finalURL}).Info("Final URL after redirects")
 
-	// process response headers
-	for k, v := range resp.Header {
-		headerValue := strings.Join(v, ", ")
-		storageHeader := storage.HTTPHeader{Key: k, Value: headerValue}
-		HTTPResponseStorage.Headers = append(HTTPResponseStorage.Headers, storageHeader)
+	if pinned := chrm.PinnedAddress(chrome.Resolve, finalURL.Hostname(), urlPort(finalURL)); pinned != "" {
+		HTTPResponseStorage.PinnedAddress = pinned
+		log.WithFields(log.Fields{"url": url, "host": finalURL.Hostname(), "address": pinned}).Debug("Pinned address")
+	}
+
+	HTTPResponseStorage.RedirectChain = recorder.finish(resp)
+	for _, hop := range HTTPResponseStorage.RedirectChain {
+		log.WithFields(log.Fields{"url": url, "hop": hop.URL, "status": hop.StatusCode, "duration": hop.Duration}).
+			Debug("Redirect hop")
+	}
+
+	// a login page captured after a redirect hides that the resource
+	// itself needs authentication
+	requestedURL := url
+	if first := HTTPResponseStorage.RedirectChain[0].URL; first != "" {
+		if parsed, err := url.Parse(first); err == nil {
+			requestedURL = parsed
+		}
+	}
+	if LoginRedirect(requestedURL, finalURL, options.LoginPattern) {
+		HTTPResponseStorage.LoginRedirect = true
+		HTTPResponseStorage.LoginRedirectFrom = requestedURL.String()
+		log.WithFields(log.Fields{"url": url, "requested-url": requestedURL, "final-url": finalURL}).
+			Info("Redirected to a login page")
+	}
 
-		log.WithFields(log.Fields{"url": url, k: headerValue}).Info("Response header")
+	if options.SaveRequest {
+		HTTPResponseStorage.Request = RecordRequest(resp.Request)
+	}
+
+	if options.SaveBody {
+		HTTPResponseStorage.Body = SavedBody(body)
+	}
+
+	// process response headers. Repeated headers are kept apart, as
+	// that is a fingerprint of the server in itself.
+	HTTPResponseStorage.Headers = HeaderList(resp.Header)
+	HTTPResponseStorage.Trailers = HeaderList(resp.Trailer)
+
+	// net/http loses the order and case of headers, so these are
+	// requested again over a connection that is read directly
+	if options.RawHeaders {
+
+		headers, trailers, err := FetchRawHeaders(finalURL, chrome, options)
+		if err != nil {
+			log.WithFields(log.Fields{"url": url, "error": err}).Warn("Failed to read the raw response headers")
+		} else {
+			HTTPResponseStorage.Headers = headers
+			HTTPResponseStorage.Trailers = trailers
+			HTTPResponseStorage.HeadersVerbatim = true
+		}
+	}
+
+	for _, header := range HTTPResponseStorage.Headers {
+		log.WithFields(log.Fields{"url": url, header.Key: header.Value}).Info("Response header")
+	}
+
+	// fingerprint the technologies in use
+	HTTPResponseStorage.Technologies = DetectTechnologies(HTTPResponseStorage.Headers, body)
+	HTTPResponseStorage.DirectoryListing = DirectoryListing(HTTPResponseStorage.PageTitle, body)
+	log.WithFields(log.Fields{"url": url, "technologies": HTTPResponseStorage.Technologies}).Debug("Detected technologies")
+
+	// Structured data such as API responses makes for a meaningless
+	// screenshot. Keep a readable copy of the body instead.
+	// parameters are server-controlled and kept out of the report
+	HTTPResponseStorage.ContentType = MediaType(resp.Header.Get("Content-Type"))
+	structured := IsStructuredContent(HTTPResponseStorage.ContentType)
+	if structured {
+		HTTPResponseStorage.StructuredBody = StructuredBody(HTTPResponseStorage.ContentType, body)
+		log.WithFields(log.Fields{"url": url, "content-type": HTTPResponseStorage.ContentType}).
+			Info("Structured response, skipping screenshot")
+	} else if options.FetchFavicon {
+		HTTPResponseStorage.Favicon = FetchFavicon(finalURL, body, chrome, options)
 	}
 
 	// Parse any TLS information
@@ -80,7 +532,10 @@ func ProcessURL(url *url.URL, chrome *chrm.Chrome, db *storage.Storage, timeout
 			SSLCertificateAttributes := storage.SSLCertificateAttributes{
 				SubjectCommonName:  c.Subject.CommonName,
 				IssuerCommonName:   c.Issuer.CommonName,
+				Subject:            c.Subject.String(),
+				Issuer:             c.Issuer.String(),
 				SignatureAlgorithm: c.SignatureAlgorithm.String(),
+				PEM:                string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})),
 			}
 
 			log.WithFields(log.Fields{"url": url, "common_name": c.Subject.CommonName}).Info("Certificate chain common name")
@@ -98,12 +553,71 @@ func ProcessURL(url *url.URL, chrome *chrm.Chrome, db *storage.Storage, timeout
 		}
 
 		SSLCertificate.CipherSuite = resp.TLS.CipherSuite
+		SSLCertificate.Validity = CertificateValidity(resp.TLS, finalURL.Hostname())
+		log.WithFields(log.Fields{"url": url, "validity": SSLCertificate.Validity}).Info("Certificate validity")
+		if SSLCertificate.Validity != storage.CertificateValid && ChainIncomplete(resp.TLS.PeerCertificates) {
+			SSLCertificate.ChainIncomplete = true
+			log.WithFields(log.Fields{"url": url, "certificates": len(resp.TLS.PeerCertificates)}).Warn("Certificate chain stops short of a trusted root")
+		}
 		HTTPResponseStorage.SSL = SSLCertificate
 		log.WithFields(log.Fields{"url": url, "cipher-suite": resp.TLS.CipherSuite}).Info("Cipher suite in use")
+
+		if options.TLSScan {
+			HTTPResponseStorage.SSL.Versions = ScanTLS(finalURL, options)
+		}
+
+		if options.HTTP2Push {
+
+			pushed, err := FetchPushedResources(finalURL, chrome, options)
+			if err != nil {
+				log.WithFields(log.Fields{"url": url, "error": err}).Warn("Failed to check for HTTP/2 server push")
+			} else if len(pushed) > 0 {
+				log.WithFields(log.Fields{"url": url, "pushed": pushed}).Info("Server pushed resources")
+			}
+			HTTPResponseStorage.PushedResources = pushed
+		}
+	}
+
+	if structured {
+		db.SetHTTPData(&HTTPResponseStorage)
+		return
+	}
+
+	// only the listed statuses are worth starting a browser for, the
+	// others keep their metadata alone
+	if len(options.ScreenshotStatuses) > 0 && !hasStatus(options.ScreenshotStatuses, resp.StatusCode) {
+		log.WithFields(log.Fields{"url": url, "status": resp.StatusCode}).Info("Status is not one to screenshot, keeping the metadata only")
+		HTTPResponseStorage.MetadataOnly = true
+		db.SetHTTPData(&HTTPResponseStorage)
+
+		return
+	}
+
+	// pages this large are unlikely to render in time, if at all
+	if options.TextFallback && options.TextFallbackSize > 0 && int64(len(body)) > options.TextFallbackSize {
+		log.WithFields(log.Fields{"url": url, "size": len(body), "text-fallback-size": options.TextFallbackSize}).
+			Info("Page is too large to render, keeping its text only")
+		storeTextOnly(db, &HTTPResponseStorage, storage.TextOnlySize, body)
+
+		return
 	}
 
 	// Generate a safe filename to use
-	fname := SafeFileName(url.String()) + ".png"
+	extension := ".png"
+	if options.ScreenshotFormat == "jpeg" {
+		extension = ".jpg"
+	}
+	fname := SafeFileName(url.String()) + extension
+
+	if options.Repeat > 0 {
+		fname = SafeFileName(url.String()) + "-repeat-" + strconv.Itoa(options.Repeat) + extension
+	}
+
+	// when keeping history, every capture needs its own screenshot,
+	// even repeated ones taken within the same second
+	if db.History {
+		fname = strings.TrimSuffix(fname, extension) + "-" + HTTPResponseStorage.CapturedAt.Format("20060102T150405.000") + extension
+	}
 
 	// Get the tull path where we will be saving the screenshot to
 	dst := filepath.Join(chrome.ScreenshotPath, fname)
@@ -113,8 +627,321 @@ func ProcessURL(url *url.URL, chrome *chrm.Chrome, db *storage.Storage, timeout
 		Debug("Generated filename for screenshot")
 
 	// Screenshot the URL
-	chrome.ScreenshotURL(finalURL, dst)
+	var engine chrm.Engine = chrome
+	if options.Engine != nil {
+		engine = options.Engine
+	}
+
+	HTTPResponseStorage.Engine = engine.Name()
+	HTTPResponseStorage.ReducedMotion = chrome.ReducedMotion
+	HTTPResponseStorage.Background = chrome.Background
+	if engine.Name() == "chrome" {
+		HTTPResponseStorage.Throttle = chrome.Throttle
+		HTTPResponseStorage.Touch = chrome.Touch
+		HTTPResponseStorage.Mobile = chrome.Mobile
+		HTTPResponseStorage.ScaleFactor = chrome.ScaleFactor
+		HTTPResponseStorage.Rendering = chrome.GPU
+	}
+	screenshot, err := engine.ScreenshotURL(finalURL, dst)
+	HTTPResponseStorage.ChromeCrashes = screenshot.Crashes
+	HTTPResponseStorage.DialogDismissed = screenshot.DialogDismissed
+	HTTPResponseStorage.BeforeDismissFile = screenshot.BeforeDismissFile
+	HTTPResponseStorage.Clicked = screenshot.Clicked
+	HTTPResponseStorage.WaitTimedOut = screenshot.WaitTimedOut
+	HTTPResponseStorage.ClippedHeight = screenshot.ClippedHeight
+	HTTPResponseStorage.ScrollIterations = screenshot.ScrollIterations
+	HTTPResponseStorage.DOMText = screenshot.DOMText
+	if screenshot.Challenge != nil {
+		challenge := storage.Challenge(*screenshot.Challenge)
+		HTTPResponseStorage.Challenge = &challenge
+	}
+	if tree := screenshot.AXTree; tree != nil {
+		HTTPResponseStorage.AXTree = &storage.AXTree{Total: tree.Total, UnlabeledButtons: tree.UnlabeledButtons,
+			UnlabeledImages: tree.UnlabeledImages, UnlabeledLinks: tree.UnlabeledLinks, UnlabeledFields: tree.UnlabeledFields}
+		for _, node := range tree.Nodes {
+			HTTPResponseStorage.AXTree.Nodes = append(HTTPResponseStorage.AXTree.Nodes, storage.AXNode(node))
+		}
+	}
+	HTTPResponseStorage.WebSockets = screenshot.WebSockets
+	HTTPResponseStorage.MixedContent = screenshot.MixedContent
+	for _, exception := range screenshot.Exceptions {
+		HTTPResponseStorage.JSExceptions = append(HTTPResponseStorage.JSExceptions, storage.JSException(exception))
+	}
+	if len(screenshot.Exceptions) > 0 {
+		log.WithFields(log.Fields{"url": url, "exceptions": len(screenshot.Exceptions)}).Info("Page threw uncaught JavaScript exceptions")
+	}
+	if len(screenshot.MixedContent) > 0 {
+		log.WithFields(log.Fields{"url": url, "insecure-resources": len(screenshot.MixedContent)}).Warn("Page loads mixed content")
+	}
+	HTTPResponseStorage.Canonical, HTTPResponseStorage.Alternates = ResolvePageLinks(finalURL, screenshot.Links)
+	HTTPResponseStorage.LinkedHosts = OffHostLinks(finalURL, HTTPResponseStorage.Canonical, HTTPResponseStorage.Alternates)
+	if len(HTTPResponseStorage.LinkedHosts) > 0 {
+		log.WithFields(log.Fields{"url": url, "hosts": HTTPResponseStorage.LinkedHosts}).Info("Canonical or alternate links point to other hosts")
+	}
+	if options.SaveLinks {
+		HTTPResponseStorage.OutboundLinks = OutboundLinks(finalURL, screenshot.Links.Anchors, options.CrossOriginLinks)
+		log.WithFields(log.Fields{"url": url, "links": len(HTTPResponseStorage.OutboundLinks)}).Debug("Outbound links")
+	}
+	if options.Crawler != nil {
+		if queued := options.Crawler.Queue(finalURL, options.CrawlDepth, HTTPResponseStorage.OutboundLinks); queued > 0 {
+			log.WithFields(log.Fields{"url": url, "depth": options.CrawlDepth + 1, "queued": queued}).Info("Queued links to crawl")
+		}
+	}
+	HTTPResponseStorage.DOMNodes = screenshot.DOMNodes
+	HTTPResponseStorage.TransferredBytes = screenshot.TransferredBytes
+	HTTPResponseStorage.TileFiles = screenshot.TileFiles
+
+	if err != nil && screenshot.TimedOut && options.TextFallback {
+		log.WithField("url", url).Info("Capture timed out, keeping the text of the page only")
+		storeTextOnly(db, &HTTPResponseStorage, storage.TextOnlyTimeout, body)
+
+		return
+	}
+
+	// engines always capture PNGs, convert those if needed
+	if err == nil && options.ScreenshotFormat == "jpeg" {
+
+		if err := ConvertJPEG(dst, options.JPEGQuality, options.JPEGSubsampling); err != nil {
+			log.WithFields(log.Fields{"url": url, "destination": dst, "err": err}).Error("Failed to convert screenshot to JPEG")
+		}
+
+		if screenshot.BeforeDismissFile != "" {
+			if err := ConvertJPEG(screenshot.BeforeDismissFile, options.JPEGQuality, options.JPEGSubsampling); err != nil {
+				log.WithFields(log.Fields{"url": url, "destination": screenshot.BeforeDismissFile, "err": err}).
+					Error("Failed to convert screenshot to JPEG")
+			}
+		}
+
+		for _, tile := range screenshot.TileFiles {
+			if err := ConvertJPEG(tile, options.JPEGQuality, options.JPEGSubsampling); err != nil {
+				log.WithFields(log.Fields{"url": url, "destination": tile, "err": err}).Error("Failed to convert screenshot tile to JPEG")
+			}
+		}
+	}
+
+	if err == nil && options.ScreenshotFormat != "jpeg" && options.PNGCompression != DefaultPNGCompression {
+
+		for _, file := range append([]string{dst, screenshot.BeforeDismissFile}, screenshot.TileFiles...) {
+			if file == "" {
+				continue
+			}
+
+			if err := ConvertPNG(file, options.PNGCompression); err != nil {
+				log.WithFields(log.Fields{"url": url, "destination": file, "err": err}).Error("Failed to re-encode screenshot")
+			}
+		}
+	}
+
+	// record the dimensions of what was actually captured
+	if err == nil {
+
+		if options.Disk != nil {
+
+			if info, err := os.Stat(dst); err == nil {
+				options.Disk.Add(info.Size())
+			}
+
+			if info, err := os.Stat(screenshot.BeforeDismissFile); err == nil && screenshot.BeforeDismissFile != "" {
+				options.Disk.Add(info.Size())
+			}
+
+			for _, tile := range screenshot.TileFiles {
+				if info, err := os.Stat(tile); err == nil {
+					options.Disk.Add(info.Size())
+				}
+			}
+		}
+
+		if width, height, err := ImageDimensions(dst); err == nil {
+			HTTPResponseStorage.ImageWidth = width
+			HTTPResponseStorage.ImageHeight = height
+		} else {
+			log.WithFields(log.Fields{"url": url, "destination": dst, "err": err}).Warn("Failed to read screenshot dimensions")
+		}
+	}
+
+	// report cards show the top of the page, where the detail
+	// that identifies it usually is
+	if err == nil && options.HeroHeight > 0 {
+
+		if heroFile, err := WriteHero(dst, options.HeroHeight, options); err == nil {
+			HTTPResponseStorage.HeroFile = heroFile
+			if info, err := os.Stat(heroFile); err == nil && options.Disk != nil {
+				options.Disk.Add(info.Size())
+			}
+		} else {
+			log.WithFields(log.Fields{"url": url, "destination": dst, "err": err}).Warn("Failed to crop the hero image")
+		}
+	}
+
+	// a blurred copy shows that something is there without what it is
+	if err == nil && options.BlurRadius > 0 {
+
+		if blurredFile, err := WriteBlurred(dst, options.BlurRadius, options); err == nil {
+			HTTPResponseStorage.BlurredFile = blurredFile
+			if info, err := os.Stat(blurredFile); err == nil && options.Disk != nil {
+				options.Disk.Add(info.Size())
+			}
+		} else {
+			log.WithFields(log.Fields{"url": url, "destination": dst, "err": err}).Warn("Failed to blur the screenshot")
+		}
+	}
+
+	// screenshots move to the bucket once nothing needs them locally
+	if err == nil && options.Artifacts != nil {
+		HTTPResponseStorage.ScreenshotFile = uploadArtifact(url, options.Artifacts, HTTPResponseStorage.ScreenshotFile)
+		HTTPResponseStorage.HeroFile = uploadArtifact(url, options.Artifacts, HTTPResponseStorage.HeroFile)
+		HTTPResponseStorage.BeforeDismissFile = uploadArtifact(url, options.Artifacts, HTTPResponseStorage.BeforeDismissFile)
+		HTTPResponseStorage.BlurredFile = uploadArtifact(url, options.Artifacts, HTTPResponseStorage.BlurredFile)
+		for i, tile := range HTTPResponseStorage.TileFiles {
+			HTTPResponseStorage.TileFiles[i] = uploadArtifact(url, options.Artifacts, tile)
+		}
+	}
 
 	// Update the database with this entry
 	db.SetHTTPData(&HTTPResponseStorage)
 }
+
+// storeTextOnly stores an entry with the text of body in place of a
+// screenshot, which was not taken for reason
+func storeTextOnly(db *storage.Storage, entry *storage.HTTResponse, reason string, body string) {
+
+	entry.TextOnly = reason
+	entry.ScreenshotFile = ""
+	entry.DOMText = BodyText(body)
+
+	db.SetHTTPData(entry)
+}
+
+// uploadArtifact moves a screenshot to the artifact store, returning
+// its reference. The local file is kept when it could not be stored.
+func uploadArtifact(url *url.URL, store *ArtifactStore, file string) string {
+
+	if file == "" {
+		return file
+	}
+
+	reference, err := store.Upload(file)
+	if err != nil {
+		log.WithFields(log.Fields{"url": url, "file": file, "err": err}).Error("Failed to store screenshot in the bucket, keeping it on disk")
+		return file
+	}
+
+	return reference
+}
+
+// hasStatus checks if status is one of statuses
+func hasStatus(statuses []int, status int) bool {
+
+	for _, candidate := range statuses {
+		if candidate == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newRequest prepares a new HTTP request agent used to query a URL
+func newRequest(chrome *chrm.Chrome, options *Options) *gorequest.SuperAgent {
+
+	request := gorequest.New().Timeout(time.Duration(options.Timeout)*time.Second).
+		TLSClientConfig(&tls.Config{InsecureSkipVerify: true}).
+		Set("User-Agent", chrome.UserAgent)
+
+	for name, value := range chrome.RequestHeaders() {
+		request.Set(name, value)
+	}
+
+	for name, value := range chrome.Cookies {
+		request.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+
+	if options.Transport != nil {
+		request.Transport = options.Transport
+	} else if options.Resolver != nil {
+		request.Transport.DialContext = options.Resolver.DialContext
+	}
+
+	return request
+}
+
+// classifyError returns the storage.ErrorKind that best describes
+// why a request failed
+func classifyError(errs []error) string {
+
+	if isTLSError(errs) {
+		return storage.ErrorKindTLS
+	}
+
+	if isRedirectLimitError(errs) {
+		return storage.ErrorKindRedirect
+	}
+
+	for _, err := range errs {
+
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return storage.ErrorKindTimeout
+		}
+
+		if opErr, ok := err.(*net.OpError); ok {
+
+			if _, ok := opErr.Err.(*net.DNSError); ok {
+				return storage.ErrorKindDNS
+			}
+
+			if sysErr, ok := opErr.Err.(*os.SyscallError); ok && sysErr.Err == syscall.ECONNREFUSED {
+				return storage.ErrorKindRefused
+			}
+
+			return storage.ErrorKindConnect
+		}
+
+		if _, ok := err.(*net.DNSError); ok {
+			return storage.ErrorKindDNS
+		}
+
+		if strings.Contains(err.Error(), "Client.Timeout") {
+			return storage.ErrorKindTimeout
+		}
+	}
+
+	return storage.ErrorKindUnknown
+}
+
+// joinErrors flattens the errors returned by a request into a string
+func joinErrors(errs []error) string {
+
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// isTLSError checks if any of the errors returned by a request
+// were caused by a failed TLS handshake
+func isTLSError(errs []error) bool {
+
+	for _, err := range errs {
+
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+
+		if _, ok := err.(tls.RecordHeaderError); ok {
+			return true
+		}
+
+		if strings.Contains(err.Error(), "tls:") || strings.Contains(err.Error(), "handshake") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Brokers that captures can be published to
+const (
+	BrokerNATS  string = "nats"
+	BrokerKafka string = "kafka"
+)
+
+// publisherBuffer is the number of messages queued while the broker
+// is slow or unavailable. Messages beyond it are dropped.
+const publisherBuffer = 1000
+
+// publisherRetry is how long to wait before reconnecting to a broker
+const publisherRetry = 5 * time.Second
+
+// Publisher publishes the metadata of every capture as a JSON message
+// to a NATS subject, or a Kafka topic through a Kafka REST proxy. A
+// broker that is unavailable never holds up a scan: messages are
+// queued, and dropped once the queue is full.
+type Publisher struct {
+	broker  string
+	address string
+	topic   string
+
+	messages chan []byte
+	done     chan struct{}
+	dropped  int
+	mu       sync.Mutex
+
+	client *http.Client
+	conn   net.Conn
+	writer *bufio.Writer
+
+	// writeMu keeps pongs from landing in the middle of a message
+	writeMu sync.Mutex
+}
+
+// NewPublisher starts publishing to topic on a broker. For NATS the
+// address is host:port, for Kafka the URL of a REST proxy.
+func NewPublisher(broker string, address string, topic string) (*Publisher, error) {
+
+	if broker != BrokerNATS && broker != BrokerKafka {
+		return nil, errors.Errorf("unknown broker %q, use nats or kafka", broker)
+	}
+
+	if address == "" || topic == "" {
+		return nil, errors.New("a broker address and topic are required")
+	}
+
+	publisher := &Publisher{
+		broker:   broker,
+		address:  strings.TrimSuffix(address, "/"),
+		topic:    topic,
+		messages: make(chan []byte, publisherBuffer),
+		done:     make(chan struct{}),
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+
+	go publisher.run()
+
+	return publisher, nil
+}
+
+// Publish queues a message. It is safe to call on a nil Publisher,
+// in which case nothing is published.
+func (publisher *Publisher) Publish(message []byte) {
+
+	if publisher == nil {
+		return
+	}
+
+	select {
+	case publisher.messages <- message:
+	default:
+		publisher.mu.Lock()
+		publisher.dropped++
+		publisher.mu.Unlock()
+	}
+}
+
+// Close publishes the queued messages, giving up after timeout
+func (publisher *Publisher) Close(timeout time.Duration) {
+
+	if publisher == nil {
+		return
+	}
+
+	close(publisher.messages)
+
+	select {
+	case <-publisher.done:
+	case <-time.After(timeout):
+		log.WithField("queued", len(publisher.messages)).Warn("Gave up publishing the remaining messages")
+	}
+
+	publisher.mu.Lock()
+	if publisher.dropped > 0 {
+		log.WithFields(log.Fields{"broker": publisher.broker, "dropped": publisher.dropped}).
+			Warn("Messages were dropped while the broker was unavailable")
+	}
+	publisher.mu.Unlock()
+}
+
+// run sends the queued messages, retrying each until it is sent
+// or the publisher is closed
+func (publisher *Publisher) run() {
+
+	defer close(publisher.done)
+
+	for message := range publisher.messages {
+
+		for {
+
+			err := publisher.send(message)
+			if err == nil {
+				break
+			}
+
+			log.WithFields(log.Fields{"broker": publisher.broker, "address": publisher.address, "err": err}).
+				Warn("Failed to publish, retrying")
+			publisher.disconnect()
+			time.Sleep(publisherRetry)
+		}
+	}
+
+	publisher.disconnect()
+}
+
+// send sends a single message to the broker
+func (publisher *Publisher) send(message []byte) error {
+
+	if publisher.broker == BrokerKafka {
+		return publisher.sendKafka(message)
+	}
+
+	return publisher.sendNATS(message)
+}
+
+// sendKafka produces a message with the v2 API of a Kafka REST proxy
+func (publisher *Publisher) sendKafka(message []byte) error {
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"records": []interface{}{map[string]json.RawMessage{"value": message}},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := publisher.client.Post(publisher.address+"/topics/"+publisher.topic,
+		"application/vnd.kafka.json.v2+json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("producing message: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// sendNATS publishes a message over the NATS text protocol,
+// connecting first if needed
+func (publisher *Publisher) sendNATS(message []byte) error {
+
+	if publisher.conn == nil {
+		if err := publisher.connectNATS(); err != nil {
+			return err
+		}
+	}
+
+	publisher.writeMu.Lock()
+	defer publisher.writeMu.Unlock()
+
+	publisher.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	fmt.Fprintf(publisher.writer, "PUB %s %d\r\n", publisher.topic, len(message))
+	publisher.writer.Write(message)
+	publisher.writer.WriteString("\r\n")
+
+	return publisher.writer.Flush()
+}
+
+// connectNATS connects to a NATS server, answering its pings in
+// the background for as long as the connection is open
+func (publisher *Publisher) connectNATS() error {
+
+	conn, err := net.DialTimeout("tcp", publisher.address, 10*time.Second)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(conn)
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	info, err := reader.ReadString('\n')
+	if err != nil || !strings.HasPrefix(info, "INFO ") {
+		conn.Close()
+		return errors.Errorf("unexpected greeting from NATS server: %q", strings.TrimSpace(info))
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	publisher.conn = conn
+	publisher.writer = bufio.NewWriter(conn)
+	publisher.writer.WriteString(`CONNECT {"verbose":false,"pedantic":false,"name":"gowitness"}` + "\r\n")
+	if err := publisher.writer.Flush(); err != nil {
+		publisher.disconnect()
+		return err
+	}
+
+	go func() {
+
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			switch {
+			case strings.HasPrefix(line, "PING"):
+				publisher.writeMu.Lock()
+				conn.Write([]byte("PONG\r\n"))
+				publisher.writeMu.Unlock()
+			case strings.HasPrefix(line, "-ERR"):
+				log.WithField("err", strings.TrimSpace(line)).Warn("NATS server returned an error")
+			}
+		}
+	}()
+
+	log.WithFields(log.Fields{"address": publisher.address, "subject": publisher.topic}).Debug("Connected to NATS")
+
+	return nil
+}
+
+// disconnect closes the NATS connection, if there is one
+func (publisher *Publisher) disconnect() {
+
+	if publisher.conn != nil {
+		publisher.conn.Close()
+		publisher.conn = nil
+	}
+}
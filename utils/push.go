@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+)
+
+// maxPushedResources is the most pushed resource URLs kept for a page
+const maxPushedResources = 50
+
+// FetchPushedResources requests target over HTTP/2 with server push
+// enabled, returning the URLs of the resources the server pushes with
+// the page. Chrome is served https pages through the forwarding proxy
+// over HTTP/1.1, and recent versions refuse pushes anyway, so they are
+// only seen on a connection of our own. Servers that do not speak
+// HTTP/2 push nothing.
+func FetchPushedResources(target *url.URL, chrome *chrm.Chrome, options *Options) ([]string, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(options.Timeout)*time.Second)
+	defer cancel()
+
+	dial := (&net.Dialer{}).DialContext
+	if options.Resolver != nil {
+		dial = options.Resolver.DialContext
+	}
+
+	conn, err := dial(ctx, "tcp", net.JoinHostPort(target.Hostname(), urlPort(target)))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{InsecureSkipVerify: true, ServerName: target.Hostname(), NextProtos: []string{http2.NextProtoTLS, "http/1.1"}})
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, err
+	}
+	if tlsConn.ConnectionState().NegotiatedProtocol != http2.NextProtoTLS {
+		return nil, nil
+	}
+
+	if _, err := tlsConn.Write([]byte(http2.ClientPreface)); err != nil {
+		return nil, err
+	}
+
+	framer := http2.NewFramer(tlsConn, tlsConn)
+	if err := framer.WriteSettings(http2.Setting{ID: http2.SettingEnablePush, Val: 1}); err != nil {
+		return nil, err
+	}
+
+	if err := framer.WriteHeaders(http2.HeadersFrameParam{
+		StreamID: 1, BlockFragment: pushRequestHeaders(target, chrome), EndStream: true, EndHeaders: true,
+	}); err != nil {
+		return nil, err
+	}
+
+	// every header block has to be decoded, pushed or not, as they
+	// share the compression state of the connection
+	decoder := hpack.NewDecoder(4096, nil)
+	var block bytes.Buffer
+	var promised uint32
+	var pushed []string
+
+	for {
+
+		frame, err := framer.ReadFrame()
+		if err != nil {
+			// a server holding the connection open is not an error,
+			// what it pushed before the deadline is kept
+			log.WithFields(log.Fields{"url": target, "err": err}).Debug("Stopped reading HTTP/2 frames")
+			return pushed, nil
+		}
+
+		headersEnded, ended := false, false
+		switch frame := frame.(type) {
+		case *http2.SettingsFrame:
+			if !frame.IsAck() {
+				framer.WriteSettingsAck()
+			}
+		case *http2.PingFrame:
+			if !frame.IsAck() {
+				framer.WritePing(true, frame.Data)
+			}
+		case *http2.PushPromiseFrame:
+			promised = frame.PromiseID
+			block.Write(frame.HeaderBlockFragment())
+			headersEnded = frame.HeadersEnded()
+		case *http2.HeadersFrame:
+			promised = 0
+			block.Write(frame.HeaderBlockFragment())
+			headersEnded = frame.HeadersEnded()
+			ended = frame.StreamID == 1 && frame.StreamEnded()
+		case *http2.ContinuationFrame:
+			block.Write(frame.HeaderBlockFragment())
+			headersEnded = frame.HeadersEnded()
+		case *http2.DataFrame:
+			// keep the page flowing until it ends
+			if length := uint32(len(frame.Data())); length > 0 {
+				framer.WriteWindowUpdate(0, length)
+				framer.WriteWindowUpdate(frame.StreamID, length)
+			}
+			ended = frame.StreamID == 1 && frame.StreamEnded()
+		case *http2.RSTStreamFrame:
+			ended = frame.StreamID == 1
+		case *http2.GoAwayFrame:
+			ended = true
+		}
+
+		if headersEnded {
+
+			fields, err := decoder.DecodeFull(block.Bytes())
+			block.Reset()
+			if err != nil {
+				return pushed, err
+			}
+
+			if promised != 0 {
+				if resource := pushedURL(fields); resource != "" && len(pushed) < maxPushedResources {
+					pushed = append(pushed, resource)
+				}
+
+				// the resource itself is not needed, only that it was pushed
+				framer.WriteRSTStream(promised, http2.ErrCodeCancel)
+			}
+		}
+
+		if ended {
+			return pushed, nil
+		}
+	}
+}
+
+// pushRequestHeaders returns the encoded header block of the request
+// for target, sending what the pre-flight requests do
+func pushRequestHeaders(target *url.URL, chrome *chrm.Chrome) []byte {
+
+	var block bytes.Buffer
+	encoder := hpack.NewEncoder(&block)
+	encoder.WriteField(hpack.HeaderField{Name: ":method", Value: "GET"})
+	encoder.WriteField(hpack.HeaderField{Name: ":scheme", Value: target.Scheme})
+	encoder.WriteField(hpack.HeaderField{Name: ":authority", Value: target.Host})
+	encoder.WriteField(hpack.HeaderField{Name: ":path", Value: target.RequestURI()})
+	encoder.WriteField(hpack.HeaderField{Name: "user-agent", Value: chrome.UserAgent})
+
+	for name, value := range chrome.RequestHeaders() {
+		encoder.WriteField(hpack.HeaderField{Name: strings.ToLower(name), Value: value})
+	}
+
+	var cookies []string
+	for name, value := range chrome.Cookies {
+		cookies = append(cookies, (&http.Cookie{Name: name, Value: value}).String())
+	}
+	if len(cookies) > 0 {
+		encoder.WriteField(hpack.HeaderField{Name: "cookie", Value: strings.Join(cookies, "; ")})
+	}
+
+	return block.Bytes()
+}
+
+// pushedURL returns the URL of the resource a push promise is for
+func pushedURL(fields []hpack.HeaderField) string {
+
+	var scheme, authority, path string
+	for _, field := range fields {
+		switch field.Name {
+		case ":scheme":
+			scheme = field.Value
+		case ":authority":
+			authority = field.Value
+		case ":path":
+			path = field.Value
+		}
+	}
+
+	if scheme == "" || authority == "" || path == "" {
+		return ""
+	}
+
+	return scheme + "://" + authority + path
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseQueryParams parses the key=value pairs of --append-query. A key
+// may be given more than once, and a value may be empty.
+func ParseQueryParams(pairs []string) (url.Values, error) {
+
+	params := url.Values{}
+	for _, pair := range pairs {
+
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid query parameter %q, use key=value", pair)
+		}
+
+		params.Add(parts[0], parts[1])
+	}
+
+	return params, nil
+}
+
+// AppendQuery returns a copy of u with params added after its existing
+// query, which is kept exactly as it was rather than re-encoded. URLs
+// that already end with params, such as those export --skipped lists,
+// are left as they are.
+func AppendQuery(u *url.URL, params url.Values) *url.URL {
+
+	appended := *u
+	appended.ForceQuery = false
+
+	query := strings.TrimRight(u.RawQuery, "&")
+	if encoded := params.Encode(); query == encoded || strings.HasSuffix(query, "&"+encoded) {
+		return &appended
+	}
+
+	if query != "" {
+		query += "&"
+	}
+	appended.RawQuery = query + params.Encode()
+
+	return &appended
+}
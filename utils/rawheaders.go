@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// maxTrailerBody is how much of a body is read looking for trailers
+const maxTrailerBody = 1 << 20
+
+// headRecorder keeps the bytes read from a connection up to the end
+// of the response headers
+type headRecorder struct {
+	net.Conn
+	head bytes.Buffer
+	done bool
+}
+
+func (conn *headRecorder) Read(p []byte) (int, error) {
+
+	n, err := conn.Conn.Read(p)
+	if conn.done {
+		return n, err
+	}
+
+	conn.head.Write(p[:n])
+	for !conn.done {
+
+		head := conn.head.Bytes()
+		end := bytes.Index(head, []byte("\r\n\r\n"))
+		if end < 0 {
+			break
+		}
+
+		// informational responses such as 103 Early Hints come
+		// before the real one
+		if fields := bytes.Fields(head[:end]); len(fields) > 1 && len(fields[1]) == 3 && fields[1][0] == '1' {
+			conn.head.Next(end + 4)
+			continue
+		}
+
+		conn.head.Truncate(end)
+		conn.done = true
+	}
+
+	return n, err
+}
+
+// HeaderList converts headers to a list with a header per value,
+// sorted by name. Values of the same header keep their order.
+func HeaderList(headers http.Header) []storage.HTTPHeader {
+
+	var keys []string
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var list []storage.HTTPHeader
+	for _, key := range keys {
+		for _, value := range headers[key] {
+			list = append(list, storage.HTTPHeader{Key: key, Value: value})
+		}
+	}
+
+	return list
+}
+
+// FetchRawHeaders requests a URL over a connection of its own, so that
+// the response headers can be read exactly as they were sent: in order,
+// with their original case and with repeated headers kept apart. Go's
+// HTTP client does not preserve any of that. Trailers are returned too.
+func FetchRawHeaders(target *url.URL, chrome *chrm.Chrome, options *Options) ([]storage.HTTPHeader, []storage.HTTPHeader, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(options.Timeout)*time.Second)
+	defer cancel()
+
+	dial := (&net.Dialer{}).DialContext
+	if options.Resolver != nil {
+		dial = options.Resolver.DialContext
+	}
+
+	conn, err := dial(ctx, "tcp", net.JoinHostPort(target.Hostname(), urlPort(target)))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	if target.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{InsecureSkipVerify: true, ServerName: target.Hostname()})
+		if err := tlsConn.Handshake(); err != nil {
+			return nil, nil, err
+		}
+		conn = tlsConn
+	}
+
+	req, err := http.NewRequest("GET", target.String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("User-Agent", chrome.UserAgent)
+	for name, value := range chrome.RequestHeaders() {
+		req.Header.Set(name, value)
+	}
+	for name, value := range chrome.Cookies {
+		req.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+	req.Close = true
+
+	if err := req.Write(conn); err != nil {
+		return nil, nil, err
+	}
+
+	recorder := &headRecorder{Conn: conn}
+	reader := bufio.NewReader(recorder)
+	resp, err := http.ReadResponse(reader, req)
+	for err == nil && resp.StatusCode >= 100 && resp.StatusCode < 200 {
+		resp, err = http.ReadResponse(reader, req)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	// trailers are only known once the body has been read
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxTrailerBody))
+
+	var headers []storage.HTTPHeader
+	lines := strings.Split(recorder.head.String(), "\r\n")
+	for _, line := range lines[1:] {
+
+		// obsolete line folding continues the previous header
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(headers) > 0 {
+			headers[len(headers)-1].Value += " " + strings.TrimSpace(line)
+			continue
+		}
+
+		if colon := strings.Index(line, ":"); colon > 0 {
+			headers = append(headers, storage.HTTPHeader{Key: line[:colon], Value: strings.TrimSpace(line[colon+1:])})
+		}
+	}
+
+	return headers, HeaderList(resp.Trailer), nil
+}
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	"github.com/parnurzeal/gorequest"
+)
+
+// DefaultMaxRedirects is the number of redirects followed before
+// giving up, matching the net/http default
+const DefaultMaxRedirects int = 10
+
+// redirectLimitError is returned once a redirect chain reaches
+// the limit, which usually means the redirects loop
+type redirectLimitError struct {
+	limit int
+	loop  bool
+}
+
+func (err *redirectLimitError) Error() string {
+
+	if err.loop {
+		return fmt.Sprintf("redirect loop, stopped after %d redirects", err.limit)
+	}
+
+	return fmt.Sprintf("stopped after %d redirects", err.limit)
+}
+
+// isRedirectLimitError checks if a request failed because it was
+// redirected too many times
+func isRedirectLimitError(errs []error) bool {
+
+	for _, err := range errs {
+
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+
+		if _, ok := err.(*redirectLimitError); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redirectRecorder records each hop of a redirect chain
+// along with how long it took
+type redirectRecorder struct {
+	hops  []storage.RedirectHop
+	last  time.Time
+	limit int
+}
+
+// newRedirectRecorder returns a redirectRecorder timing from now,
+// refusing to follow more than limit redirects
+func newRedirectRecorder(limit int) *redirectRecorder {
+
+	if limit <= 0 {
+		limit = DefaultMaxRedirects
+	}
+
+	return &redirectRecorder{last: time.Now(), limit: limit}
+}
+
+// policy is used as the redirect policy of a request, recording
+// the hop that caused the redirect
+func (recorder *redirectRecorder) policy(req gorequest.Request, via []gorequest.Request) error {
+
+	previous := (*http.Request)(via[len(via)-1])
+	hop := storage.RedirectHop{URL: previous.URL.String(), Duration: time.Since(recorder.last)}
+	if req.Response != nil {
+		hop.StatusCode = req.Response.StatusCode
+	}
+
+	recorder.hops = append(recorder.hops, hop)
+	recorder.last = time.Now()
+
+	if len(via) >= recorder.limit {
+		return &redirectLimitError{limit: recorder.limit, loop: recorder.visited(req.URL.String())}
+	}
+
+	return nil
+}
+
+// visited checks if the chain already passed through target
+func (recorder *redirectRecorder) visited(target string) bool {
+
+	for _, hop := range recorder.hops {
+		if hop.URL == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// partial returns the hops recorded for a chain that was
+// never completed
+func (recorder *redirectRecorder) partial() []storage.RedirectHop {
+
+	return recorder.hops
+}
+
+// refresh records the page in resp as a hop of the chain, as it
+// redirects with a meta refresh
+func (recorder *redirectRecorder) refresh(resp gorequest.Response) {
+
+	recorder.hops = append(recorder.hops, storage.RedirectHop{
+		URL:         resp.Request.URL.String(),
+		StatusCode:  resp.StatusCode,
+		Duration:    time.Since(recorder.last),
+		MetaRefresh: true,
+	})
+	recorder.last = time.Now()
+}
+
+// finish records the final hop of the chain
+func (recorder *redirectRecorder) finish(resp gorequest.Response) []storage.RedirectHop {
+
+	return append(recorder.hops, storage.RedirectHop{
+		URL:        resp.Request.URL.String(),
+		StatusCode: resp.StatusCode,
+		Duration:   time.Since(recorder.last),
+	})
+}
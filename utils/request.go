@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// redactedValue replaces the values of sensitive headers
+const redactedValue string = "[redacted]"
+
+// sensitiveHeaders are always redacted from recorded requests
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+}
+
+// sensitiveHeaderWords redact any header whose name contains them
+var sensitiveHeaderWords = []string{"token", "secret", "password", "api-key", "apikey", "session"}
+
+// RecordRequest returns the request that was sent, with credentials
+// and other secrets redacted so that it is safe to keep in a report
+func RecordRequest(req *http.Request) *storage.HTTPRequest {
+
+	// credentials in the URL are redacted too
+	requestURL := *req.URL
+	if requestURL.User != nil {
+
+		if _, ok := requestURL.User.Password(); ok {
+			requestURL.User = url.UserPassword(requestURL.User.Username(), redactedValue)
+		}
+	}
+
+	recorded := &storage.HTTPRequest{Method: req.Method, URL: requestURL.String()}
+
+	for key, values := range req.Header {
+
+		value := strings.Join(values, ", ")
+		if isSensitiveHeader(key) {
+			value = redactedValue
+		}
+
+		recorded.Headers = append(recorded.Headers, storage.HTTPHeader{Key: key, Value: value})
+	}
+
+	sort.Slice(recorded.Headers, func(i, j int) bool {
+		return recorded.Headers[i].Key < recorded.Headers[j].Key
+	})
+
+	return recorded
+}
+
+// isSensitiveHeader checks if a header is likely to contain a secret
+func isSensitiveHeader(key string) bool {
+
+	if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+		return true
+	}
+
+	lower := strings.ToLower(key)
+	for _, word := range sensitiveHeaderWords {
+		if strings.Contains(lower, word) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+	log "github.com/sirupsen/logrus"
+)
+
+// lookupTimeout bounds a single lookup of a hostname
+const lookupTimeout = 10 * time.Second
+
+// Resolver resolves hostnames using a bounded number of concurrent
+// lookups, caching the results so that a hostname is only ever
+// resolved once. Failed lookups are not cached, and are tried again
+// for the next URL on the host.
+type Resolver struct {
+	sem   chan struct{}
+	mu    sync.Mutex
+	cache map[string]*resolverEntry
+
+	// pins bypass DNS for the hosts they match
+	pins []chrm.ResolvePin
+}
+
+// resolverEntry is a cached (or in-flight) lookup
+type resolverEntry struct {
+	done  chan struct{}
+	addrs []string
+	err   error
+}
+
+// NewResolver returns a Resolver that runs at most
+// concurrency lookups at a time
+func NewResolver(concurrency int) *Resolver {
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &Resolver{
+		sem:   make(chan struct{}, concurrency),
+		cache: make(map[string]*resolverEntry),
+	}
+}
+
+// Pin connects to the pinned addresses instead of resolving
+// the hosts they match
+func (resolver *Resolver) Pin(pins []chrm.ResolvePin) {
+
+	resolver.pins = append(resolver.pins, pins...)
+}
+
+// Lookup resolves a hostname, returning the cached result
+// if it has been resolved before
+func (resolver *Resolver) Lookup(host string) ([]string, error) {
+
+	if ip := net.ParseIP(host); ip != nil {
+		return []string{ip.String()}, nil
+	}
+
+	// pinned hosts often do not resolve at all
+	for _, pin := range resolver.pins {
+		if pin.Host == "*" || pin.Host == strings.ToLower(host) {
+			return []string{pin.Address}, nil
+		}
+	}
+
+	return resolver.lookup(host)
+}
+
+// lookup resolves a hostname, ignoring pins
+func (resolver *Resolver) lookup(host string) ([]string, error) {
+
+	if ip := net.ParseIP(host); ip != nil {
+		return []string{ip.String()}, nil
+	}
+
+	resolver.mu.Lock()
+	entry, ok := resolver.cache[host]
+	if !ok {
+		entry = &resolverEntry{done: make(chan struct{})}
+		resolver.cache[host] = entry
+	}
+	resolver.mu.Unlock()
+
+	// someone else is (or was) resolving this host, wait for them
+	if ok {
+		<-entry.done
+		return entry.addrs, entry.err
+	}
+
+	resolver.sem <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	entry.addrs, entry.err = net.DefaultResolver.LookupHost(ctx, host)
+	cancel()
+	<-resolver.sem
+
+	// a failure may be transient, those waiting share it but the
+	// next lookup starts afresh
+	if entry.err != nil {
+		resolver.mu.Lock()
+		delete(resolver.cache, host)
+		resolver.mu.Unlock()
+	}
+	close(entry.done)
+
+	log.WithFields(log.Fields{"host": host, "addresses": entry.addrs, "err": entry.err}).Debug("Resolved host")
+
+	return entry.addrs, entry.err
+}
+
+// DialContext dials address using the cached lookup results. It is
+// suitable for use as an http.Transport's DialContext.
+func (resolver *Resolver) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if pinned := chrm.PinnedAddress(resolver.pins, host, port); pinned != "" {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, network, net.JoinHostPort(pinned, port))
+	}
+
+	addrs, err := resolver.lookup(host)
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer net.Dialer
+	var conn net.Conn
+	for _, addr := range addrs {
+
+		conn, err = dialer.DialContext(ctx, network, net.JoinHostPort(addr, port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+
+	return nil, err
+}
+
+// urlPort returns the port of a URL, defaulting to that of its scheme
+func urlPort(target *url.URL) string {
+
+	if port := target.Port(); port != "" {
+		return port
+	}
+
+	if target.Scheme == "https" {
+		return "443"
+	}
+
+	return "80"
+}
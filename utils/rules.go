@@ -0,0 +1,353 @@
+package utils
+
+import (
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// TagRule tags the entries its When expression matches with Tag. An
+// expression is conditions joined with && and ||, && binding tighter,
+// such as: status == 200 && title contains "admin"
+type TagRule struct {
+	Tag  string `yaml:"tag"`
+	When string `yaml:"when"`
+
+	// any of these sets of conditions, all of which must hold
+	anyOf [][]ruleCondition
+}
+
+// ruleCondition compares a field of an entry with a value
+type ruleCondition struct {
+	field    string
+	operator string
+	value    string
+	number   int
+	pattern  *regexp.Regexp
+}
+
+// ruleOperators are the comparisons a condition can make. The ordering
+// ones only apply to status.
+var ruleOperators = map[string]bool{
+	"==": true, "!=": true, "contains": true, "matches": true,
+	"<": true, "<=": true, ">": true, ">=": true,
+}
+
+// ruleFields are the fields of an entry a condition can compare,
+// along with header.<name> for any response header
+var ruleFields = map[string]bool{
+	"status": true, "title": true, "url": true, "final_url": true, "server": true,
+	"content_type": true, "technology": true, "error_kind": true, "lang": true, "text": true,
+}
+
+// LoadTagRules reads tag rules from a YAML file listing them under
+// rules, each with the tag it applies and when:
+//
+//	rules:
+//	  - tag: admin-panel
+//	    when: status == 200 && title contains "admin"
+func LoadTagRules(path string) ([]TagRule, error) {
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Rules []TagRule `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, errors.Wrap(err, "parsing tag rules")
+	}
+
+	for i := range file.Rules {
+
+		rule := &file.Rules[i]
+		if strings.TrimSpace(rule.Tag) == "" {
+			return nil, errors.Errorf("tag rule %d has no tag", i+1)
+		}
+
+		if err := rule.parse(); err != nil {
+			return nil, errors.Wrapf(err, "tag rule %d (%s)", i+1, rule.Tag)
+		}
+	}
+
+	return file.Rules, nil
+}
+
+// parse compiles the When expression of the rule
+func (rule *TagRule) parse() error {
+
+	tokens, err := ruleTokens(rule.When)
+	if err != nil {
+		return err
+	}
+
+	if len(tokens) == 0 {
+		return errors.New("no when expression")
+	}
+
+	var conditions []ruleCondition
+	for len(tokens) > 0 {
+
+		if len(tokens) < 3 {
+			return errors.Errorf("incomplete condition %q", strings.Join(tokens, " "))
+		}
+
+		condition, err := parseCondition(tokens[0], tokens[1], tokens[2])
+		if err != nil {
+			return err
+		}
+		conditions = append(conditions, condition)
+		tokens = tokens[3:]
+
+		if len(tokens) == 0 || tokens[0] == "||" {
+			rule.anyOf = append(rule.anyOf, conditions)
+			conditions = nil
+		} else if tokens[0] != "&&" {
+			return errors.Errorf("expected && or || before %q", tokens[0])
+		}
+
+		if len(tokens) > 0 {
+			tokens = tokens[1:]
+			if len(tokens) == 0 {
+				return errors.New("expression ends with an operator")
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseCondition builds a condition comparing field with value
+func parseCondition(field string, operator string, value string) (ruleCondition, error) {
+
+	field = strings.ToLower(field)
+	operator = strings.ToLower(operator)
+	condition := ruleCondition{field: field, operator: operator, value: value}
+
+	if !ruleFields[field] && !strings.HasPrefix(field, "header.") {
+		return condition, errors.Errorf("unknown field %q", field)
+	}
+
+	if !ruleOperators[operator] {
+		return condition, errors.Errorf("unknown operator %q", operator)
+	}
+
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return condition, errors.Errorf("invalid string %s", value)
+		}
+		condition.value = unquoted
+	}
+
+	switch {
+	case operator == "matches":
+		pattern, err := regexp.Compile(condition.value)
+		if err != nil {
+			return condition, errors.Wrapf(err, "invalid pattern %q", condition.value)
+		}
+		condition.pattern = pattern
+
+	case field == "status":
+		number, err := strconv.Atoi(condition.value)
+		if err != nil || operator == "contains" {
+			return condition, errors.Errorf("status can only be compared with a number")
+		}
+		condition.number = number
+
+	case strings.HasPrefix(operator, "<") || strings.HasPrefix(operator, ">"):
+		return condition, errors.Errorf("%s can only compare status", operator)
+	}
+
+	return condition, nil
+}
+
+// ruleTokens splits an expression into field names, operators, quoted
+// strings and bare values
+func ruleTokens(expression string) ([]string, error) {
+
+	var tokens []string
+	for i := 0; i < len(expression); {
+
+		switch c := expression[i]; {
+		case unicode.IsSpace(rune(c)):
+			i++
+
+		case c == '"':
+			end := i + 1
+			for end < len(expression) && expression[end] != '"' {
+				if expression[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(expression) {
+				return nil, errors.New("unterminated string")
+			}
+			tokens = append(tokens, expression[i:end+1])
+			i = end + 1
+
+		case strings.ContainsRune("=!<>&|", rune(c)):
+			end := i + 1
+			for end < len(expression) && strings.ContainsRune("=!<>&|", rune(expression[end])) {
+				end++
+			}
+			tokens = append(tokens, expression[i:end])
+			i = end
+
+		default:
+			end := i + 1
+			for end < len(expression) && !unicode.IsSpace(rune(expression[end])) &&
+				!strings.ContainsRune("=!<>&|\"", rune(expression[end])) {
+				end++
+			}
+			tokens = append(tokens, expression[i:end])
+			i = end
+		}
+	}
+
+	return tokens, nil
+}
+
+// Matches reports whether the rule applies to entry
+func (rule *TagRule) Matches(entry *storage.HTTResponse) bool {
+
+	for _, conditions := range rule.anyOf {
+
+		matched := true
+		for _, condition := range conditions {
+			if !condition.matches(entry) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches reports whether the condition holds for entry. Fields with
+// several values, such as technology, hold if any of them does.
+func (condition ruleCondition) matches(entry *storage.HTTResponse) bool {
+
+	if condition.field == "status" && condition.pattern == nil {
+
+		status := entry.ResponseCode
+		switch condition.operator {
+		case "==":
+			return status == condition.number
+		case "!=":
+			return status != condition.number
+		case "<":
+			return status < condition.number
+		case "<=":
+			return status <= condition.number
+		case ">":
+			return status > condition.number
+		default:
+			return status >= condition.number
+		}
+	}
+
+	values := condition.fieldValues(entry)
+	if condition.operator == "!=" {
+		for _, value := range values {
+			if strings.EqualFold(value, condition.value) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, value := range values {
+
+		switch condition.operator {
+		case "==":
+			if strings.EqualFold(value, condition.value) {
+				return true
+			}
+		case "contains":
+			if strings.Contains(strings.ToLower(value), strings.ToLower(condition.value)) {
+				return true
+			}
+		case "matches":
+			if condition.pattern.MatchString(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// fieldValues returns the values of the field of entry the condition
+// compares
+func (condition ruleCondition) fieldValues(entry *storage.HTTResponse) []string {
+
+	switch condition.field {
+	case "status":
+		return []string{strconv.Itoa(entry.ResponseCode)}
+	case "title":
+		return []string{entry.PageTitle}
+	case "url":
+		return []string{entry.URL}
+	case "final_url":
+		return []string{entry.FinalURL}
+	case "server":
+		return headerValues(entry, "Server")
+	case "content_type":
+		return []string{entry.ContentType}
+	case "technology":
+		return entry.Technologies
+	case "error_kind":
+		return []string{entry.ErrorKind}
+	case "lang":
+		return []string{entry.Lang}
+	case "text":
+		return []string{entry.DOMText}
+	}
+
+	return headerValues(entry, strings.TrimPrefix(condition.field, "header."))
+}
+
+// headerValues returns the values of the response header name
+func headerValues(entry *storage.HTTResponse, name string) []string {
+
+	var values []string
+	for _, header := range entry.Headers {
+		if strings.EqualFold(header.Key, name) {
+			values = append(values, header.Value)
+		}
+	}
+
+	return values
+}
+
+// AutoTags returns the tags of the rules that match entry, in the
+// order of the rules, without repeats
+func AutoTags(rules []TagRule, entry *storage.HTTResponse) []string {
+
+	var tags []string
+	seen := make(map[string]bool)
+	for i := range rules {
+		if tag := strings.TrimSpace(rules[i].Tag); !seen[tag] && rules[i].Matches(entry) {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
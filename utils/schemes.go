@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"math/rand"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	chrm "github.com/RiskSense-Ops/gowitness/chrome"
+)
+
+// Orders in which the schemes of bare hosts are tried
+const (
+	SchemeHTTPS  string = "https"
+	SchemeHTTP   string = "http"
+	SchemeRandom string = "random"
+)
+
+// SchemeChooser picks the scheme of hosts given without one. The scheme
+// that answered on a host and port is remembered, so that its other
+// paths are tried with it first instead of repeating the fallback.
+type SchemeChooser struct {
+	Prefer string
+
+	mu    sync.Mutex
+	known map[string]string
+	rand  *rand.Rand
+}
+
+// NewSchemeChooser returns a SchemeChooser trying prefer first, which
+// is https, http or random to shuffle the order per host
+func NewSchemeChooser(prefer string) (*SchemeChooser, error) {
+
+	switch prefer {
+	case SchemeHTTPS, SchemeHTTP, SchemeRandom:
+	default:
+		return nil, errors.Errorf("invalid scheme preference %q, use https, http or random", prefer)
+	}
+
+	return &SchemeChooser{
+		Prefer: prefer,
+		known:  make(map[string]string),
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}, nil
+}
+
+// Order returns the schemes to try for a host and port, in order
+func (chooser *SchemeChooser) Order(host string) []string {
+
+	chooser.mu.Lock()
+	defer chooser.mu.Unlock()
+
+	first := chooser.known[host]
+	if first == "" {
+		first = chooser.Prefer
+	}
+	if first == SchemeRandom {
+		first = SchemeHTTPS
+		if chooser.rand.Intn(2) == 0 {
+			first = SchemeHTTP
+		}
+	}
+
+	if first == SchemeHTTP {
+		return []string{SchemeHTTP, SchemeHTTPS}
+	}
+
+	return []string{SchemeHTTPS, SchemeHTTP}
+}
+
+// Remember records the scheme a host and port answered on
+func (chooser *SchemeChooser) Remember(host string, scheme string) {
+
+	chooser.mu.Lock()
+	defer chooser.mu.Unlock()
+
+	chooser.known[host] = scheme
+}
+
+// probeScheme returns u with the first scheme the host answers on,
+// trying them in the order of the chooser. The first scheme is kept
+// when the host answers on neither, so that the capture records why.
+func probeScheme(u *url.URL, chrome *chrm.Chrome, options *Options) *url.URL {
+
+	order := options.Schemes.Order(u.Host)
+	for _, scheme := range order {
+
+		candidate := *u
+		candidate.Scheme = scheme
+
+		_, _, errs := newRequest(chrome, options).Head(candidate.String()).End()
+		if errs == nil {
+			options.Schemes.Remember(u.Host, scheme)
+			log.WithFields(log.Fields{"url": candidate.String(), "scheme": scheme}).Debug("Bare host answered")
+
+			return &candidate
+		}
+
+		log.WithFields(log.Fields{"url": candidate.String(), "error": errs}).Debug("Bare host did not answer, trying the next scheme")
+	}
+
+	candidate := *u
+	candidate.Scheme = order[0]
+
+	return &candidate
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Scope is a set of CIDR ranges that targets are allowed to be in
+type Scope struct {
+	networks []*net.IPNet
+}
+
+// ParseScope parses CIDR ranges into a Scope. Addresses without a
+// subnet are treated as a single host.
+func ParseScope(cidrs []string) (*Scope, error) {
+
+	scope := &Scope{}
+	for _, cidr := range cidrs {
+
+		cidr = strings.TrimSpace(cidr)
+		if !strings.Contains(cidr, "/") {
+			if ip := net.ParseIP(cidr); ip != nil && ip.To4() == nil {
+				cidr = cidr + "/128"
+			} else {
+				cidr = cidr + "/32"
+			}
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing scope")
+		}
+		scope.networks = append(scope.networks, network)
+	}
+
+	return scope, nil
+}
+
+// Contains checks if an address is in scope
+func (scope *Scope) Contains(address string) bool {
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range scope.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
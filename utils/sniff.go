@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// htmlMediaTypes are the media types browsers render as HTML
+var htmlMediaTypes = map[string]bool{"text/html": true, "application/xhtml+xml": true}
+
+// CheckContentType compares the Content-Type of a response with what
+// its body sniffs as, returning nil when they agree. A body sniffing
+// as HTML under another type, or none, could be rendered as HTML by a
+// browser that sniffs it, and an HTML type on a binary body is just as
+// wrong. Bodies are sniffed before they are decoded.
+func CheckContentType(body string, header http.Header) *storage.SniffIssue {
+
+	if strings.TrimSpace(body) == "" {
+		return nil
+	}
+
+	declared := ""
+	if mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type")); err == nil {
+		declared = strings.ToLower(mediaType)
+	}
+
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType([]byte(body)))
+
+	switch {
+	case htmlMediaTypes[sniffed] && !htmlMediaTypes[declared]:
+	case htmlMediaTypes[declared] && !isTextMediaType(sniffed):
+	default:
+		return nil
+	}
+
+	return &storage.SniffIssue{
+		Declared: declared,
+		Sniffed:  sniffed,
+		NoSniff:  strings.EqualFold(strings.TrimSpace(header.Get("X-Content-Type-Options")), "nosniff"),
+	}
+}
+
+// isTextMediaType checks if a sniffed media type is text of some kind
+func isTextMediaType(mediaType string) bool {
+
+	return strings.HasPrefix(mediaType, "text/") || mediaType == "application/json" || mediaType == "application/javascript"
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// technology is a simple fingerprint used to identify
+// software running on a web server
+type technology struct {
+	Name   string
+	Header string         // header key to inspect
+	Match  *regexp.Regexp // matched against the header value, or the body if Header is empty
+}
+
+// technologies is the built-in fingerprint table
+var technologies = []technology{
+	{Name: "nginx", Header: "Server", Match: regexp.MustCompile(`(?i)nginx`)},
+	{Name: "Apache", Header: "Server", Match: regexp.MustCompile(`(?i)apache`)},
+	{Name: "IIS", Header: "Server", Match: regexp.MustCompile(`(?i)microsoft-iis`)},
+	{Name: "lighttpd", Header: "Server", Match: regexp.MustCompile(`(?i)lighttpd`)},
+	{Name: "Jetty", Header: "Server", Match: regexp.MustCompile(`(?i)jetty`)},
+	{Name: "Tomcat", Header: "Server", Match: regexp.MustCompile(`(?i)(tomcat|coyote)`)},
+	{Name: "Cloudflare", Header: "Server", Match: regexp.MustCompile(`(?i)cloudflare`)},
+	{Name: "PHP", Header: "X-Powered-By", Match: regexp.MustCompile(`(?i)php`)},
+	{Name: "ASP.NET", Header: "X-Powered-By", Match: regexp.MustCompile(`(?i)asp\.net`)},
+	{Name: "Express", Header: "X-Powered-By", Match: regexp.MustCompile(`(?i)express`)},
+	{Name: "Jenkins", Header: "X-Jenkins", Match: regexp.MustCompile(`.`)},
+	{Name: "Drupal", Header: "X-Generator", Match: regexp.MustCompile(`(?i)drupal`)},
+	{Name: "WordPress", Match: regexp.MustCompile(`(?i)(/wp-content/|/wp-includes/|<meta name="generator" content="wordpress)`)},
+	{Name: "Joomla", Match: regexp.MustCompile(`(?i)<meta name="generator" content="joomla`)},
+	{Name: "Drupal", Match: regexp.MustCompile(`(?i)(drupal\.settings|/sites/default/files/)`)},
+	{Name: "Jenkins", Match: regexp.MustCompile(`(?i)<title>[^<]*jenkins[^<]*</title>`)},
+	{Name: "GitLab", Match: regexp.MustCompile(`(?i)(<meta content="gitlab"|gon\.gitlab_url)`)},
+	{Name: "Grafana", Match: regexp.MustCompile(`(?i)<title>grafana</title>`)},
+	{Name: "Kibana", Match: regexp.MustCompile(`(?i)<title>kibana</title>`)},
+	{Name: "phpMyAdmin", Match: regexp.MustCompile(`(?i)<title>[^<]*phpmyadmin[^<]*</title>`)},
+	{Name: "Outlook Web App", Match: regexp.MustCompile(`(?i)(/owa/auth/|outlook web app)`)},
+	{Name: "SharePoint", Match: regexp.MustCompile(`(?i)(_layouts/15/|microsoftsharepointteamservices)`)},
+	{Name: "jQuery", Match: regexp.MustCompile(`(?i)jquery[.-]?[0-9.]*(\.min)?\.js`)},
+	{Name: "Bootstrap", Match: regexp.MustCompile(`(?i)bootstrap(\.min)?\.(css|js)`)},
+}
+
+// DetectTechnologies returns the names of the technologies identified
+// using the headers and body of an HTTP response
+func DetectTechnologies(headers []storage.HTTPHeader, body string) []string {
+
+	var detected []string
+	seen := make(map[string]bool)
+
+	for _, t := range technologies {
+
+		if seen[t.Name] {
+			continue
+		}
+
+		matched := false
+		if t.Header == "" {
+			matched = t.Match.MatchString(body)
+		} else {
+
+			for _, h := range headers {
+				if strings.EqualFold(h.Key, t.Header) && t.Match.MatchString(h.Value) {
+					matched = true
+					break
+				}
+			}
+		}
+
+		if matched {
+			seen[t.Name] = true
+			detected = append(detected, t.Name)
+		}
+	}
+
+	return detected
+}
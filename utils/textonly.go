@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// maxBodyText is the most characters of text kept for a text-only
+// entry, as the pages falling back to it are large ones
+const maxBodyText = 100000
+
+var (
+	hiddenElements = regexp.MustCompile(`(?is)<!--.*?-->|<script\b.*?</script>|<style\b.*?</style>|<noscript\b.*?</noscript>|<template\b.*?</template>`)
+	blockBoundary  = regexp.MustCompile(`(?i)<br\b[^>]*>|</(?:p|div|li|tr|h[1-6]|section|article|header|footer|table|ul|ol)>`)
+	lineSpace      = regexp.MustCompile(`[ \t\r\f\v]+`)
+	lineEdge       = regexp.MustCompile(` ?\n ?`)
+	blankLines     = regexp.MustCompile(`\n{3,}`)
+)
+
+// BodyText returns the readable text of an HTML body, for pages that
+// are stored as text because they could not be rendered. Block
+// elements are kept on lines of their own.
+func BodyText(body string) string {
+
+	text := hiddenElements.ReplaceAllString(body, " ")
+	text = blockBoundary.ReplaceAllString(text, "\n")
+	text = html.UnescapeString(markup.ReplaceAllString(text, " "))
+	text = lineEdge.ReplaceAllString(lineSpace.ReplaceAllString(text, " "), "\n")
+	text = strings.TrimSpace(blankLines.ReplaceAllString(text, "\n\n"))
+
+	if runes := []rune(text); len(runes) > maxBodyText {
+		text = string(runes[:maxBodyText]) + "…"
+	}
+
+	return text
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+)
+
+// maxTitleSourceLength is the most characters kept of a title source,
+// as descriptions in particular can run long
+const maxTitleSourceLength = 200
+
+var (
+	metaTag       = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	metaName      = regexp.MustCompile(`(?is)\b(?:name|property)\s*=\s*["']?([^"'\s>]*)`)
+	metaContent   = regexp.MustCompile(`(?is)\bcontent\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+	firstHeading  = regexp.MustCompile(`(?is)<h1\b[^>]*>(.*?)</h1>`)
+	markup        = regexp.MustCompile(`(?s)<[^>]*>`)
+	spaceSequence = regexp.MustCompile(`\s+`)
+)
+
+// ExtractTitleSources returns the meta description, og:title and first
+// heading of a page, which can stand in for an unhelpful title. Nil is
+// returned when the page has none of them.
+func ExtractTitleSources(body string) *storage.TitleSources {
+
+	sources := &storage.TitleSources{}
+	for _, tag := range metaTag.FindAllString(body, -1) {
+
+		name := metaName.FindStringSubmatch(tag)
+		content := metaContent.FindStringSubmatch(tag)
+		if len(name) < 2 || len(content) < 4 {
+			continue
+		}
+
+		value := cleanTitleSource(content[1] + content[2] + content[3])
+		switch strings.ToLower(name[1]) {
+		case "description":
+			if sources.Description == "" {
+				sources.Description = value
+			}
+		case "og:title":
+			if sources.OGTitle == "" {
+				sources.OGTitle = value
+			}
+		}
+	}
+
+	if heading := firstHeading.FindStringSubmatch(body); len(heading) > 1 {
+		sources.Heading = cleanTitleSource(markup.ReplaceAllString(heading[1], " "))
+	}
+
+	if *sources == (storage.TitleSources{}) {
+		return nil
+	}
+
+	return sources
+}
+
+// cleanTitleSource unescapes text taken from markup, collapsing its
+// whitespace and bounding its length
+func cleanTitleSource(text string) string {
+
+	text = strings.TrimSpace(spaceSequence.ReplaceAllString(html.UnescapeString(text), " "))
+	if runes := []rune(text); len(runes) > maxTitleSourceLength {
+		text = string(runes[:maxTitleSourceLength]) + "…"
+	}
+
+	return text
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/RiskSense-Ops/gowitness/storage"
+	log "github.com/sirupsen/logrus"
+)
+
+// tlsScanVersions are the versions tried, oldest first
+var tlsScanVersions = []struct {
+	version uint16
+	name    string
+	weak    bool
+}{
+	{tls.VersionTLS10, "TLS 1.0", true},
+	{tls.VersionTLS11, "TLS 1.1", true},
+	{tls.VersionTLS12, "TLS 1.2", false},
+	{tls.VersionTLS13, "TLS 1.3", false},
+}
+
+// tlsScanCiphers offers every cipher suite Go implements, including
+// the insecure ones, so that old servers still complete a handshake
+func tlsScanCiphers() []uint16 {
+
+	var ciphers []uint16
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		ciphers = append(ciphers, suite.ID)
+	}
+
+	return ciphers
+}
+
+// ScanTLS checks which TLS versions a target accepts, with a separate
+// handshake for each, recording the cipher suite negotiated with it
+func ScanTLS(target *url.URL, options *Options) []storage.TLSVersion {
+
+	var versions []storage.TLSVersion
+	for _, candidate := range tlsScanVersions {
+
+		version := storage.TLSVersion{Version: candidate.name, Weak: candidate.weak}
+
+		cipher, err := tlsHandshake(target, candidate.version, options)
+		if err == nil {
+			version.Accepted = true
+			version.CipherSuite = tls.CipherSuiteName(cipher)
+		}
+
+		log.WithFields(log.Fields{"url": target, "version": version.Version, "accepted": version.Accepted,
+			"cipher-suite": version.CipherSuite, "error": err}).Debug("TLS version")
+
+		versions = append(versions, version)
+	}
+
+	return versions
+}
+
+// tlsHandshake completes a handshake limited to version, returning the
+// negotiated cipher suite
+func tlsHandshake(target *url.URL, version uint16, options *Options) (uint16, error) {
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(options.Timeout)*time.Second)
+	defer cancel()
+
+	dial := (&net.Dialer{}).DialContext
+	if options.Resolver != nil {
+		dial = options.Resolver.DialContext
+	}
+
+	conn, err := dial(ctx, "tcp", net.JoinHostPort(target.Hostname(), urlPort(target)))
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{
+		InsecureSkipVerify: true,
+		ServerName:         target.Hostname(),
+		MinVersion:         version,
+		MaxVersion:         version,
+		CipherSuites:       tlsScanCiphers(),
+	})
+	if err := tlsConn.Handshake(); err != nil {
+		return 0, err
+	}
+
+	return tlsConn.ConnectionState().CipherSuite, nil
+}
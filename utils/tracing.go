@@ -0,0 +1,235 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// tracerBatchSize is the number of ended spans that are buffered
+// before they are exported
+const tracerBatchSize = 100
+
+// traceparent matches a W3C trace context header
+var traceparent = regexp.MustCompile(`^[0-9a-f]{2}-([0-9a-f]{32})-([0-9a-f]{16})-[0-9a-f]{2}$`)
+
+// Tracer exports OpenTelemetry spans to an OTLP/HTTP endpoint using
+// the JSON encoding. All of the spans of a run share a trace, which
+// may continue the trace of an upstream stage.
+type Tracer struct {
+	endpoint string
+	client   *http.Client
+	traceID  string
+	parentID string
+
+	mu    sync.Mutex
+	spans []*Span
+}
+
+// Span is a single traced operation
+type Span struct {
+	tracer     *Tracer
+	name       string
+	id         string
+	start      time.Time
+	end        time.Time
+	attributes map[string]interface{}
+	err        string
+}
+
+// NewTracer returns a Tracer exporting to the OTLP/HTTP endpoint, eg:
+// http://localhost:4318. parent is an optional W3C traceparent to
+// continue the trace of.
+func NewTracer(endpoint string, parent string) *Tracer {
+
+	tracer := &Tracer{
+		endpoint: strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		client:   &http.Client{Timeout: 10 * time.Second},
+		traceID:  randomID(16),
+	}
+
+	if match := traceparent.FindStringSubmatch(strings.TrimSpace(parent)); match != nil {
+		tracer.traceID = match[1]
+		tracer.parentID = match[2]
+	} else if parent != "" {
+		log.WithField("traceparent", parent).Warn("Ignoring invalid traceparent")
+	}
+
+	return tracer
+}
+
+// Start starts a span. It is safe to call on a nil Tracer, in
+// which case nothing is recorded.
+func (tracer *Tracer) Start(name string) *Span {
+
+	if tracer == nil {
+		return nil
+	}
+
+	return &Span{
+		tracer: tracer, name: name, id: randomID(8), start: time.Now(), attributes: make(map[string]interface{}),
+	}
+}
+
+// SetAttribute sets a string, bool or integer attribute on the span
+func (span *Span) SetAttribute(key string, value interface{}) {
+
+	if span == nil {
+		return
+	}
+
+	span.attributes[key] = value
+}
+
+// SetError marks the span as failed
+func (span *Span) SetError(message string) {
+
+	if span == nil {
+		return
+	}
+
+	span.err = message
+}
+
+// End ends the span, exporting it along with the rest of its
+// batch once the batch is full
+func (span *Span) End() {
+
+	if span == nil {
+		return
+	}
+
+	span.end = time.Now()
+
+	tracer := span.tracer
+	tracer.mu.Lock()
+	tracer.spans = append(tracer.spans, span)
+	full := len(tracer.spans) >= tracerBatchSize
+	tracer.mu.Unlock()
+
+	if full {
+		if err := tracer.Flush(); err != nil {
+			log.WithField("err", err).Warn("Failed to export spans")
+		}
+	}
+}
+
+// Flush exports the spans that have ended
+func (tracer *Tracer) Flush() error {
+
+	if tracer == nil {
+		return nil
+	}
+
+	tracer.mu.Lock()
+	spans := tracer.spans
+	tracer.spans = nil
+	tracer.mu.Unlock()
+
+	if len(spans) == 0 {
+		return nil
+	}
+
+	var encoded []map[string]interface{}
+	for _, span := range spans {
+		encoded = append(encoded, tracer.encode(span))
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{
+				"attributes": otlpAttributes(map[string]interface{}{"service.name": "gowitness"}),
+			},
+			"scopeSpans": []interface{}{map[string]interface{}{
+				"scope": map[string]string{"name": "gowitness"},
+				"spans": encoded,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := tracer.client.Post(tracer.endpoint, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "exporting spans")
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("exporting spans: %s", resp.Status)
+	}
+
+	log.WithFields(log.Fields{"spans": len(spans), "endpoint": tracer.endpoint}).Debug("Exported spans")
+
+	return nil
+}
+
+// encode converts a span to its OTLP JSON form
+func (tracer *Tracer) encode(span *Span) map[string]interface{} {
+
+	encoded := map[string]interface{}{
+		"traceId":           tracer.traceID,
+		"spanId":            span.id,
+		"name":              span.name,
+		"kind":              1,
+		"startTimeUnixNano": strconv.FormatInt(span.start.UnixNano(), 10),
+		"endTimeUnixNano":   strconv.FormatInt(span.end.UnixNano(), 10),
+		"attributes":        otlpAttributes(span.attributes),
+		"status":            map[string]interface{}{"code": 1},
+	}
+
+	if tracer.parentID != "" {
+		encoded["parentSpanId"] = tracer.parentID
+	}
+
+	if span.err != "" {
+		encoded["status"] = map[string]interface{}{"code": 2, "message": span.err}
+	}
+
+	return encoded
+}
+
+// otlpAttributes converts attributes to OTLP key/value pairs
+func otlpAttributes(attributes map[string]interface{}) []map[string]interface{} {
+
+	var encoded []map[string]interface{}
+	for key, value := range attributes {
+
+		var otlpValue map[string]interface{}
+		switch v := value.(type) {
+		case bool:
+			otlpValue = map[string]interface{}{"boolValue": v}
+		case int:
+			otlpValue = map[string]interface{}{"intValue": strconv.Itoa(v)}
+		case int64:
+			otlpValue = map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+		default:
+			otlpValue = map[string]interface{}{"stringValue": fmt.Sprint(v)}
+		}
+
+		encoded = append(encoded, map[string]interface{}{"key": key, "value": otlpValue})
+	}
+
+	return encoded
+}
+
+// randomID returns a random hex encoded id of size bytes
+func randomID(size int) string {
+
+	id := make([]byte, size)
+	rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"crypto/tls"
+	"net/http"
+	"time"
+)
+
+// TransportTuning controls the connection pool of the transport
+// shared by all of the pre-flight HTTP requests
+type TransportTuning struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+
+	// HTTP10 sends requests as HTTP/1.0 instead of HTTP/1.1
+	HTTP10 bool
+
+	// ConnectionClose sends every request with Connection: close
+	// over a new connection, for servers that mishandle keep-alive
+	ConnectionClose bool
+}
+
+// NewTransport returns a transport to share between requests, so that
+// connections are reused instead of every request dialing (and leaving
+// behind) its own. Hostnames are resolved with resolver if it is not nil.
+func NewTransport(resolver *Resolver, tuning TransportTuning) *http.Transport {
+
+	transport := &http.Transport{
+		TLSClientConfig:     &tls.Config{InsecureSkipVerify: true},
+		MaxIdleConns:        tuning.MaxIdleConns,
+		MaxIdleConnsPerHost: tuning.MaxIdleConnsPerHost,
+		IdleConnTimeout:     tuning.IdleConnTimeout,
+		DisableKeepAlives:   tuning.ConnectionClose,
+	}
+
+	if resolver != nil {
+		transport.DialContext = resolver.DialContext
+	}
+
+	if tuning.HTTP10 {
+		useHTTP10(transport)
+	}
+
+	return transport
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// visualDiffThreshold is how different the channels of a pixel may
+// be before it is considered changed, ignoring compression noise
+const visualDiffThreshold uint32 = 0x0800
+
+// visualDiffGap is the space between the images in a visual diff
+const visualDiffGap int = 10
+
+// VisualDiff compares two screenshot files, returning an image with
+// the before and after screenshots side by side followed by the after
+// screenshot faded, with changed pixels highlighted in red. The
+// number of changed pixels is returned too.
+func VisualDiff(beforeFile string, afterFile string) (image.Image, int, error) {
+
+	before, err := decodeImage(beforeFile)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	after, err := decodeImage(afterFile)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the canvas fits the larger of the two screenshots
+	width := before.Bounds().Dx()
+	if after.Bounds().Dx() > width {
+		width = after.Bounds().Dx()
+	}
+	height := before.Bounds().Dy()
+	if after.Bounds().Dy() > height {
+		height = after.Bounds().Dy()
+	}
+
+	canvas := image.NewRGBA(image.Rect(0, 0, width*3+visualDiffGap*2, height))
+	draw.Draw(canvas, canvas.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(canvas, before.Bounds().Sub(before.Bounds().Min), before, before.Bounds().Min, draw.Src)
+	draw.Draw(canvas, after.Bounds().Sub(after.Bounds().Min).Add(image.Pt(width+visualDiffGap, 0)),
+		after, after.Bounds().Min, draw.Src)
+
+	highlight := color.RGBA{R: 0xff, A: 0xff}
+	offset := (width + visualDiffGap) * 2
+	changed := 0
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+
+			a := pixelAt(before, x, y)
+			b := pixelAt(after, x, y)
+
+			if pixelsDiffer(a, b) {
+				canvas.Set(offset+x, y, highlight)
+				changed++
+				continue
+			}
+
+			// unchanged pixels are faded so that changes stand out
+			r, g, bl, _ := b.RGBA()
+			canvas.Set(offset+x, y, color.RGBA{
+				R: uint8(0xc0 + (r>>8)/4), G: uint8(0xc0 + (g>>8)/4), B: uint8(0xc0 + (bl>>8)/4), A: 0xff,
+			})
+		}
+	}
+
+	return canvas, changed, nil
+}
+
+// pixelAt returns the colour of a pixel relative to the image origin,
+// or transparent when it falls outside of the image
+func pixelAt(img image.Image, x int, y int) color.Color {
+
+	point := image.Pt(x, y).Add(img.Bounds().Min)
+	if !point.In(img.Bounds()) {
+		return color.Transparent
+	}
+
+	return img.At(point.X, point.Y)
+}
+
+// pixelsDiffer checks if two colours differ by more than the threshold
+func pixelsDiffer(a color.Color, b color.Color) bool {
+
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+
+	for _, pair := range [][2]uint32{{ar, br}, {ag, bg}, {ab, bb}, {aa, ba}} {
+
+		if pair[0] > pair[1] && pair[0]-pair[1] > visualDiffThreshold ||
+			pair[1] > pair[0] && pair[1]-pair[0] > visualDiffThreshold {
+			return true
+		}
+	}
+
+	return false
+}